@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/driver"
+	"github.com/riskykurniawan15/payrolls/models/attendance_import"
+	attendanceRepositories "github.com/riskykurniawan15/payrolls/repositories/attendance"
+	attendanceImportRepositories "github.com/riskykurniawan15/payrolls/repositories/attendance_import"
+	instanceRepositories "github.com/riskykurniawan15/payrolls/repositories/instance"
+	userRepositories "github.com/riskykurniawan15/payrolls/repositories/user"
+	attendanceImportServices "github.com/riskykurniawan15/payrolls/services/attendance_import"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+func main() {
+	file := flag.String("file", "", "path to the punch log export (.dat or .csv)")
+	dryRun := flag.Bool("dry-run", false, "report what would be imported without writing")
+	createdBy := flag.Uint("created-by", 1, "admin user ID recorded as importer")
+	flag.Parse()
+
+	if *file == "" {
+		log.Fatal("file is required, usage: attendance_import -file=attlog.dat [-dry-run] [-created-by=1]")
+	}
+
+	content, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("Failed to read file: %v", err)
+	}
+
+	// Load configuration
+	cfg := config.Configuration()
+
+	// Connect to database using existing driver
+	db := driver.ConnectDB(cfg.PostgressDB)
+
+	// Initialize logger with config
+	logs := logger.NewLoggerWithConfig(logger.LoggerConfig{
+		OutputMode: cfg.Logger.OutputMode,
+		LogLevel:   cfg.Logger.LogLevel,
+		LogDir:     cfg.Logger.LogDir,
+	})
+	defer logs.Close()
+
+	service := attendanceImportServices.NewAttendanceImportService(
+		logs,
+		cfg,
+		attendanceImportRepositories.NewAttendanceImportRepository(db),
+		attendanceRepositories.NewAttendanceRepository(db),
+		userRepositories.NewUserRepository(db),
+		instanceRepositories.NewInstanceRepository(db),
+	)
+
+	result, err := service.Import(context.Background(), attendance_import.ImportAttendanceRequest{
+		FileName: filepath.Base(*file),
+		Content:  content,
+		DryRun:   *dryRun,
+	}, uint(*createdBy))
+	if err != nil {
+		log.Fatalf("Import error: %v", err)
+	}
+
+	output, _ := json.MarshalIndent(result, "", "  ")
+	log.Println(string(output))
+	log.Println("Attendance import completed successfully!")
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/driver"
+	"github.com/riskykurniawan15/payrolls/models/employee_import"
+	departmentRepositories "github.com/riskykurniawan15/payrolls/repositories/department"
+	instanceRepositories "github.com/riskykurniawan15/payrolls/repositories/instance"
+	roleRepositories "github.com/riskykurniawan15/payrolls/repositories/role"
+	userRepositories "github.com/riskykurniawan15/payrolls/repositories/user"
+	employeeImportServices "github.com/riskykurniawan15/payrolls/services/employee_import"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+func main() {
+	file := flag.String("file", "", "path to the employee sheet (.csv or .xlsx)")
+	dryRun := flag.Bool("dry-run", false, "validate the sheet without writing")
+	createdBy := flag.Uint("created-by", 1, "admin user ID recorded as importer")
+	credentialsOut := flag.String("credentials-out", "", "write the temporary passwords of new employees to this CSV instead of the output")
+	flag.Parse()
+
+	if *file == "" {
+		log.Fatal("file is required, usage: employee_import -file=employees.csv [-dry-run] [-created-by=1] [-credentials-out=credentials.csv]")
+	}
+
+	content, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("Failed to read file: %v", err)
+	}
+
+	// The passwords are shown only once, so make sure they can be written before importing
+	var credentials *os.File
+	if *credentialsOut != "" && !*dryRun {
+		credentials, err = os.OpenFile(*credentialsOut, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			log.Fatalf("Failed to create credentials file: %v", err)
+		}
+		defer credentials.Close()
+	}
+
+	// Load configuration
+	cfg := config.Configuration()
+
+	// Connect to database using existing driver
+	db := driver.ConnectDB(cfg.PostgressDB)
+
+	// Initialize logger with config
+	logs := logger.NewLoggerWithConfig(logger.LoggerConfig{
+		OutputMode: cfg.Logger.OutputMode,
+		LogLevel:   cfg.Logger.LogLevel,
+		LogDir:     cfg.Logger.LogDir,
+	})
+	defer logs.Close()
+
+	service := employeeImportServices.NewEmployeeImportService(
+		logs,
+		cfg,
+		instanceRepositories.NewInstanceRepository(db),
+		userRepositories.NewUserRepository(db),
+		roleRepositories.NewRoleRepository(db),
+		departmentRepositories.NewDepartmentRepository(db),
+	)
+
+	result, err := service.Import(context.Background(), employee_import.ImportEmployeesRequest{
+		FileName: filepath.Base(*file),
+		Content:  content,
+		DryRun:   *dryRun,
+		// The command runs with direct database access, so roles in the sheet are applied as given
+		CanAssignRoles: true,
+	}, uint(*createdBy))
+	if err != nil {
+		removeCredentials(credentials)
+		log.Fatalf("Import error: %v", err)
+	}
+
+	if credentials != nil {
+		if len(result.Errors) > 0 {
+			removeCredentials(credentials)
+		} else if err := writeCredentials(credentials, result); err != nil {
+			log.Fatalf("Failed to write credentials file: %v", err)
+		}
+	}
+
+	output, _ := json.MarshalIndent(result, "", "  ")
+	log.Println(string(output))
+
+	if len(result.Errors) > 0 {
+		log.Fatalf("Employee sheet has %d invalid rows, nothing was imported", len(result.Errors))
+	}
+	if credentials != nil {
+		log.Printf("Temporary passwords written to %s", credentials.Name())
+	}
+	log.Println("Employee import completed successfully!")
+}
+
+// writeCredentials moves the temporary passwords from the result into the credentials file
+func writeCredentials(f *os.File, result *employee_import.ImportResult) error {
+	w := csv.NewWriter(f)
+	if err := w.Write([]string{"employee_number", "username", "temporary_password"}); err != nil {
+		return err
+	}
+	for i, employee := range result.Employees {
+		if employee.TemporaryPassword == "" {
+			continue
+		}
+		if err := w.Write([]string{employee.EmployeeNumber, employee.Username, employee.TemporaryPassword}); err != nil {
+			return err
+		}
+		result.Employees[i].TemporaryPassword = ""
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func removeCredentials(f *os.File) {
+	if f == nil {
+		return
+	}
+	f.Close()
+	os.Remove(f.Name())
+}
@@ -3,23 +3,37 @@ package config
 import (
 	"fmt"
 	"log"
+	"net"
+	"strings"
 
 	"github.com/riskykurniawan15/payrolls/utils/env"
 )
 
 type (
 	Config struct {
-		CompanyName string
-		Http        HttpServer
-		PostgressDB PostgressDB
-		JWT         JWTConfig
-		Logger      LoggerConfig
+		CompanyName   string
+		Http          HttpServer
+		PostgressDB   PostgressDB
+		JWT           JWTConfig
+		Password      PasswordConfig
+		LoginLimit    LoginLimitConfig
+		TwoFactor     TwoFactorConfig
+		OIDC          OIDCConfig
+		Logger        LoggerConfig
+		Geofence      GeofenceConfig
+		Attendance    AttendanceConfig
+		Overtime      OvertimeConfig
+		Storage       StorageConfig
+		Reimbursement ReimbursementConfig
+		Termination   TerminationConfig
 	}
 
 	HttpServer struct {
 		Server string
 		Port   int
 		URL    string
+		// TrustedProxies may set X-Forwarded-For, without them the client IP is the connecting address
+		TrustedProxies []*net.IPNet
 	}
 
 	PostgressDB struct {
@@ -37,8 +51,50 @@ type (
 	}
 
 	JWTConfig struct {
-		SecretKey string
-		Expired   int
+		SecretKey      string
+		AccessExpired  int // minutes
+		RefreshExpired int // hours
+	}
+
+	PasswordConfig struct {
+		MinLength     int
+		RequireUpper  bool
+		RequireLower  bool
+		RequireDigit  bool
+		RequireSymbol bool
+		ResetTokenTTL int // minutes
+	}
+
+	LoginLimitConfig struct {
+		MaxAttempts   int // failed attempts per account before lockout
+		IPMaxAttempts int // failed attempts per IP before it is blocked
+		Window        int // minutes
+		LockoutTime   int // minutes
+		DelayBase     int // seconds
+		DelayMax      int // seconds
+	}
+
+	TwoFactorConfig struct {
+		Issuer        string // shown next to the account in authenticator apps
+		EncryptionKey string // encrypts stored TOTP secrets
+		ChallengeTTL  int    // minutes
+		MaxAttempts   int    // wrong codes allowed per login challenge
+		RecoveryCodes int
+		Skew          int // time steps accepted before and after the current one
+	}
+
+	OIDCConfig struct {
+		Enabled       bool
+		IssuerURL     string
+		ClientID      string
+		ClientSecret  string
+		RedirectURL   string
+		Scopes        []string
+		MatchClaim    string // "email" or "sub"
+		AutoProvision bool   // create unknown users on their first sign-in
+		DefaultRole   string // role given to provisioned users
+		StateTTL      int    // minutes
+		Timeout       int    // seconds
 	}
 
 	LoggerConfig struct {
@@ -46,6 +102,47 @@ type (
 		LogLevel   string
 		LogDir     string
 	}
+
+	GeofenceConfig struct {
+		Mode        string
+		MaxAccuracy float64
+	}
+
+	AttendanceConfig struct {
+		WorkStartTime     string
+		WorkEndTime       string
+		AutoCloseEnabled  bool
+		AutoCloseCutoff   int
+		AutoCloseInterval int
+		Holidays          string
+	}
+
+	OvertimeConfig struct {
+		ProposalEnabled    bool
+		ProposalMinMinutes int
+		WeeklyCapHours     float64
+		MonthlyCapHours    float64
+	}
+
+	ReimbursementConfig struct {
+		DuplicateWindowDays      int
+		DuplicateTitleSimilarity float64
+	}
+
+	TerminationConfig struct {
+		DeactivateInterval int
+	}
+
+	StorageConfig struct {
+		Driver      string
+		LocalPath   string
+		S3Endpoint  string
+		S3Region    string
+		S3Bucket    string
+		S3AccessKey string
+		S3SecretKey string
+		S3UseSSL    bool
+	}
 )
 
 func Configuration() Config {
@@ -54,11 +151,26 @@ func Configuration() Config {
 	}
 
 	cfg := Config{
-		CompanyName: env.GetEnv("COMPANY_NAME", "Blank Company"),
-		Http:        loadHttpServer(),
-		PostgressDB: loadDBServer(),
-		JWT:         loadJWTConfig(),
-		Logger:      loadLoggerConfig(),
+		CompanyName:   env.GetEnv("COMPANY_NAME", "Blank Company"),
+		Http:          loadHttpServer(),
+		PostgressDB:   loadDBServer(),
+		JWT:           loadJWTConfig(),
+		Password:      loadPasswordConfig(),
+		LoginLimit:    loadLoginLimitConfig(),
+		TwoFactor:     loadTwoFactorConfig(),
+		OIDC:          loadOIDCConfig(),
+		Logger:        loadLoggerConfig(),
+		Geofence:      loadGeofenceConfig(),
+		Attendance:    loadAttendanceConfig(),
+		Overtime:      loadOvertimeConfig(),
+		Storage:       loadStorageConfig(),
+		Reimbursement: loadReimbursementConfig(),
+		Termination:   loadTerminationConfig(),
+	}
+
+	// The callback is served by this application unless a proxy in front of it is configured
+	if cfg.OIDC.RedirectURL == "" {
+		cfg.OIDC.RedirectURL = cfg.Http.URL + "auth/oidc/callback"
 	}
 
 	log.Println("Success for load all configuration")
@@ -82,9 +194,32 @@ func loadHttpServer() HttpServer {
 	}
 	cfg.URL += "/"
 
+	cfg.TrustedProxies = parseTrustedProxies(env.GetEnv("TRUSTED_PROXIES", ""))
+
 	return cfg
 }
 
+// parseTrustedProxies reads a comma separated list of IPs and CIDR ranges, invalid entries are skipped
+func parseTrustedProxies(value string) []*net.IPNet {
+	var proxies []*net.IPNet
+	for _, entry := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if !strings.Contains(entry, "/") {
+			if ip := net.ParseIP(entry); ip != nil && ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			log.Println("invalid trusted proxy, skipped:", entry)
+			continue
+		}
+		proxies = append(proxies, ipNet)
+	}
+	return proxies
+}
+
 func loadDBServer() PostgressDB {
 	return PostgressDB{
 		DBUser:        env.GetEnv("DB_USER", "root"),
@@ -103,8 +238,58 @@ func loadDBServer() PostgressDB {
 
 func loadJWTConfig() JWTConfig {
 	return JWTConfig{
-		SecretKey: env.GetEnv("JWT_SECRET_KEY", ""),
-		Expired:   env.GetEnv("JWT_EXPIRED", 24),
+		SecretKey:      env.GetEnv("JWT_SECRET_KEY", ""),
+		AccessExpired:  env.GetEnv("JWT_ACCESS_EXPIRED", 15),
+		RefreshExpired: env.GetEnv("JWT_REFRESH_EXPIRED", 168),
+	}
+}
+
+func loadPasswordConfig() PasswordConfig {
+	return PasswordConfig{
+		MinLength:     env.GetEnv("PASSWORD_MIN_LENGTH", 8),
+		RequireUpper:  env.GetEnv("PASSWORD_REQUIRE_UPPER", true),
+		RequireLower:  env.GetEnv("PASSWORD_REQUIRE_LOWER", true),
+		RequireDigit:  env.GetEnv("PASSWORD_REQUIRE_DIGIT", true),
+		RequireSymbol: env.GetEnv("PASSWORD_REQUIRE_SYMBOL", false),
+		ResetTokenTTL: env.GetEnv("PASSWORD_RESET_TOKEN_TTL", 60), // in minutes
+	}
+}
+
+func loadLoginLimitConfig() LoginLimitConfig {
+	return LoginLimitConfig{
+		MaxAttempts:   env.GetEnv("LOGIN_MAX_ATTEMPTS", 5),     // failed attempts per account before lockout
+		IPMaxAttempts: env.GetEnv("LOGIN_IP_MAX_ATTEMPTS", 20), // failed attempts per IP before it is blocked
+		Window:        env.GetEnv("LOGIN_ATTEMPT_WINDOW", 15),  // in minutes, failures older than this are forgotten
+		LockoutTime:   env.GetEnv("LOGIN_LOCKOUT_TIME", 15),    // in minutes
+		DelayBase:     env.GetEnv("LOGIN_DELAY_BASE", 1),       // in seconds, doubled after every failure
+		DelayMax:      env.GetEnv("LOGIN_DELAY_MAX", 30),       // in seconds
+	}
+}
+
+func loadTwoFactorConfig() TwoFactorConfig {
+	return TwoFactorConfig{
+		Issuer:        env.GetEnv("TWO_FACTOR_ISSUER", env.GetEnv("COMPANY_NAME", "Blank Company")),
+		EncryptionKey: env.GetEnv("TWO_FACTOR_ENCRYPTION_KEY", env.GetEnv("JWT_SECRET_KEY", "")), // falls back to the JWT secret
+		ChallengeTTL:  env.GetEnv("TWO_FACTOR_CHALLENGE_TTL", 5),                                 // in minutes
+		MaxAttempts:   env.GetEnv("TWO_FACTOR_MAX_ATTEMPTS", 5),                                  // wrong codes per login challenge
+		RecoveryCodes: env.GetEnv("TWO_FACTOR_RECOVERY_CODES", 10),
+		Skew:          env.GetEnv("TWO_FACTOR_SKEW", 1), // 30 second steps accepted around the current one
+	}
+}
+
+func loadOIDCConfig() OIDCConfig {
+	return OIDCConfig{
+		Enabled:       env.GetEnv("OIDC_ENABLED", false),
+		IssuerURL:     env.GetEnv("OIDC_ISSUER_URL", ""), // e.g. https://sso.example.com/realms/company
+		ClientID:      env.GetEnv("OIDC_CLIENT_ID", ""),
+		ClientSecret:  env.GetEnv("OIDC_CLIENT_SECRET", ""), // empty for public clients
+		RedirectURL:   env.GetEnv("OIDC_REDIRECT_URL", ""),  // defaults to the callback on this server
+		Scopes:        strings.Fields(env.GetEnv("OIDC_SCOPES", "openid email profile")),
+		MatchClaim:    env.GetEnv("OIDC_MATCH_CLAIM", "email"), // "email", "sub"
+		AutoProvision: env.GetEnv("OIDC_AUTO_PROVISION", false),
+		DefaultRole:   env.GetEnv("OIDC_DEFAULT_ROLE", "employee"),
+		StateTTL:      env.GetEnv("OIDC_STATE_TTL", 10), // in minutes
+		Timeout:       env.GetEnv("OIDC_TIMEOUT", 10),   // in seconds
 	}
 }
 
@@ -115,3 +300,56 @@ func loadLoggerConfig() LoggerConfig {
 		LogDir:     env.GetEnv("LOG_DIR", "logger"),       // directory for log files
 	}
 }
+
+func loadGeofenceConfig() GeofenceConfig {
+	return GeofenceConfig{
+		Mode:        env.GetEnv("GEOFENCE_MODE", "flag"),        // "disabled", "flag", "reject"
+		MaxAccuracy: env.GetEnv("GEOFENCE_MAX_ACCURACY", 100.0), // in meters
+	}
+}
+
+func loadAttendanceConfig() AttendanceConfig {
+	return AttendanceConfig{
+		WorkStartTime:     env.GetEnv("WORK_START_TIME", "09:00"),            // default scheduled start of day (HH:MM)
+		WorkEndTime:       env.GetEnv("WORK_END_TIME", "17:00"),              // default scheduled end of day (HH:MM)
+		AutoCloseEnabled:  env.GetEnv("ATTENDANCE_AUTO_CLOSE_ENABLED", true), // close forgotten check-outs automatically
+		AutoCloseCutoff:   env.GetEnv("ATTENDANCE_AUTO_CLOSE_CUTOFF", 4),     // in hours after scheduled end
+		AutoCloseInterval: env.GetEnv("ATTENDANCE_AUTO_CLOSE_INTERVAL", 15),  // in minutes
+		Holidays:          env.GetEnv("HOLIDAYS", ""),                        // comma separated dates (YYYY-MM-DD)
+	}
+}
+
+func loadOvertimeConfig() OvertimeConfig {
+	return OvertimeConfig{
+		ProposalEnabled:    env.GetEnv("OVERTIME_PROPOSAL_ENABLED", false),  // propose overtime from attendance
+		ProposalMinMinutes: env.GetEnv("OVERTIME_PROPOSAL_MIN_MINUTES", 30), // minimum minutes beyond scheduled end
+		WeeklyCapHours:     env.GetEnv("OVERTIME_WEEKLY_CAP_HOURS", 14.0),   // rolling 7 days, 0 disables the cap
+		MonthlyCapHours:    env.GetEnv("OVERTIME_MONTHLY_CAP_HOURS", 56.0),  // rolling 30 days, 0 disables the cap
+	}
+}
+
+func loadReimbursementConfig() ReimbursementConfig {
+	return ReimbursementConfig{
+		DuplicateWindowDays:      env.GetEnv("REIMBURSEMENT_DUPLICATE_WINDOW_DAYS", 3),        // days apart for claims to be compared
+		DuplicateTitleSimilarity: env.GetEnv("REIMBURSEMENT_DUPLICATE_TITLE_SIMILARITY", 0.8), // 0-1, minimum title similarity
+	}
+}
+
+func loadTerminationConfig() TerminationConfig {
+	return TerminationConfig{
+		DeactivateInterval: env.GetEnv("TERMINATION_DEACTIVATE_INTERVAL", 60), // in minutes
+	}
+}
+
+func loadStorageConfig() StorageConfig {
+	return StorageConfig{
+		Driver:      env.GetEnv("STORAGE_DRIVER", "local"), // local or s3
+		LocalPath:   env.GetEnv("STORAGE_LOCAL_PATH", "./storage"),
+		S3Endpoint:  env.GetEnv("S3_ENDPOINT", "localhost:9000"), // host[:port] without scheme
+		S3Region:    env.GetEnv("S3_REGION", "us-east-1"),
+		S3Bucket:    env.GetEnv("S3_BUCKET", "payrolls"),
+		S3AccessKey: env.GetEnv("S3_ACCESS_KEY", ""),
+		S3SecretKey: env.GetEnv("S3_SECRET_KEY", ""),
+		S3UseSSL:    env.GetEnv("S3_USE_SSL", false),
+	}
+}
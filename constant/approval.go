@@ -0,0 +1,8 @@
+package constant
+
+// Approval statuses for requests reviewed by an admin
+const (
+	ApprovalStatusPending  = "pending"
+	ApprovalStatusApproved = "approved"
+	ApprovalStatusRejected = "rejected"
+)
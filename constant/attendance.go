@@ -0,0 +1,12 @@
+package constant
+
+// AttendanceImportMaxSize limits uploaded punch logs to 10 MB
+const AttendanceImportMaxSize = 10 << 20
+
+// Attendance record sources
+const (
+	AttendanceSourceManual     = "manual"
+	AttendanceSourceImport     = "import"
+	AttendanceSourceCorrection = "correction"
+	AttendanceSourceAdmin      = "admin"
+)
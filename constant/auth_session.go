@@ -0,0 +1,13 @@
+package constant
+
+// Reasons an auth session is revoked
+const (
+	SessionRevokedRotated        = "rotated"          // refresh token exchanged for a new pair
+	SessionRevokedLogout         = "logout"           // user signed out
+	SessionRevokedReuse          = "reuse_detected"   // a rotated refresh token was presented again
+	SessionRevokedByAdmin        = "admin"            // admin revoked all sessions of the user
+	SessionRevokedDeactivated    = "deactivated"      // user was deactivated
+	SessionRevokedPasswordReset  = "password_reset"   // password was set with an admin issued reset token
+	SessionRevokedPasswordChange = "password_change"  // user changed their own password
+	SessionRevokedTwoFactorReset = "two_factor_reset" // admin reset the two-factor authentication of the user
+)
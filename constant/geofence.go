@@ -0,0 +1,8 @@
+package constant
+
+// Geofence enforcement modes
+const (
+	GeofenceModeDisabled = "disabled"
+	GeofenceModeFlag     = "flag"
+	GeofenceModeReject   = "reject"
+)
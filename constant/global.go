@@ -4,3 +4,17 @@ const (
 	AdminRole    = "admin"
 	EmployeeRole = "employee"
 )
+
+// EmployeeImportMaxSize limits uploaded employee sheets to 5 MB
+const EmployeeImportMaxSize = 5 << 20
+
+// UploadBodyOverhead is the room an upload request gets above its file limit for the multipart envelope and form fields
+const UploadBodyOverhead = 64 << 10
+
+// Employment types of an employee
+const (
+	EmploymentTypePermanent = "permanent"
+	EmploymentTypeContract  = "contract"
+	EmploymentTypeProbation = "probation"
+	EmploymentTypeIntern    = "intern"
+)
@@ -0,0 +1,15 @@
+package constant
+
+// Outcomes recorded for a login attempt
+const (
+	LoginAttemptSuccess            = "success"
+	LoginAttemptInvalidCredentials = "invalid_credentials"  // unknown username or wrong password
+	LoginAttemptDeactivated        = "deactivated"          // correct password for a deactivated account
+	LoginAttemptAccountLocked      = "account_locked"       // account is locked after too many failures
+	LoginAttemptAccountThrottled   = "account_throttled"    // attempted before the progressive delay of the account passed
+	LoginAttemptIPBlocked          = "ip_blocked"           // too many failures from the same IP
+	LoginAttemptIPThrottled        = "ip_throttled"         // attempted before the progressive delay of the IP passed
+	LoginAttemptTwoFactorChallenge = "two_factor_challenge" // correct password, waiting for the second factor
+	LoginAttemptInvalidTwoFactor   = "invalid_two_factor"   // wrong or reused authenticator or recovery code
+	LoginAttemptSSOUnknownUser     = "sso_unknown_user"     // signed in at the identity provider without a matching account
+)
@@ -0,0 +1,10 @@
+package constant
+
+// Notification types sent to users
+const (
+	NotificationTypeAttendanceAutoClosed  = "attendance_auto_closed"
+	NotificationTypeOvertimeApproved      = "overtime_approved"
+	NotificationTypeOvertimeRejected      = "overtime_rejected"
+	NotificationTypeReimbursementApproved = "reimbursement_approved"
+	NotificationTypeReimbursementRejected = "reimbursement_rejected"
+)
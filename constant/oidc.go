@@ -0,0 +1,7 @@
+package constant
+
+// Claims a single sign-on is matched against an existing user by
+const (
+	OIDCMatchEmail   = "email" // verified email claim against users.email
+	OIDCMatchSubject = "sub"   // only subjects an admin linked to a user
+)
@@ -0,0 +1,7 @@
+package constant
+
+// Overtime record sources
+const (
+	OvertimeSourceDeclared = "declared"
+	OvertimeSourceProposal = "proposal"
+)
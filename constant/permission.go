@@ -0,0 +1,26 @@
+package constant
+
+// Permissions granted to roles, routes are protected by these codes
+const (
+	PermissionAttendanceSelf              = "attendance:self"               // check in and out, own corrections and timesheet
+	PermissionOvertimeSelf                = "overtime:self"                 // submit own overtime
+	PermissionReimbursementSelf           = "reimbursement:self"            // submit own reimbursement claims
+	PermissionPayslipSelf                 = "payslip:self"                  // view own payslips
+	PermissionUserManage                  = "user:manage"                   // create, update and deactivate users and their profiles
+	PermissionEmployeeReadSensitive       = "employee:read:sensitive"       // see unmasked NIK, NPWP and bank account numbers
+	PermissionRoleManage                  = "role:manage"                   // manage roles and assign them to users
+	PermissionPeriodManage                = "period:manage"                 // manage payroll periods
+	PermissionPayrollRun                  = "payroll:run"                   // run payroll for a period
+	PermissionPayrollReport               = "payroll:report"                // generate payslip summaries
+	PermissionAttendanceReadAll           = "attendance:read:all"           // view attendance and timesheets of every employee
+	PermissionAttendanceManage            = "attendance:manage"             // record attendance for employees, imports and device users
+	PermissionAttendanceCorrectionReview  = "attendance_correction:review"  // approve or reject attendance corrections
+	PermissionOvertimeReview              = "overtime:review"               // approve or reject overtime
+	PermissionReimbursementReview         = "reimbursement:review"          // approve or reject reimbursement claims
+	PermissionReimbursementCategoryManage = "reimbursement_category:manage" // manage reimbursement categories and limits
+	PermissionOfficeLocationManage        = "office_location:manage"        // manage office locations and geofence overrides
+	PermissionAPIKeyManage                = "api_key:manage"                // issue and revoke API keys for integrations
+	PermissionDepartmentManage            = "department:manage"             // manage departments
+	PermissionTeamReview                  = "team:review"                   // view and review requests of direct and indirect reports
+	PermissionTerminationManage           = "termination:manage"            // record terminations and settle the final pay
+)
@@ -0,0 +1,19 @@
+package constant
+
+// ReceiptMaxSize limits uploaded reimbursement receipts to 5 MB
+const ReceiptMaxSize = 5 << 20
+
+// Reimbursement statuses, a claim is submitted, reviewed by an admin and paid by payroll
+const (
+	ReimbursementStatusSubmitted         = "submitted"
+	ReimbursementStatusApproved          = "approved"
+	ReimbursementStatusPartiallyApproved = "partially_approved"
+	ReimbursementStatusRejected          = "rejected"
+	ReimbursementStatusPaid              = "paid"
+)
+
+// Reasons a reimbursement is flagged as a suspected duplicate
+const (
+	DuplicateReasonSimilarClaim = "similar_claim" // same amount, close date and similar title
+	DuplicateReasonSameReceipt  = "same_receipt"  // identical attachment file
+)
@@ -2,6 +2,7 @@ package constant
 
 const (
 	StatusActive     = 1
+	StatusInactive   = 2
 	StatusProcessing = 5
 	StatusCompleted  = 6
 	StatusFailed     = 8
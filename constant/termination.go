@@ -0,0 +1,7 @@
+package constant
+
+// Termination statuses, a scheduled termination becomes settled once its final pay is calculated
+const (
+	TerminationStatusScheduled = "scheduled"
+	TerminationStatusSettled   = "settled"
+)
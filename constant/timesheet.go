@@ -0,0 +1,17 @@
+package constant
+
+// Timesheet day statuses
+const (
+	TimesheetStatusPresent = "present"
+	TimesheetStatusAbsent  = "absent"
+	TimesheetStatusLeave   = "leave"
+	TimesheetStatusHoliday = "holiday"
+	TimesheetStatusWeekend = "weekend"
+)
+
+// Timesheet export formats
+const (
+	TimesheetFormatJSON = "json"
+	TimesheetFormatCSV  = "csv"
+	TimesheetFormatXLSX = "xlsx"
+)
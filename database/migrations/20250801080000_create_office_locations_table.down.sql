@@ -0,0 +1,26 @@
+-- Drop attendance location columns
+ALTER TABLE attendances
+    DROP CONSTRAINT IF EXISTS fk_attendances_check_in_office_location_id,
+    DROP CONSTRAINT IF EXISTS fk_attendances_check_out_office_location_id,
+    DROP COLUMN IF EXISTS check_in_latitude,
+    DROP COLUMN IF EXISTS check_in_longitude,
+    DROP COLUMN IF EXISTS check_in_accuracy,
+    DROP COLUMN IF EXISTS check_in_office_location_id,
+    DROP COLUMN IF EXISTS check_in_outside_geofence,
+    DROP COLUMN IF EXISTS check_out_latitude,
+    DROP COLUMN IF EXISTS check_out_longitude,
+    DROP COLUMN IF EXISTS check_out_accuracy,
+    DROP COLUMN IF EXISTS check_out_office_location_id,
+    DROP COLUMN IF EXISTS check_out_outside_geofence;
+
+-- Drop triggers
+DROP TRIGGER IF EXISTS update_geofence_overrides_updated_columns ON geofence_overrides;
+DROP TRIGGER IF EXISTS update_office_locations_updated_columns ON office_locations;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_geofence_overrides_user_id;
+DROP INDEX IF EXISTS idx_office_locations_status;
+
+-- Drop tables
+DROP TABLE IF EXISTS geofence_overrides;
+DROP TABLE IF EXISTS office_locations;
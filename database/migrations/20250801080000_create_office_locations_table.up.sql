@@ -0,0 +1,61 @@
+CREATE TABLE office_locations (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    address TEXT,
+    latitude DECIMAL(10,7) NOT NULL,
+    longitude DECIMAL(10,7) NOT NULL,
+    radius_meters INTEGER NOT NULL DEFAULT 100,
+    status SMALLINT DEFAULT 1,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes
+CREATE INDEX idx_office_locations_status ON office_locations(status);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_office_locations_updated_columns
+    BEFORE UPDATE ON office_locations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_columns();
+
+-- Users allowed to check in outside of every office geofence (remote workers)
+CREATE TABLE geofence_overrides (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    reason VARCHAR(255),
+    valid_until TIMESTAMP WITH TIME ZONE,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE,
+
+    -- Foreign key constraint
+    CONSTRAINT fk_geofence_overrides_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE UNIQUE INDEX idx_geofence_overrides_user_id ON geofence_overrides(user_id);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_geofence_overrides_updated_columns
+    BEFORE UPDATE ON geofence_overrides
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_columns();
+
+-- Store device location on attendance
+ALTER TABLE attendances
+    ADD COLUMN check_in_latitude DECIMAL(10,7),
+    ADD COLUMN check_in_longitude DECIMAL(10,7),
+    ADD COLUMN check_in_accuracy DECIMAL(8,2),
+    ADD COLUMN check_in_office_location_id BIGINT,
+    ADD COLUMN check_in_outside_geofence BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN check_out_latitude DECIMAL(10,7),
+    ADD COLUMN check_out_longitude DECIMAL(10,7),
+    ADD COLUMN check_out_accuracy DECIMAL(8,2),
+    ADD COLUMN check_out_office_location_id BIGINT,
+    ADD COLUMN check_out_outside_geofence BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT fk_attendances_check_in_office_location_id FOREIGN KEY (check_in_office_location_id) REFERENCES office_locations(id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_attendances_check_out_office_location_id FOREIGN KEY (check_out_office_location_id) REFERENCES office_locations(id) ON DELETE SET NULL;
@@ -0,0 +1,19 @@
+-- Drop attendance source columns
+ALTER TABLE attendances
+    DROP CONSTRAINT IF EXISTS fk_attendances_import_id,
+    DROP COLUMN IF EXISTS import_id,
+    DROP COLUMN IF EXISTS source;
+
+-- Drop triggers
+DROP TRIGGER IF EXISTS update_attendance_imports_updated_columns ON attendance_imports;
+DROP TRIGGER IF EXISTS update_device_users_updated_columns ON device_users;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_attendance_imports_created_at;
+DROP INDEX IF EXISTS idx_attendance_imports_file_hash;
+DROP INDEX IF EXISTS idx_device_users_user_id;
+DROP INDEX IF EXISTS idx_device_users_device_user_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS attendance_imports;
+DROP TABLE IF EXISTS device_users;
@@ -0,0 +1,57 @@
+-- Map fingerprint terminal user IDs to application users
+CREATE TABLE device_users (
+    id BIGSERIAL PRIMARY KEY,
+    device_user_id VARCHAR(50) NOT NULL,
+    user_id BIGINT NOT NULL,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE,
+
+    -- Foreign key constraint
+    CONSTRAINT fk_device_users_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE UNIQUE INDEX idx_device_users_device_user_id ON device_users(device_user_id);
+CREATE INDEX idx_device_users_user_id ON device_users(user_id);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_device_users_updated_columns
+    BEFORE UPDATE ON device_users
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_columns();
+
+-- History of punch log imports
+CREATE TABLE attendance_imports (
+    id BIGSERIAL PRIMARY KEY,
+    file_name VARCHAR(255) NOT NULL,
+    file_hash VARCHAR(64) NOT NULL,
+    total_punches INTEGER NOT NULL DEFAULT 0,
+    total_days INTEGER NOT NULL DEFAULT 0,
+    created_count INTEGER NOT NULL DEFAULT 0,
+    updated_count INTEGER NOT NULL DEFAULT 0,
+    skipped_count INTEGER NOT NULL DEFAULT 0,
+    conflict_count INTEGER NOT NULL DEFAULT 0,
+    error_count INTEGER NOT NULL DEFAULT 0,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes
+CREATE INDEX idx_attendance_imports_file_hash ON attendance_imports(file_hash);
+CREATE INDEX idx_attendance_imports_created_at ON attendance_imports(created_at);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_attendance_imports_updated_columns
+    BEFORE UPDATE ON attendance_imports
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_columns();
+
+-- Track where an attendance record comes from
+ALTER TABLE attendances
+    ADD COLUMN source VARCHAR(20) NOT NULL DEFAULT 'manual',
+    ADD COLUMN import_id BIGINT,
+    ADD CONSTRAINT fk_attendances_import_id FOREIGN KEY (import_id) REFERENCES attendance_imports(id) ON DELETE SET NULL;
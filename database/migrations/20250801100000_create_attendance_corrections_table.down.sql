@@ -0,0 +1,10 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_attendance_corrections_updated_columns ON attendance_corrections;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_attendance_corrections_status;
+DROP INDEX IF EXISTS idx_attendance_corrections_attendance_id;
+DROP INDEX IF EXISTS idx_attendance_corrections_user_id;
+
+-- Drop table
+DROP TABLE IF EXISTS attendance_corrections;
@@ -0,0 +1,35 @@
+-- Attendance correction requests proposed by employees
+CREATE TABLE attendance_corrections (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    attendance_id BIGINT,
+    original_check_in_date TIMESTAMP WITH TIME ZONE NULL,
+    original_check_out_date TIMESTAMP WITH TIME ZONE NULL,
+    requested_check_in_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    requested_check_out_date TIMESTAMP WITH TIME ZONE NULL,
+    reason TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reviewed_by BIGINT,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_comment TEXT,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_attendance_corrections_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_attendance_corrections_attendance_id FOREIGN KEY (attendance_id) REFERENCES attendances(id) ON DELETE SET NULL,
+    CONSTRAINT fk_attendance_corrections_reviewed_by FOREIGN KEY (reviewed_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Create indexes
+CREATE INDEX idx_attendance_corrections_user_id ON attendance_corrections(user_id);
+CREATE INDEX idx_attendance_corrections_attendance_id ON attendance_corrections(attendance_id);
+CREATE INDEX idx_attendance_corrections_status ON attendance_corrections(status);
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_attendance_corrections_updated_columns
+    BEFORE UPDATE ON attendance_corrections
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_columns();
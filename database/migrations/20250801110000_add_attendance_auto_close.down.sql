@@ -0,0 +1,14 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_notifications_created_at;
+DROP INDEX IF EXISTS idx_notifications_user_id;
+DROP INDEX IF EXISTS idx_attendances_open;
+
+-- Drop table
+DROP TABLE IF EXISTS notifications;
+
+-- Drop auto-close columns
+ALTER TABLE attendances
+    DROP COLUMN IF EXISTS auto_closed;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS work_end_time;
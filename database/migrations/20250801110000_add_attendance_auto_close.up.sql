@@ -0,0 +1,28 @@
+-- Scheduled end of the working day per employee, NULL uses WORK_END_TIME
+ALTER TABLE users
+    ADD COLUMN work_end_time TIME;
+
+-- Mark attendances closed by the auto-close job
+ALTER TABLE attendances
+    ADD COLUMN auto_closed BOOLEAN NOT NULL DEFAULT false;
+
+-- Speed up lookup of attendances without check-out
+CREATE INDEX idx_attendances_open ON attendances(check_in_date) WHERE check_out_date IS NULL;
+
+-- In-app notifications for users
+CREATE TABLE notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign key constraint
+    CONSTRAINT fk_notifications_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_notifications_user_id ON notifications(user_id);
+CREATE INDEX idx_notifications_created_at ON notifications(created_at);
@@ -0,0 +1,3 @@
+-- Drop work start column
+ALTER TABLE users
+    DROP COLUMN IF EXISTS work_start_time;
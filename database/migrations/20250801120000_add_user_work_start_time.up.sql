@@ -0,0 +1,3 @@
+-- Scheduled start of the working day per employee, NULL uses WORK_START_TIME
+ALTER TABLE users
+    ADD COLUMN work_start_time TIME;
@@ -0,0 +1,4 @@
+-- Drop overtime measurement columns
+ALTER TABLE overtimes
+    DROP COLUMN IF EXISTS source,
+    DROP COLUMN IF EXISTS actual_hours;
@@ -0,0 +1,4 @@
+-- Overtime hours measured from attendance, NULL when there is no completed attendance
+ALTER TABLE overtimes
+    ADD COLUMN actual_hours DECIMAL(5,2),
+    ADD COLUMN source VARCHAR(20) NOT NULL DEFAULT 'declared';
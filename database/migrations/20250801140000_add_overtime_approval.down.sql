@@ -0,0 +1,9 @@
+-- Drop overtime approval
+DROP INDEX IF EXISTS idx_overtimes_status;
+
+ALTER TABLE overtimes
+    DROP CONSTRAINT IF EXISTS fk_overtimes_reviewed_by,
+    DROP COLUMN IF EXISTS review_comment,
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS reviewed_by,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,11 @@
+-- Overtime approval, overtimes recorded before the workflow were already paid and stay approved
+ALTER TABLE overtimes
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'approved',
+    ADD COLUMN reviewed_by BIGINT,
+    ADD COLUMN reviewed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN review_comment TEXT,
+    ADD CONSTRAINT fk_overtimes_reviewed_by FOREIGN KEY (reviewed_by) REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE overtimes ALTER COLUMN status SET DEFAULT 'pending';
+
+CREATE INDEX idx_overtimes_status ON overtimes(status);
@@ -0,0 +1,19 @@
+-- Receipts attached to reimbursements, the file itself lives in the configured storage
+CREATE TABLE reimbursement_attachments (
+    id BIGSERIAL PRIMARY KEY,
+    reimbursement_id BIGINT NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    checksum VARCHAR(64) NOT NULL,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_reimbursement_attachments_reimbursement_id FOREIGN KEY (reimbursement_id) REFERENCES reimbursements(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_reimbursement_attachments_reimbursement_id ON reimbursement_attachments(reimbursement_id);
+CREATE INDEX idx_reimbursement_attachments_checksum ON reimbursement_attachments(checksum);
@@ -0,0 +1,14 @@
+-- Drop reimbursement review and payout
+DROP INDEX IF EXISTS idx_reimbursements_period_detail_id;
+DROP INDEX IF EXISTS idx_reimbursements_status;
+
+ALTER TABLE reimbursements
+    DROP CONSTRAINT IF EXISTS fk_reimbursements_period_detail_id,
+    DROP CONSTRAINT IF EXISTS fk_reimbursements_reviewed_by,
+    DROP COLUMN IF EXISTS paid_at,
+    DROP COLUMN IF EXISTS period_detail_id,
+    DROP COLUMN IF EXISTS review_comment,
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS reviewed_by,
+    DROP COLUMN IF EXISTS approved_amount,
+    DROP COLUMN IF EXISTS status;
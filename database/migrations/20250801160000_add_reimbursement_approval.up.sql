@@ -0,0 +1,28 @@
+-- Reimbursement review and payout, claims filed before the workflow count as approved
+-- and those already covered by a completed payroll are marked paid
+ALTER TABLE reimbursements
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'approved',
+    ADD COLUMN approved_amount DECIMAL(10,2),
+    ADD COLUMN reviewed_by BIGINT,
+    ADD COLUMN reviewed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN review_comment TEXT,
+    ADD COLUMN period_detail_id BIGINT,
+    ADD COLUMN paid_at TIMESTAMP WITH TIME ZONE,
+    ADD CONSTRAINT fk_reimbursements_reviewed_by FOREIGN KEY (reviewed_by) REFERENCES users(id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_reimbursements_period_detail_id FOREIGN KEY (period_detail_id) REFERENCES period_details(id);
+
+UPDATE reimbursements SET approved_amount = amount;
+
+UPDATE reimbursements r
+SET status = 'paid', period_detail_id = pd.id, paid_at = p.payroll_date
+FROM period_details pd
+JOIN periods p ON p.id = pd.periods_id
+WHERE pd.user_id = r.user_id
+    -- 6 is constant.StatusCompleted (constant/status.go), keep in sync if the status values change
+    AND p.status = 6
+    AND date(r.date) BETWEEN date(p.start_date) AND date(p.end_date);
+
+ALTER TABLE reimbursements ALTER COLUMN status SET DEFAULT 'submitted';
+
+CREATE INDEX idx_reimbursements_status ON reimbursements(status);
+CREATE INDEX idx_reimbursements_period_detail_id ON reimbursements(period_detail_id);
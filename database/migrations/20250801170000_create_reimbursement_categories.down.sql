@@ -0,0 +1,12 @@
+-- Drop reimbursement categories
+ALTER TABLE users
+    DROP COLUMN IF EXISTS employment_type,
+    DROP COLUMN IF EXISTS grade;
+
+DROP INDEX IF EXISTS idx_reimbursements_user_category_date;
+
+ALTER TABLE reimbursements
+    DROP CONSTRAINT IF EXISTS fk_reimbursements_category_id,
+    DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS reimbursement_categories;
@@ -0,0 +1,29 @@
+-- Reimbursement categories with policy limits, a NULL limit is unlimited and an empty
+-- eligibility list allows every grade or employment type
+CREATE TABLE reimbursement_categories (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    per_claim_limit DECIMAL(15,2),
+    monthly_limit DECIMAL(15,2),
+    yearly_limit DECIMAL(15,2),
+    eligible_grades TEXT NOT NULL DEFAULT '',
+    eligible_employment_types TEXT NOT NULL DEFAULT '',
+    status SMALLINT DEFAULT 1,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+ALTER TABLE reimbursements
+    ADD COLUMN category_id BIGINT,
+    ADD CONSTRAINT fk_reimbursements_category_id FOREIGN KEY (category_id) REFERENCES reimbursement_categories(id);
+
+CREATE INDEX idx_reimbursements_user_category_date ON reimbursements(user_id, category_id, date);
+
+-- Grade and employment type decide category eligibility
+ALTER TABLE users
+    ADD COLUMN grade VARCHAR(20),
+    ADD COLUMN employment_type VARCHAR(20);
@@ -0,0 +1,7 @@
+-- Drop suspected duplicate reimbursement claims
+DROP TABLE IF EXISTS reimbursement_duplicates;
+
+DROP INDEX IF EXISTS idx_reimbursements_suspected_duplicate;
+
+ALTER TABLE reimbursements
+    DROP COLUMN IF EXISTS suspected_duplicate;
@@ -0,0 +1,21 @@
+-- Suspected duplicate reimbursement claims, one row per matched claim and reason
+ALTER TABLE reimbursements
+    ADD COLUMN suspected_duplicate BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE reimbursement_duplicates (
+    id BIGSERIAL PRIMARY KEY,
+    reimbursement_id BIGINT NOT NULL,
+    matched_reimbursement_id BIGINT NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    title_similarity DECIMAL(5,4),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_reimbursement_duplicates_reimbursement_id FOREIGN KEY (reimbursement_id) REFERENCES reimbursements(id) ON DELETE CASCADE,
+    CONSTRAINT fk_reimbursement_duplicates_matched_reimbursement_id FOREIGN KEY (matched_reimbursement_id) REFERENCES reimbursements(id) ON DELETE CASCADE,
+    CONSTRAINT uq_reimbursement_duplicates UNIQUE (reimbursement_id, matched_reimbursement_id, reason)
+);
+
+-- Create indexes
+CREATE INDEX idx_reimbursement_duplicates_matched_reimbursement_id ON reimbursement_duplicates(matched_reimbursement_id);
+CREATE INDEX idx_reimbursements_suspected_duplicate ON reimbursements(suspected_duplicate) WHERE suspected_duplicate;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_users_status;
+ALTER TABLE users
+    DROP COLUMN status;
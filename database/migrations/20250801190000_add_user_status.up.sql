@@ -0,0 +1,5 @@
+-- Deactivated users (status 2) keep their history but cannot log in and are left out of payroll
+ALTER TABLE users
+    ADD COLUMN status SMALLINT NOT NULL DEFAULT 1;
+
+CREATE INDEX idx_users_status ON users(status);
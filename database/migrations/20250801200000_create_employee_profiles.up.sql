@@ -0,0 +1,25 @@
+-- HR master data of an employee, one row per user
+CREATE TABLE employee_profiles (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL UNIQUE,
+    full_name VARCHAR(150) NOT NULL,
+    employee_number VARCHAR(30) NOT NULL UNIQUE,
+    nik CHAR(16) UNIQUE,
+    npwp VARCHAR(16),
+    bank_name VARCHAR(50),
+    bank_account_number VARCHAR(30),
+    bank_account_name VARCHAR(150),
+    department VARCHAR(100),
+    position VARCHAR(100),
+    join_date DATE,
+    phone VARCHAR(20),
+    personal_email VARCHAR(150),
+    address TEXT,
+    emergency_contact_name VARCHAR(150),
+    emergency_contact_phone VARCHAR(20),
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_employee_profiles_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
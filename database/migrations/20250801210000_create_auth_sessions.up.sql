@@ -0,0 +1,19 @@
+-- Server-side sessions, one row per issued access/refresh token pair. Rotating a refresh token
+-- revokes its row and inserts a new one in the same family
+CREATE TABLE auth_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    family_id VARCHAR(36) NOT NULL,
+    token_id VARCHAR(36) NOT NULL UNIQUE,
+    refresh_token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    revoked_reason VARCHAR(30),
+    ip VARCHAR(45),
+    user_agent TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_auth_sessions_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_auth_sessions_user_id ON auth_sessions(user_id);
+CREATE INDEX idx_auth_sessions_family_id ON auth_sessions(family_id);
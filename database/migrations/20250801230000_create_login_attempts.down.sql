@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS login_attempts;
+ALTER TABLE users
+    DROP COLUMN failed_login_count,
+    DROP COLUMN last_failed_login_at,
+    DROP COLUMN locked_until;
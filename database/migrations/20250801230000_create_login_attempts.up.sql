@@ -0,0 +1,21 @@
+-- Consecutive failed logins per account, the account is locked once the limit is reached
+ALTER TABLE users
+    ADD COLUMN failed_login_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN last_failed_login_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN locked_until TIMESTAMP WITH TIME ZONE;
+
+-- Every login attempt, used to throttle by IP including attempts on unknown usernames
+CREATE TABLE login_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    username VARCHAR(50) NOT NULL,
+    user_id BIGINT,
+    ip VARCHAR(45) NOT NULL,
+    success BOOLEAN NOT NULL DEFAULT FALSE,
+    reason VARCHAR(30) NOT NULL,
+    user_agent TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_login_attempts_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_login_attempts_ip_created_at ON login_attempts(ip, created_at);
+CREATE INDEX idx_login_attempts_user_id ON login_attempts(user_id);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS sync_user_system_role ON users;
+DROP FUNCTION IF EXISTS sync_user_system_role();
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
+DROP TABLE IF EXISTS permissions;
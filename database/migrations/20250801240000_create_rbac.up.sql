@@ -0,0 +1,120 @@
+-- Fine-grained permissions, routes are protected by their code
+CREATE TABLE permissions (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    description VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Roles group permissions. System roles mirror users.roles and cannot be deleted
+CREATE TABLE roles (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    description VARCHAR(255),
+    is_system BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE role_permissions (
+    role_id BIGINT NOT NULL,
+    permission_id BIGINT NOT NULL,
+    PRIMARY KEY (role_id, permission_id),
+    CONSTRAINT fk_role_permissions_role_id FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_role_permissions_permission_id FOREIGN KEY (permission_id) REFERENCES permissions(id) ON DELETE CASCADE
+);
+
+-- A user may hold several roles, their permissions are combined
+CREATE TABLE user_roles (
+    user_id BIGINT NOT NULL,
+    role_id BIGINT NOT NULL,
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, role_id),
+    CONSTRAINT fk_user_roles_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_roles_role_id FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_roles_role_id ON user_roles(role_id);
+
+INSERT INTO permissions (code, description) VALUES
+    ('attendance:self', 'Check in and out, own attendance corrections and timesheet'),
+    ('overtime:self', 'Submit own overtime'),
+    ('reimbursement:self', 'Submit own reimbursement claims'),
+    ('payslip:self', 'View own payslips'),
+    ('user:manage', 'Create, update and deactivate users and their profiles'),
+    ('employee:read:sensitive', 'View unmasked NIK, NPWP and bank account numbers'),
+    ('role:manage', 'Manage roles and assign them to users'),
+    ('period:manage', 'Manage payroll periods'),
+    ('payroll:run', 'Run payroll for a period'),
+    ('payroll:report', 'Generate payslip summaries'),
+    ('attendance:read:all', 'View attendance and timesheets of every employee'),
+    ('attendance:manage', 'Record attendance for employees, attendance imports and device users'),
+    ('attendance_correction:review', 'Approve or reject attendance corrections'),
+    ('overtime:review', 'Approve or reject overtime'),
+    ('reimbursement:review', 'Approve or reject reimbursement claims'),
+    ('reimbursement_category:manage', 'Manage reimbursement categories and limits'),
+    ('office_location:manage', 'Manage office locations and geofence overrides');
+
+INSERT INTO roles (name, description, is_system) VALUES
+    ('admin', 'Full access', TRUE),
+    ('employee', 'Employee self service', TRUE),
+    ('hr', 'Human resources', FALSE),
+    ('finance', 'Payroll and reimbursement', FALSE),
+    ('manager', 'Reviews team attendance, overtime and reimbursement', FALSE);
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p WHERE r.name = 'admin';
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code IN (
+    'attendance:self', 'overtime:self', 'reimbursement:self', 'payslip:self'
+) WHERE r.name = 'employee';
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code IN (
+    'user:manage', 'employee:read:sensitive', 'period:manage', 'attendance:read:all', 'attendance:manage',
+    'attendance_correction:review', 'overtime:review', 'office_location:manage'
+) WHERE r.name = 'hr';
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code IN (
+    'period:manage', 'payroll:run', 'payroll:report', 'reimbursement:review', 'reimbursement_category:manage'
+) WHERE r.name = 'finance';
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code IN (
+    'attendance:read:all', 'attendance_correction:review', 'overtime:review', 'reimbursement:review'
+) WHERE r.name = 'manager';
+
+-- Existing users keep their access through the system role matching their account type
+INSERT INTO user_roles (user_id, role_id)
+SELECT u.id, r.id FROM users u JOIN roles r ON r.name = u.roles::text;
+
+-- New users, and users whose account type changes, get the matching system role
+CREATE OR REPLACE FUNCTION sync_user_system_role()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        IF OLD.roles IS NOT DISTINCT FROM NEW.roles THEN
+            RETURN NEW;
+        END IF;
+        DELETE FROM user_roles
+        WHERE user_id = NEW.id
+          AND role_id = (SELECT id FROM roles WHERE name = OLD.roles::text AND is_system);
+    END IF;
+
+    INSERT INTO user_roles (user_id, role_id, created_by)
+    SELECT NEW.id, id, COALESCE(NEW.updated_by, NEW.created_by) FROM roles WHERE name = NEW.roles::text AND is_system
+    ON CONFLICT DO NOTHING;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER sync_user_system_role
+    AFTER INSERT OR UPDATE OF roles ON users
+    FOR EACH ROW
+    EXECUTE FUNCTION sync_user_system_role();
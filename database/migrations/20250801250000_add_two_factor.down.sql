@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS two_factor_challenges;
+DROP TABLE IF EXISTS two_factor_recovery_codes;
+
+ALTER TABLE roles
+    DROP COLUMN IF EXISTS requires_two_factor;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS two_factor_last_step,
+    DROP COLUMN IF EXISTS two_factor_enabled_at,
+    DROP COLUMN IF EXISTS two_factor_enabled,
+    DROP COLUMN IF EXISTS two_factor_secret;
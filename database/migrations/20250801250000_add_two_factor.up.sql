@@ -0,0 +1,39 @@
+-- TOTP secret is stored encrypted, it is only trusted once two_factor_enabled is set
+ALTER TABLE users
+    ADD COLUMN two_factor_secret TEXT,
+    ADD COLUMN two_factor_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN two_factor_enabled_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN two_factor_last_step BIGINT NOT NULL DEFAULT 0;
+
+-- Holders of these roles must enrol before they can use anything else
+ALTER TABLE roles
+    ADD COLUMN requires_two_factor BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE roles SET requires_two_factor = TRUE WHERE name IN ('admin', 'hr', 'finance');
+
+-- One-time codes for when the authenticator is lost, only the hash is stored
+CREATE TABLE two_factor_recovery_codes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_two_factor_recovery_codes_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_two_factor_recovery_codes_user_code UNIQUE (user_id, code_hash)
+);
+
+-- Issued after the password is verified, exchanged for a session together with the second factor
+CREATE TABLE two_factor_challenges (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    used_at TIMESTAMP WITH TIME ZONE,
+    ip VARCHAR(45),
+    user_agent TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_two_factor_challenges_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_two_factor_challenges_user_id ON two_factor_challenges(user_id);
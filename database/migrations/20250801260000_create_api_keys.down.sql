@@ -0,0 +1,7 @@
+DELETE FROM permissions WHERE code = 'api_key:manage';
+
+DROP INDEX IF EXISTS idx_audit_trails_api_key_id;
+ALTER TABLE audit_trails DROP COLUMN IF EXISTS api_key_id;
+
+DROP TABLE IF EXISTS api_key_permissions;
+DROP TABLE IF EXISTS api_keys;
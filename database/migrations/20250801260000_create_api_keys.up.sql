@@ -0,0 +1,33 @@
+-- Keys for system-to-system integrations, only the SHA-256 of the key is stored
+CREATE TABLE api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL UNIQUE,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    last_used_ip VARCHAR(45),
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    revoked_by BIGINT,
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_api_keys_created_by FOREIGN KEY (created_by) REFERENCES users(id)
+);
+
+-- Scopes of a key, a key never gets more than its creator currently holds
+CREATE TABLE api_key_permissions (
+    api_key_id BIGINT NOT NULL,
+    permission_id BIGINT NOT NULL,
+    PRIMARY KEY (api_key_id, permission_id),
+    CONSTRAINT fk_api_key_permissions_api_key_id FOREIGN KEY (api_key_id) REFERENCES api_keys(id) ON DELETE CASCADE,
+    CONSTRAINT fk_api_key_permissions_permission_id FOREIGN KEY (permission_id) REFERENCES permissions(id) ON DELETE CASCADE
+);
+
+ALTER TABLE audit_trails ADD COLUMN api_key_id BIGINT;
+CREATE INDEX idx_audit_trails_api_key_id ON audit_trails(api_key_id);
+
+INSERT INTO permissions (code, description) VALUES
+    ('api_key:manage', 'Issue and revoke API keys for integrations');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code = 'api_key:manage' WHERE r.name = 'admin';
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS oidc_login_states;
+
+DROP INDEX IF EXISTS uq_users_oidc_subject;
+DROP INDEX IF EXISTS uq_users_email;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS oidc_subject,
+    DROP COLUMN IF EXISTS email;
@@ -0,0 +1,20 @@
+-- Corporate email is matched against the verified email claim, the subject links the account for good
+ALTER TABLE users
+    ADD COLUMN email VARCHAR(150),
+    ADD COLUMN oidc_subject VARCHAR(255);
+
+CREATE UNIQUE INDEX uq_users_email ON users(LOWER(email));
+CREATE UNIQUE INDEX uq_users_oidc_subject ON users(oidc_subject);
+
+-- One row per sign-in started at the provider, only the hash of the state parameter is stored
+CREATE TABLE oidc_login_states (
+    id BIGSERIAL PRIMARY KEY,
+    state_hash CHAR(64) NOT NULL UNIQUE,
+    nonce VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_oidc_login_states_expires_at ON oidc_login_states(expires_at);
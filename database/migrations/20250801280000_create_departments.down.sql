@@ -0,0 +1,21 @@
+UPDATE roles SET description = 'Reviews team attendance, overtime and reimbursement' WHERE name = 'manager';
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code IN (
+    'attendance:read:all', 'attendance_correction:review', 'overtime:review', 'reimbursement:review'
+) WHERE r.name = 'manager'
+ON CONFLICT DO NOTHING;
+
+DELETE FROM permissions WHERE code IN ('department:manage', 'team:review');
+
+DROP INDEX IF EXISTS idx_users_manager_id;
+DROP INDEX IF EXISTS idx_users_department_id;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS chk_users_manager_not_self,
+    DROP CONSTRAINT IF EXISTS fk_users_manager_id,
+    DROP CONSTRAINT IF EXISTS fk_users_department_id,
+    DROP COLUMN IF EXISTS manager_id,
+    DROP COLUMN IF EXISTS department_id;
+
+DROP TABLE IF EXISTS departments;
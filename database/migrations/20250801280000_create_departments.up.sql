@@ -0,0 +1,57 @@
+-- Organisational units, the free text department on employee profiles stays for display
+CREATE TABLE departments (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(255),
+    created_by BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX uq_departments_name ON departments(LOWER(name));
+
+-- Reporting line, a manager sees the requests of everyone below them
+ALTER TABLE users
+    ADD COLUMN department_id BIGINT,
+    ADD COLUMN manager_id BIGINT,
+    ADD CONSTRAINT fk_users_department_id FOREIGN KEY (department_id) REFERENCES departments(id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_users_manager_id FOREIGN KEY (manager_id) REFERENCES users(id) ON DELETE SET NULL,
+    ADD CONSTRAINT chk_users_manager_not_self CHECK (manager_id <> id);
+
+CREATE INDEX idx_users_department_id ON users(department_id);
+CREATE INDEX idx_users_manager_id ON users(manager_id);
+
+-- Departments typed on employee profiles become the initial departments
+INSERT INTO departments (name)
+SELECT DISTINCT ON (LOWER(TRIM(department))) TRIM(department)
+FROM employee_profiles
+WHERE TRIM(COALESCE(department, '')) <> ''
+ORDER BY LOWER(TRIM(department)), TRIM(department);
+
+UPDATE users u SET department_id = d.id
+FROM employee_profiles p
+JOIN departments d ON LOWER(d.name) = LOWER(TRIM(p.department))
+WHERE p.user_id = u.id;
+
+INSERT INTO permissions (code, description) VALUES
+    ('department:manage', 'Manage departments'),
+    ('team:review', 'View and review attendance, overtime and reimbursement of direct and indirect reports');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code IN ('department:manage', 'team:review') WHERE r.name = 'admin';
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code = 'department:manage' WHERE r.name = 'hr';
+
+-- Managers review their own reports instead of every employee
+DELETE FROM role_permissions
+WHERE role_id = (SELECT id FROM roles WHERE name = 'manager')
+  AND permission_id IN (SELECT id FROM permissions WHERE code IN (
+    'attendance:read:all', 'attendance_correction:review', 'overtime:review', 'reimbursement:review'
+  ));
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code = 'team:review' WHERE r.name = 'manager';
+
+UPDATE roles SET description = 'Reviews attendance, overtime and reimbursement of their reports' WHERE name = 'manager';
@@ -0,0 +1,10 @@
+DELETE FROM permissions WHERE code = 'termination:manage';
+
+ALTER TABLE reimbursements
+    DROP CONSTRAINT IF EXISTS fk_reimbursements_termination_id,
+    DROP COLUMN IF EXISTS termination_id;
+
+DROP INDEX IF EXISTS idx_terminations_last_working_day;
+DROP INDEX IF EXISTS uq_terminations_user_id;
+
+DROP TABLE IF EXISTS terminations;
@@ -0,0 +1,54 @@
+-- Employees leaving the company, the final settlement is stored on the termination once it is settled
+CREATE TABLE terminations (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    last_working_day DATE NOT NULL,
+    reason VARCHAR(30) NOT NULL,
+    notes VARCHAR(500),
+    unused_leave_days DECIMAL(5,1) NOT NULL DEFAULT 0,
+    outstanding_loan DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    separation_pay DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    status VARCHAR(20) NOT NULL DEFAULT 'scheduled',
+    deactivated_at TIMESTAMP WITH TIME ZONE,
+    settlement_start_date DATE,
+    service_months INT NOT NULL DEFAULT 0,
+    monthly_wage DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    daily_rate DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    total_working INT NOT NULL DEFAULT 0,
+    prorated_salary DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    overtime JSONB,
+    amount_overtime DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    reimbursement JSONB,
+    amount_reimbursement DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    leave_encashment DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    severance_months DECIMAL(5,2) NOT NULL DEFAULT 0,
+    severance_pay DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    service_award_months DECIMAL(5,2) NOT NULL DEFAULT 0,
+    service_award_pay DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    compensation_months DECIMAL(5,2) NOT NULL DEFAULT 0,
+    compensation_pay DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    loan_deduction DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    take_home_pay DECIMAL(15,2) NOT NULL DEFAULT 0.00,
+    settled_by BIGINT,
+    settled_at TIMESTAMP WITH TIME ZONE,
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by BIGINT,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_terminations_user_id FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT chk_terminations_status CHECK (status IN ('scheduled', 'settled'))
+);
+
+CREATE UNIQUE INDEX uq_terminations_user_id ON terminations(user_id);
+CREATE INDEX idx_terminations_last_working_day ON terminations(last_working_day);
+
+-- Approved claims still unpaid when the employee leaves are paid with the final settlement
+ALTER TABLE reimbursements
+    ADD COLUMN termination_id BIGINT,
+    ADD CONSTRAINT fk_reimbursements_termination_id FOREIGN KEY (termination_id) REFERENCES terminations(id);
+
+INSERT INTO permissions (code, description) VALUES
+    ('termination:manage', 'Record employee terminations and settle their final pay');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON p.code = 'termination:manage' WHERE r.name IN ('admin', 'hr');
@@ -0,0 +1,7 @@
+-- Drop overtime payout
+DROP INDEX IF EXISTS idx_overtimes_period_detail_id;
+
+ALTER TABLE overtimes
+    DROP CONSTRAINT IF EXISTS fk_overtimes_period_detail_id,
+    DROP COLUMN IF EXISTS paid_at,
+    DROP COLUMN IF EXISTS period_detail_id;
@@ -0,0 +1,18 @@
+-- Overtime payout, overtime approved after its period was processed is paid by the next payroll run
+-- and overtime already covered by a completed payroll is linked to the payslip that paid it
+ALTER TABLE overtimes
+    ADD COLUMN period_detail_id BIGINT,
+    ADD COLUMN paid_at TIMESTAMP WITH TIME ZONE,
+    ADD CONSTRAINT fk_overtimes_period_detail_id FOREIGN KEY (period_detail_id) REFERENCES period_details(id);
+
+UPDATE overtimes o
+SET period_detail_id = pd.id, paid_at = p.payroll_date
+FROM period_details pd
+JOIN periods p ON p.id = pd.periods_id
+WHERE pd.user_id = o.user_id
+    AND o.status = 'approved'
+    -- 6 is constant.StatusCompleted (constant/status.go), keep in sync if the status values change
+    AND p.status = 6
+    AND date(o.overtimes_date) BETWEEN date(p.start_date) AND date(p.end_date);
+
+CREATE INDEX idx_overtimes_period_detail_id ON overtimes(period_detail_id);
@@ -55,6 +55,8 @@ func (s *UserSeeder) createAdminUser() error {
 		Salary:    0,
 		CreatedBy: defaultCreatedBy, // Will be updated after admin is created
 		CreatedAt: time.Now(),
+		// Seeded passwords are well known, force a change on first login
+		MustChangePassword: true,
 	}
 
 	// Check if admin already exists
@@ -131,6 +133,8 @@ func (s *UserSeeder) createEmployeeUsers() error {
 			Salary:    salary,
 			CreatedBy: adminUser.ID,
 			CreatedAt: time.Now(),
+			// Seeded passwords are well known, force a change on first login
+			MustChangePassword: true,
 		}
 
 		// Check if user already exists
@@ -157,15 +161,16 @@ func (s *UserSeeder) createEmployeeUsers() error {
 
 // User model for seeder
 type User struct {
-	ID        uint    `gorm:"primaryKey"`
-	Username  string  `gorm:"unique;not null"`
-	Password  string  `gorm:"not null"`
-	Roles     string  `gorm:"not null"`
-	Salary    float64 `gorm:"not null"`
-	CreatedBy uint
-	CreatedAt time.Time
-	UpdatedBy *uint
-	UpdatedAt *time.Time `gorm:"autoUpdateTime:false"`
+	ID                 uint    `gorm:"primaryKey"`
+	Username           string  `gorm:"unique;not null"`
+	Password           string  `gorm:"not null"`
+	Roles              string  `gorm:"not null"`
+	Salary             float64 `gorm:"not null"`
+	CreatedBy          uint
+	CreatedAt          time.Time
+	UpdatedBy          *uint
+	UpdatedAt          *time.Time `gorm:"autoUpdateTime:false"`
+	MustChangePassword bool
 }
 
 func (User) TableName() string {
@@ -0,0 +1,39 @@
+package driver
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/utils/storage"
+)
+
+func ConnectStorage(cfg config.StorageConfig) storage.Storage {
+	log.Println("Connection to storage")
+
+	switch cfg.Driver {
+	case "local":
+		store, err := storage.NewLocalStorage(cfg.LocalPath)
+		if err != nil {
+			panic(fmt.Sprintf("Failed to prepare local storage: %v", err))
+		}
+		log.Println("Success for using local storage")
+		return store
+	case "s3":
+		store, err := storage.NewS3Storage(storage.S3Options{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			UseSSL:    cfg.S3UseSSL,
+		})
+		if err != nil {
+			panic(fmt.Sprintf("Failed to prepare s3 storage: %v", err))
+		}
+		log.Println("Success for using s3 storage")
+		return store
+	default:
+		panic(fmt.Sprintf("Unsupported storage driver %q", cfg.Driver))
+	}
+}
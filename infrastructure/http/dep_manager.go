@@ -12,45 +12,92 @@ import (
 	periodDetailRepositories "github.com/riskykurniawan15/payrolls/repositories/period_detail"
 	userRepositories "github.com/riskykurniawan15/payrolls/repositories/user"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/storage"
 	"gorm.io/gorm"
 
+	apiKeyRepositories "github.com/riskykurniawan15/payrolls/repositories/api_key"
 	attendanceRepositories "github.com/riskykurniawan15/payrolls/repositories/attendance"
+	attendanceCorrectionRepositories "github.com/riskykurniawan15/payrolls/repositories/attendance_correction"
+	attendanceImportRepositories "github.com/riskykurniawan15/payrolls/repositories/attendance_import"
 	auditTrailRepositories "github.com/riskykurniawan15/payrolls/repositories/audit_trail"
+	authSessionRepositories "github.com/riskykurniawan15/payrolls/repositories/auth_session"
+	departmentRepositories "github.com/riskykurniawan15/payrolls/repositories/department"
+	loginAttemptRepositories "github.com/riskykurniawan15/payrolls/repositories/login_attempt"
+	notificationRepositories "github.com/riskykurniawan15/payrolls/repositories/notification"
+	officeLocationRepositories "github.com/riskykurniawan15/payrolls/repositories/office_location"
 	overtimeRepositories "github.com/riskykurniawan15/payrolls/repositories/overtime"
 	reimbursementRepositories "github.com/riskykurniawan15/payrolls/repositories/reimbursement"
+	roleRepositories "github.com/riskykurniawan15/payrolls/repositories/role"
+	terminationRepositories "github.com/riskykurniawan15/payrolls/repositories/termination"
+	apiKeyServices "github.com/riskykurniawan15/payrolls/services/api_key"
 	attendanceServices "github.com/riskykurniawan15/payrolls/services/attendance"
+	attendanceCorrectionServices "github.com/riskykurniawan15/payrolls/services/attendance_correction"
+	attendanceImportServices "github.com/riskykurniawan15/payrolls/services/attendance_import"
 	auditTrailServices "github.com/riskykurniawan15/payrolls/services/audit_trail"
+	departmentServices "github.com/riskykurniawan15/payrolls/services/department"
+	employeeImportServices "github.com/riskykurniawan15/payrolls/services/employee_import"
 	healthServices "github.com/riskykurniawan15/payrolls/services/health"
+	notificationServices "github.com/riskykurniawan15/payrolls/services/notification"
+	officeLocationServices "github.com/riskykurniawan15/payrolls/services/office_location"
 	overtimeServices "github.com/riskykurniawan15/payrolls/services/overtime"
 	payslipServices "github.com/riskykurniawan15/payrolls/services/payslip"
 	periodServices "github.com/riskykurniawan15/payrolls/services/period"
 	periodDetailServices "github.com/riskykurniawan15/payrolls/services/period_detail"
 	reimbursementServices "github.com/riskykurniawan15/payrolls/services/reimbursement"
+	roleServices "github.com/riskykurniawan15/payrolls/services/role"
+	terminationServices "github.com/riskykurniawan15/payrolls/services/termination"
+	timesheetServices "github.com/riskykurniawan15/payrolls/services/timesheet"
 	userServices "github.com/riskykurniawan15/payrolls/services/user"
 
+	apiKeyHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/api_key"
 	attendanceHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/attendance"
+	attendanceCorrectionHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/attendance_correction"
+	attendanceImportHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/attendance_import"
+	departmentHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/department"
+	employeeImportHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/employee_import"
 	healthHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/health"
+	notificationHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/notification"
+	officeLocationHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/office_location"
 	overtimeHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/overtime"
 	payslipHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/payslip"
 	periodHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/period"
 	periodDetailHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/period_detail"
 	reimbursementHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/reimbursement"
+	roleHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/role"
+	terminationHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/termination"
+	timesheetHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/timesheet"
 	userHandlers "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/user"
 )
 
 type Dependencies struct {
-	HealthHandlers        healthHandlers.IHealthHandler
-	UserHandlers          userHandlers.IUserHandler
-	PeriodHandlers        periodHandlers.IPeriodHandler
-	PeriodDetailHandlers  periodDetailHandlers.IPeriodDetailHandler
-	AttendanceHandlers    attendanceHandlers.IAttendanceHandler
-	OvertimeHandlers      overtimeHandlers.IOvertimeHandler
-	ReimbursementHandlers reimbursementHandlers.IReimbursementHandler
-	PayslipHandlers       payslipHandlers.IPayslipHandler
-	AuditTrailService     auditTrailServices.IAuditTrailService
+	HealthHandlers               healthHandlers.IHealthHandler
+	UserHandlers                 userHandlers.IUserHandler
+	PeriodHandlers               periodHandlers.IPeriodHandler
+	PeriodDetailHandlers         periodDetailHandlers.IPeriodDetailHandler
+	AttendanceHandlers           attendanceHandlers.IAttendanceHandler
+	OvertimeHandlers             overtimeHandlers.IOvertimeHandler
+	ReimbursementHandlers        reimbursementHandlers.IReimbursementHandler
+	PayslipHandlers              payslipHandlers.IPayslipHandler
+	OfficeLocationHandlers       officeLocationHandlers.IOfficeLocationHandler
+	AttendanceImportHandlers     attendanceImportHandlers.IAttendanceImportHandler
+	AttendanceCorrectionHandlers attendanceCorrectionHandlers.IAttendanceCorrectionHandler
+	NotificationHandlers         notificationHandlers.INotificationHandler
+	TimesheetHandlers            timesheetHandlers.ITimesheetHandler
+	RoleHandlers                 roleHandlers.IRoleHandler
+	APIKeyHandlers               apiKeyHandlers.IAPIKeyHandler
+	DepartmentHandlers           departmentHandlers.IDepartmentHandler
+	EmployeeImportHandlers       employeeImportHandlers.IEmployeeImportHandler
+	TerminationHandlers          terminationHandlers.ITerminationHandler
+	AuditTrailService            auditTrailServices.IAuditTrailService
+	AttendanceService            attendanceServices.IAttendanceService
+	UserService                  userServices.IUserService
+	RoleService                  roleServices.IRoleService
+	APIKeyService                apiKeyServices.IAPIKeyService
+	DepartmentService            departmentServices.IDepartmentService
+	TerminationService           terminationServices.ITerminationService
 }
 
-func InitializeHandler(db *gorm.DB, cfg config.Config, logger logger.Logger) *Dependencies {
+func InitializeHandler(db *gorm.DB, cfg config.Config, logger logger.Logger, store storage.Storage) *Dependencies {
 	wire.Build(
 		RepositorySet,
 		ServicesSet,
@@ -70,6 +117,16 @@ var RepositorySet = wire.NewSet(
 	overtimeRepositories.NewOvertimeRepository,
 	reimbursementRepositories.NewReimbursementRepository,
 	instanceRepositories.NewInstanceRepository,
+	officeLocationRepositories.NewOfficeLocationRepository,
+	attendanceImportRepositories.NewAttendanceImportRepository,
+	attendanceCorrectionRepositories.NewAttendanceCorrectionRepository,
+	notificationRepositories.NewNotificationRepository,
+	authSessionRepositories.NewAuthSessionRepository,
+	loginAttemptRepositories.NewLoginAttemptRepository,
+	roleRepositories.NewRoleRepository,
+	apiKeyRepositories.NewAPIKeyRepository,
+	departmentRepositories.NewDepartmentRepository,
+	terminationRepositories.NewTerminationRepository,
 )
 
 var ServicesSet = wire.NewSet(
@@ -82,6 +139,16 @@ var ServicesSet = wire.NewSet(
 	overtimeServices.NewOvertimeService,
 	reimbursementServices.NewReimbursementService,
 	payslipServices.NewPayslipService,
+	officeLocationServices.NewOfficeLocationService,
+	attendanceImportServices.NewAttendanceImportService,
+	attendanceCorrectionServices.NewAttendanceCorrectionService,
+	notificationServices.NewNotificationService,
+	timesheetServices.NewTimesheetService,
+	roleServices.NewRoleService,
+	apiKeyServices.NewAPIKeyService,
+	departmentServices.NewDepartmentService,
+	employeeImportServices.NewEmployeeImportService,
+	terminationServices.NewTerminationService,
 )
 
 var HandlerSet = wire.NewSet(
@@ -93,4 +160,14 @@ var HandlerSet = wire.NewSet(
 	overtimeHandlers.NewOvertimeHandlers,
 	reimbursementHandlers.NewReimbursementHandlers,
 	payslipHandlers.NewPayslipHandlers,
+	officeLocationHandlers.NewOfficeLocationHandlers,
+	attendanceImportHandlers.NewAttendanceImportHandlers,
+	attendanceCorrectionHandlers.NewAttendanceCorrectionHandlers,
+	notificationHandlers.NewNotificationHandlers,
+	timesheetHandlers.NewTimesheetHandlers,
+	roleHandlers.NewRoleHandlers,
+	apiKeyHandlers.NewAPIKeyHandlers,
+	departmentHandlers.NewDepartmentHandlers,
+	employeeImportHandlers.NewEmployeeImportHandlers,
+	terminationHandlers.NewTerminationHandlers,
 )
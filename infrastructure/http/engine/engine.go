@@ -12,7 +12,9 @@ import (
 	"github.com/riskykurniawan15/payrolls/config"
 	dep "github.com/riskykurniawan15/payrolls/infrastructure/http"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/router"
+	"github.com/riskykurniawan15/payrolls/infrastructure/scheduler"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/storage"
 	"gorm.io/gorm"
 )
 
@@ -20,11 +22,35 @@ type App struct {
 	Config      config.Config
 	Logger      logger.Logger
 	PostgressDB *gorm.DB
+	Storage     storage.Storage
 }
 
 func Start(app App) {
 	// Initialize HTTP server
-	e := router.Routers(dep.InitializeHandler(app.PostgressDB, app.Config, app.Logger), app.Config.JWT.SecretKey)
+	dependencies := dep.InitializeHandler(app.PostgressDB, app.Config, app.Logger, app.Storage)
+	e := router.Routers(dependencies, app.Config.JWT.SecretKey, app.Config.Http.TrustedProxies)
+
+	// Start background jobs
+	jobs := scheduler.NewScheduler(app.Logger)
+	if app.Config.Attendance.AutoCloseEnabled {
+		jobs.Register(scheduler.Job{
+			Name:     "attendance_auto_close",
+			Interval: time.Duration(app.Config.Attendance.AutoCloseInterval) * time.Minute,
+			Run: func(ctx context.Context) error {
+				_, err := dependencies.AttendanceService.AutoCloseForgottenCheckOuts(ctx, time.Now())
+				return err
+			},
+		})
+	}
+	jobs.Register(scheduler.Job{
+		Name:     "termination_deactivate",
+		Interval: time.Duration(app.Config.Termination.DeactivateInterval) * time.Minute,
+		Run: func(ctx context.Context) error {
+			_, err := dependencies.TerminationService.DeactivateDue(ctx, time.Now())
+			return err
+		},
+	})
+	jobs.Start()
 
 	// Start HTTP server in background
 	go func() {
@@ -45,6 +71,9 @@ func Start(app App) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// Stop background jobs
+	jobs.Stop()
+
 	// Shutdown HTTP server
 	if err := e.Shutdown(ctx); err != nil {
 		app.Logger.Error(fmt.Sprintf("Failed to shutdown HTTP server: %v", err))
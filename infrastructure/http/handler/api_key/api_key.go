@@ -0,0 +1,149 @@
+package api_key
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/api_key"
+	apiKeyServices "github.com/riskykurniawan15/payrolls/services/api_key"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+type (
+	IAPIKeyHandler interface {
+		List(ctx echo.Context) error
+		GetByID(ctx echo.Context) error
+		Create(ctx echo.Context) error
+		Revoke(ctx echo.Context) error
+	}
+
+	APIKeyHandler struct {
+		logger         logger.Logger
+		apiKeyServices apiKeyServices.IAPIKeyService
+	}
+)
+
+func NewAPIKeyHandlers(logger logger.Logger, apiKeyServices apiKeyServices.IAPIKeyService) IAPIKeyHandler {
+	return &APIKeyHandler{
+		logger:         logger,
+		apiKeyServices: apiKeyServices,
+	}
+}
+
+func (handler APIKeyHandler) List(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.apiKeyServices.List(serviceCtx)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler APIKeyHandler) GetByID(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.apiKeyServices.Get(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler APIKeyHandler) Create(ctx echo.Context) error {
+	var req api_key.CreateAPIKeyRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"name":   req.Name,
+		"scopes": req.Scopes,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.apiKeyServices.Create(serviceCtx, req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler APIKeyHandler) Revoke(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	if err := handler.apiKeyServices.Revoke(serviceCtx, uint(id), middleware.GetUserID(ctx)); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
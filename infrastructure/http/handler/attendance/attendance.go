@@ -11,6 +11,7 @@ import (
 	"github.com/riskykurniawan15/payrolls/models/attendance"
 	attendanceServices "github.com/riskykurniawan15/payrolls/services/attendance"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
 )
 
 type (
@@ -20,6 +21,10 @@ type (
 		CheckIn(ctx echo.Context) error
 		CheckOut(ctx echo.Context) error
 		CheckOutByID(ctx echo.Context) error
+		AdminList(ctx echo.Context) error
+		AdminGetByID(ctx echo.Context) error
+		AdminCreate(ctx echo.Context) error
+		AdminUpdate(ctx echo.Context) error
 	}
 
 	AttendanceHandler struct {
@@ -184,10 +189,28 @@ func (handler AttendanceHandler) CheckIn(ctx echo.Context) error {
 	}
 
 	handler.logger.InfoT("incoming check-in request", requestID, map[string]interface{}{
-		"user_id":  userID,
-		"has_date": req.Date != nil,
+		"user_id":      userID,
+		"has_date":     req.Date != nil,
+		"has_location": req.Latitude != nil && req.Longitude != nil,
 	})
 
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
 	// Add request ID to context
 	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
 
@@ -231,10 +254,28 @@ func (handler AttendanceHandler) CheckOut(ctx echo.Context) error {
 	}
 
 	handler.logger.InfoT("incoming check-out request", requestID, map[string]interface{}{
-		"user_id":  userID,
-		"has_date": req.Date != nil,
+		"user_id":      userID,
+		"has_date":     req.Date != nil,
+		"has_location": req.Latitude != nil && req.Longitude != nil,
 	})
 
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
 	// Add request ID to context
 	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
 
@@ -297,8 +338,26 @@ func (handler AttendanceHandler) CheckOutByID(ctx echo.Context) error {
 		"user_id":       userID,
 		"attendance_id": id,
 		"has_date":      req.Date != nil,
+		"has_location":  req.Latitude != nil && req.Longitude != nil,
 	})
 
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
 	// Add request ID to context
 	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
 
@@ -326,3 +385,217 @@ func (handler AttendanceHandler) CheckOutByID(ctx echo.Context) error {
 		"data": response,
 	}))
 }
+
+func (handler AttendanceHandler) AdminList(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	// Get pagination parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+	userID, _ := strconv.ParseUint(ctx.QueryParam("user_id"), 10, 32)
+	missingCheckOut, _ := strconv.ParseBool(ctx.QueryParam("missing_check_out"))
+	late, _ := strconv.ParseBool(ctx.QueryParam("late"))
+	startDateStr := ctx.QueryParam("start_date")
+	endDateStr := ctx.QueryParam("end_date")
+
+	req := attendance.AdminAttendanceListRequest{
+		Page:            page,
+		Limit:           limit,
+		UserID:          uint(userID),
+		MissingCheckOut: missingCheckOut,
+		Late:            late,
+		UserIDs:         middleware.GetTeamUserIDs(ctx),
+	}
+
+	// Parse date parameters
+	if startDateStr != "" {
+		if parsed, err := time.Parse("2006-01-02", startDateStr); err == nil {
+			req.StartDate = &parsed
+		}
+	}
+	if endDateStr != "" {
+		if parsed, err := time.Parse("2006-01-02", endDateStr); err == nil {
+			// Set end date to end of day
+			endOfDay := parsed.Add(24*time.Hour - time.Second)
+			req.EndDate = &endOfDay
+		}
+	}
+
+	handler.logger.InfoT("incoming admin get attendances request", requestID, map[string]interface{}{
+		"user_id":           req.UserID,
+		"page":              page,
+		"limit":             limit,
+		"start_date":        startDateStr,
+		"end_date":          endDateStr,
+		"missing_check_out": missingCheckOut,
+		"late":              late,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceServices.AdminList(serviceCtx, req)
+	if err != nil {
+		handler.logger.ErrorT("service error", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler AttendanceHandler) AdminGetByID(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	// Get attendance ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid attendance ID",
+		}))
+	}
+
+	handler.logger.InfoT("incoming admin get attendance by ID request", requestID, map[string]interface{}{
+		"attendance_id": id,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceServices.AdminGetByID(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler AttendanceHandler) AdminCreate(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+	adminID := middleware.GetUserID(ctx)
+
+	var req attendance.AdminAttendanceRequest
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"admin_id": adminID,
+			"error":    err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming admin create attendance request", requestID, map[string]interface{}{
+		"admin_id": adminID,
+		"user_id":  req.UserID,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceServices.AdminCreate(serviceCtx, adminID, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler AttendanceHandler) AdminUpdate(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+	adminID := middleware.GetUserID(ctx)
+
+	// Get attendance ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid attendance ID",
+		}))
+	}
+
+	var req attendance.AdminUpdateAttendanceRequest
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"admin_id": adminID,
+			"error":    err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming admin update attendance request", requestID, map[string]interface{}{
+		"admin_id":      adminID,
+		"attendance_id": id,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceServices.AdminUpdate(serviceCtx, uint(id), adminID, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
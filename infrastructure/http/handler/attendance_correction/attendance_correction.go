@@ -0,0 +1,268 @@
+package attendance_correction
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/attendance_correction"
+	attendanceCorrectionServices "github.com/riskykurniawan15/payrolls/services/attendance_correction"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+type (
+	IAttendanceCorrectionHandler interface {
+		Create(ctx echo.Context) error
+		List(ctx echo.Context) error
+		GetByID(ctx echo.Context) error
+		ListAll(ctx echo.Context) error
+		Approve(ctx echo.Context) error
+		Reject(ctx echo.Context) error
+	}
+
+	AttendanceCorrectionHandler struct {
+		logger                       logger.Logger
+		attendanceCorrectionServices attendanceCorrectionServices.IAttendanceCorrectionService
+	}
+)
+
+func NewAttendanceCorrectionHandlers(logger logger.Logger, attendanceCorrectionServices attendanceCorrectionServices.IAttendanceCorrectionService) IAttendanceCorrectionHandler {
+	return &AttendanceCorrectionHandler{
+		logger:                       logger,
+		attendanceCorrectionServices: attendanceCorrectionServices,
+	}
+}
+
+func (handler AttendanceCorrectionHandler) Create(ctx echo.Context) error {
+	var req attendance_correction.CreateAttendanceCorrectionRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"attendance_id": req.AttendanceID,
+		"check_in_date": req.CheckInDate,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceCorrectionServices.Create(serviceCtx, userID, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler AttendanceCorrectionHandler) List(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+
+	// Employees only see their own corrections
+	req := attendance_correction.ListAttendanceCorrectionsRequest{
+		Page:   page,
+		Limit:  limit,
+		UserID: middleware.GetUserID(ctx),
+		Status: ctx.QueryParam("status"),
+	}
+
+	return handler.list(ctx, req)
+}
+
+func (handler AttendanceCorrectionHandler) GetByID(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceCorrectionServices.GetByID(serviceCtx, uint(id), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler AttendanceCorrectionHandler) ListAll(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+	userID, _ := strconv.ParseUint(ctx.QueryParam("user_id"), 10, 32)
+
+	req := attendance_correction.ListAttendanceCorrectionsRequest{
+		Page:    page,
+		Limit:   limit,
+		UserID:  uint(userID),
+		Status:  ctx.QueryParam("status"),
+		UserIDs: middleware.GetTeamUserIDs(ctx),
+	}
+
+	return handler.list(ctx, req)
+}
+
+func (handler AttendanceCorrectionHandler) Approve(ctx echo.Context) error {
+	return handler.review(ctx, true)
+}
+
+func (handler AttendanceCorrectionHandler) Reject(ctx echo.Context) error {
+	return handler.review(ctx, false)
+}
+
+func (handler AttendanceCorrectionHandler) list(ctx echo.Context, req attendance_correction.ListAttendanceCorrectionsRequest) error {
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":    req.Page,
+		"limit":   req.Limit,
+		"user_id": req.UserID,
+		"status":  req.Status,
+	})
+
+	// Set default values before validation
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceCorrectionServices.List(serviceCtx, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler AttendanceCorrectionHandler) review(ctx echo.Context, approve bool) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req attendance_correction.ReviewAttendanceCorrectionRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":      id,
+		"approve": approve,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	// Get reviewer ID from middleware, team routes only reach the reviewer's reports
+	reviewerID := middleware.GetUserID(ctx)
+	req.UserIDs = middleware.GetTeamUserIDs(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	var response *attendance_correction.AttendanceCorrectionResponse
+	if approve {
+		response, err = handler.attendanceCorrectionServices.Approve(serviceCtx, uint(id), reviewerID, req)
+	} else {
+		response, err = handler.attendanceCorrectionServices.Reject(serviceCtx, uint(id), reviewerID, req)
+	}
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, attendanceCorrectionServices.ErrSelfReview) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler AttendanceCorrectionHandler) validationError(ctx echo.Context, err error) error {
+	if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+		return ctx.JSON(http.StatusBadRequest, entities.Response{
+			Status:  http.StatusBadRequest,
+			Message: "Bad Request",
+			Error:   "Validation failed",
+			Meta: map[string]interface{}{
+				"validation_errors": validationErrors.GetValidationErrors(),
+			},
+		})
+	}
+	return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+		"error": err.Error(),
+	}))
+}
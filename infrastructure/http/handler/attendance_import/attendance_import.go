@@ -0,0 +1,247 @@
+package attendance_import
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/attendance_import"
+	attendanceImportServices "github.com/riskykurniawan15/payrolls/services/attendance_import"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+type (
+	IAttendanceImportHandler interface {
+		Import(ctx echo.Context) error
+		ListImports(ctx echo.Context) error
+		CreateDeviceUser(ctx echo.Context) error
+		ListDeviceUsers(ctx echo.Context) error
+		DeleteDeviceUser(ctx echo.Context) error
+	}
+
+	AttendanceImportHandler struct {
+		logger                   logger.Logger
+		attendanceImportServices attendanceImportServices.IAttendanceImportService
+	}
+)
+
+func NewAttendanceImportHandlers(logger logger.Logger, attendanceImportServices attendanceImportServices.IAttendanceImportService) IAttendanceImportHandler {
+	return &AttendanceImportHandler{
+		logger:                   logger,
+		attendanceImportServices: attendanceImportServices,
+	}
+}
+
+func (handler AttendanceImportHandler) Import(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		handler.logger.ErrorT("failed to read uploaded file", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "File is required",
+		}))
+	}
+
+	if fileHeader.Size > constant.AttendanceImportMaxSize {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "File exceeds the maximum size of 10 MB",
+		}))
+	}
+
+	dryRun, _ := strconv.ParseBool(ctx.FormValue("dry_run"))
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"file_name": fileHeader.Filename,
+		"file_size": fileHeader.Size,
+		"dry_run":   dryRun,
+	})
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Failed to open uploaded file",
+		}))
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, constant.AttendanceImportMaxSize))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Failed to read uploaded file",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceImportServices.Import(serviceCtx, attendance_import.ImportAttendanceRequest{
+		FileName: fileHeader.Filename,
+		Content:  content,
+		DryRun:   dryRun,
+	}, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	status := http.StatusCreated
+	if dryRun {
+		status = http.StatusOK
+	}
+
+	return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler AttendanceImportHandler) ListImports(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":  page,
+		"limit": limit,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceImportServices.ListImports(serviceCtx, page, limit)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler AttendanceImportHandler) CreateDeviceUser(ctx echo.Context) error {
+	var req attendance_import.CreateDeviceUserRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"device_user_id": req.DeviceUserID,
+		"user_id":        req.UserID,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceImportServices.CreateDeviceUser(serviceCtx, req, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler AttendanceImportHandler) ListDeviceUsers(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":  page,
+		"limit": limit,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.attendanceImportServices.ListDeviceUsers(serviceCtx, page, limit)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler AttendanceImportHandler) DeleteDeviceUser(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	err = handler.attendanceImportServices.DeleteDeviceUser(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
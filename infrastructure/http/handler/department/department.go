@@ -0,0 +1,248 @@
+package department
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/department"
+	departmentServices "github.com/riskykurniawan15/payrolls/services/department"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+type (
+	IDepartmentHandler interface {
+		List(ctx echo.Context) error
+		GetByID(ctx echo.Context) error
+		Create(ctx echo.Context) error
+		Update(ctx echo.Context) error
+		Delete(ctx echo.Context) error
+		TeamMembers(ctx echo.Context) error
+		TeamSummary(ctx echo.Context) error
+	}
+
+	DepartmentHandler struct {
+		logger             logger.Logger
+		departmentServices departmentServices.IDepartmentService
+	}
+)
+
+func NewDepartmentHandlers(logger logger.Logger, departmentServices departmentServices.IDepartmentService) IDepartmentHandler {
+	return &DepartmentHandler{
+		logger:             logger,
+		departmentServices: departmentServices,
+	}
+}
+
+func (handler DepartmentHandler) List(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.departmentServices.ListDepartments(serviceCtx)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler DepartmentHandler) GetByID(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.departmentServices.GetDepartment(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler DepartmentHandler) Create(ctx echo.Context) error {
+	var req department.CreateDepartmentRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"name": req.Name,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.departmentServices.CreateDepartment(serviceCtx, req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler DepartmentHandler) Update(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req department.UpdateDepartmentRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":   id,
+		"name": req.Name,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.departmentServices.UpdateDepartment(serviceCtx, uint(id), req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler DepartmentHandler) Delete(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	if err := handler.departmentServices.DeleteDepartment(serviceCtx, uint(id), middleware.GetUserID(ctx)); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler DepartmentHandler) TeamMembers(ctx echo.Context) error {
+	req := department.ListTeamMembersRequest{
+		DirectOnly: ctx.QueryParam("direct") == "true",
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.departmentServices.ListTeamMembers(serviceCtx, middleware.GetUserID(ctx), req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler DepartmentHandler) TeamSummary(ctx echo.Context) error {
+	req := department.TeamSummaryRequest{
+		StartDate: ctx.QueryParam("start_date"),
+		EndDate:   ctx.QueryParam("end_date"),
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"start_date": req.StartDate,
+		"end_date":   req.EndDate,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.departmentServices.TeamSummary(serviceCtx, middleware.GetUserID(ctx), req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler DepartmentHandler) validationError(ctx echo.Context, err error) error {
+	if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+		return ctx.JSON(http.StatusBadRequest, entities.Response{
+			Status:  http.StatusBadRequest,
+			Message: "Bad Request",
+			Error:   "Validation failed",
+			Meta: map[string]interface{}{
+				"validation_errors": validationErrors.GetValidationErrors(),
+			},
+		})
+	}
+	return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+		"error": err.Error(),
+	}))
+}
@@ -0,0 +1,112 @@
+package employee_import
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/employee_import"
+	employeeImportServices "github.com/riskykurniawan15/payrolls/services/employee_import"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+type (
+	IEmployeeImportHandler interface {
+		Import(ctx echo.Context) error
+	}
+
+	EmployeeImportHandler struct {
+		logger                 logger.Logger
+		employeeImportServices employeeImportServices.IEmployeeImportService
+	}
+)
+
+func NewEmployeeImportHandlers(logger logger.Logger, employeeImportServices employeeImportServices.IEmployeeImportService) IEmployeeImportHandler {
+	return &EmployeeImportHandler{
+		logger:                 logger,
+		employeeImportServices: employeeImportServices,
+	}
+}
+
+func (handler EmployeeImportHandler) Import(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		handler.logger.ErrorT("failed to read uploaded file", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "File is required",
+		}))
+	}
+
+	if fileHeader.Size > constant.EmployeeImportMaxSize {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "File exceeds the maximum size of 5 MB",
+		}))
+	}
+
+	dryRun, _ := strconv.ParseBool(ctx.FormValue("dry_run"))
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"file_name": fileHeader.Filename,
+		"file_size": fileHeader.Size,
+		"dry_run":   dryRun,
+	})
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Failed to open uploaded file",
+		}))
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, constant.EmployeeImportMaxSize))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Failed to read uploaded file",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.employeeImportServices.Import(serviceCtx, employee_import.ImportEmployeesRequest{
+		FileName:       fileHeader.Filename,
+		Content:        content,
+		DryRun:         dryRun,
+		CanAssignRoles: middleware.HasPermission(ctx, constant.PermissionRoleManage),
+	}, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// The sheet is imported as a whole, invalid rows mean nothing was written
+	if !dryRun && len(response.Errors) > 0 {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Some rows are invalid, nothing was imported",
+			"data":  response,
+		}))
+	}
+
+	status := http.StatusCreated
+	if dryRun {
+		status = http.StatusOK
+	}
+
+	return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+		"data": response,
+	}))
+}
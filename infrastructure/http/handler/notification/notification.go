@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/notification"
+	notificationServices "github.com/riskykurniawan15/payrolls/services/notification"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+type (
+	INotificationHandler interface {
+		List(ctx echo.Context) error
+		MarkAsRead(ctx echo.Context) error
+	}
+
+	NotificationHandler struct {
+		logger               logger.Logger
+		notificationServices notificationServices.INotificationService
+	}
+)
+
+func NewNotificationHandlers(logger logger.Logger, notificationServices notificationServices.INotificationService) INotificationHandler {
+	return &NotificationHandler{
+		logger:               logger,
+		notificationServices: notificationServices,
+	}
+}
+
+func (handler NotificationHandler) List(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+	unreadOnly, _ := strconv.ParseBool(ctx.QueryParam("unread_only"))
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":        page,
+		"limit":       limit,
+		"unread_only": unreadOnly,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.notificationServices.List(serviceCtx, notification.ListNotificationsRequest{
+		UserID:     middleware.GetUserID(ctx),
+		Page:       page,
+		Limit:      limit,
+		UnreadOnly: unreadOnly,
+	})
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler NotificationHandler) MarkAsRead(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	if err := handler.notificationServices.MarkAsRead(serviceCtx, uint(id), middleware.GetUserID(ctx)); err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,373 @@
+package office_location
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/office_location"
+	officeLocationServices "github.com/riskykurniawan15/payrolls/services/office_location"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+type (
+	IOfficeLocationHandler interface {
+		Create(ctx echo.Context) error
+		GetByID(ctx echo.Context) error
+		Update(ctx echo.Context) error
+		Delete(ctx echo.Context) error
+		List(ctx echo.Context) error
+		CreateOverride(ctx echo.Context) error
+		ListOverrides(ctx echo.Context) error
+		DeleteOverride(ctx echo.Context) error
+	}
+
+	OfficeLocationHandler struct {
+		logger                 logger.Logger
+		officeLocationServices officeLocationServices.IOfficeLocationService
+	}
+)
+
+func NewOfficeLocationHandlers(logger logger.Logger, officeLocationServices officeLocationServices.IOfficeLocationService) IOfficeLocationHandler {
+	return &OfficeLocationHandler{
+		logger:                 logger,
+		officeLocationServices: officeLocationServices,
+	}
+}
+
+func (handler OfficeLocationHandler) Create(ctx echo.Context) error {
+	var req office_location.CreateOfficeLocationRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"name":          req.Name,
+		"latitude":      req.Latitude,
+		"longitude":     req.Longitude,
+		"radius_meters": req.RadiusMeters,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.officeLocationServices.Create(serviceCtx, req, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler OfficeLocationHandler) GetByID(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.officeLocationServices.GetByID(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler OfficeLocationHandler) Update(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req office_location.UpdateOfficeLocationRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":            id,
+		"name":          req.Name,
+		"latitude":      req.Latitude,
+		"longitude":     req.Longitude,
+		"radius_meters": req.RadiusMeters,
+		"status":        req.Status,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.officeLocationServices.Update(serviceCtx, uint(id), req, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler OfficeLocationHandler) Delete(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	err = handler.officeLocationServices.Delete(serviceCtx, uint(id), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler OfficeLocationHandler) List(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+	search := ctx.QueryParam("search")
+	sortBy := ctx.QueryParam("sort_by")
+	sortDesc := ctx.QueryParam("sort_desc") == "true"
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":      page,
+		"limit":     limit,
+		"search":    search,
+		"sort_by":   sortBy,
+		"sort_desc": sortDesc,
+	})
+
+	// Build request
+	req := office_location.ListOfficeLocationsRequest{
+		Page:     page,
+		Limit:    limit,
+		Search:   search,
+		SortBy:   sortBy,
+		SortDesc: sortDesc,
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.officeLocationServices.List(serviceCtx, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler OfficeLocationHandler) CreateOverride(ctx echo.Context) error {
+	var req office_location.CreateGeofenceOverrideRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"user_id":     req.UserID,
+		"valid_until": req.ValidUntil,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.officeLocationServices.CreateOverride(serviceCtx, req, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler OfficeLocationHandler) ListOverrides(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":  page,
+		"limit": limit,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.officeLocationServices.ListOverrides(serviceCtx, page, limit)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler OfficeLocationHandler) DeleteOverride(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	err = handler.officeLocationServices.DeleteOverride(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
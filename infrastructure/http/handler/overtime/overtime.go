@@ -1,6 +1,7 @@
 package overtime
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -20,6 +21,11 @@ type (
 		Update(ctx echo.Context) error
 		Delete(ctx echo.Context) error
 		List(ctx echo.Context) error
+		ListProposals(ctx echo.Context) error
+		ConfirmProposal(ctx echo.Context) error
+		ListAll(ctx echo.Context) error
+		Approve(ctx echo.Context) error
+		Reject(ctx echo.Context) error
 	}
 
 	OvertimeHandler struct {
@@ -291,8 +297,257 @@ func (handler OvertimeHandler) List(ctx echo.Context) error {
 		}))
 	}
 
+	// Remaining allowance is reported next to the pagination
+	meta := struct {
+		overtime.Pagination
+		Allowance *overtime.OvertimeAllowance `json:"allowance,omitempty"`
+	}{response.Pagination, response.Allowance}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": meta,
+	}))
+}
+
+func (handler OvertimeHandler) ListProposals(ctx echo.Context) error {
+	startDate := ctx.QueryParam("start_date")
+	endDate := ctx.QueryParam("end_date")
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"start_date": startDate,
+		"end_date":   endDate,
+	})
+
+	// Handle empty date strings
+	var startDatePtr, endDatePtr *string
+	if startDate != "" {
+		startDatePtr = &startDate
+	}
+	if endDate != "" {
+		endDatePtr = &endDate
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.overtimeServices.ListProposals(serviceCtx, userID, startDatePtr, endDatePtr)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler OvertimeHandler) ConfirmProposal(ctx echo.Context) error {
+	var req overtime.ConfirmOvertimeProposalRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"overtimes_date":   req.OvertimesDate,
+		"total_hours_time": req.TotalHoursTime,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.overtimeServices.ConfirmProposal(serviceCtx, req, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler OvertimeHandler) ListAll(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+	userID, _ := strconv.ParseUint(ctx.QueryParam("user_id"), 10, 32)
+	startDate := ctx.QueryParam("start_date")
+	endDate := ctx.QueryParam("end_date")
+
+	req := overtime.ListAllOvertimesRequest{
+		Page:    page,
+		Limit:   limit,
+		UserID:  uint(userID),
+		Status:  ctx.QueryParam("status"),
+		GapOnly: ctx.QueryParam("gap_only") == "true",
+		UserIDs: middleware.GetTeamUserIDs(ctx),
+	}
+	if startDate != "" {
+		req.StartDate = &startDate
+	}
+	if endDate != "" {
+		req.EndDate = &endDate
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":       req.Page,
+		"limit":      req.Limit,
+		"user_id":    req.UserID,
+		"start_date": startDate,
+		"end_date":   endDate,
+		"status":     req.Status,
+		"gap_only":   req.GapOnly,
+	})
+
+	// Set default values before validation
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.overtimeServices.ListAll(serviceCtx, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
 	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
 		"data": response.Data,
 		"meta": response.Pagination,
 	}))
 }
+
+func (handler OvertimeHandler) Approve(ctx echo.Context) error {
+	return handler.review(ctx, true)
+}
+
+func (handler OvertimeHandler) Reject(ctx echo.Context) error {
+	return handler.review(ctx, false)
+}
+
+func (handler OvertimeHandler) review(ctx echo.Context, approve bool) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req overtime.ReviewOvertimeRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":      id,
+		"approve": approve,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get reviewer ID from middleware, team routes only reach the reviewer's reports
+	reviewerID := middleware.GetUserID(ctx)
+	req.UserIDs = middleware.GetTeamUserIDs(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	var response *overtime.OvertimeResponse
+	if approve {
+		response, err = handler.overtimeServices.Approve(serviceCtx, uint(id), reviewerID, req)
+	} else {
+		response, err = handler.overtimeServices.Reject(serviceCtx, uint(id), reviewerID, req)
+	}
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, overtimeServices.ErrSelfReview) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
@@ -1,6 +1,7 @@
 package payslip
 
 import (
+	"errors"
 	"fmt"
 	"html/template"
 	"net/http"
@@ -22,6 +23,8 @@ type (
 		Print(ctx echo.Context) error
 		GenerateSummary(ctx echo.Context) error
 		PrintSummary(ctx echo.Context) error
+		GenerateSettlement(ctx echo.Context) error
+		PrintSettlement(ctx echo.Context) error
 	}
 
 	PayslipHandler struct {
@@ -403,18 +406,20 @@ func (handler PayslipHandler) generatePayslipSummaryHTML(data *payslip.PayslipSu
   <table>
     <tr>
       <th>No</th>
+      <th>Employee No</th>
       <th>Employee Name</th>
       <th class="right">THP</th>
     </tr>
     {{range .EmployeeList}}
     <tr>
       <td>{{.No}}</td>
+      <td>{{.EmployeeNumber}}</td>
       <td>{{.EmployeeName}}</td>
       <td class="right">{{formatRupiah .TakeHomePay}}</td>
     </tr>
     {{end}}
     <tr class="total-row">
-      <td colspan="2">Total</td>
+      <td colspan="3">Total</td>
       <td class="right">{{formatRupiah .TotalTakeHomePay}}</td>
     </tr>
   </table>
@@ -445,6 +450,109 @@ func (handler PayslipHandler) generatePayslipSummaryHTML(data *payslip.PayslipSu
 	return result.String(), nil
 }
 
+func (handler PayslipHandler) GenerateSettlement(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	// Get termination ID from URL parameter
+	terminationIDStr := ctx.Param("id")
+	terminationID, err := strconv.ParseUint(terminationIDStr, 10, 32)
+	if err != nil {
+		handler.logger.ErrorT("invalid termination ID", requestID, map[string]interface{}{
+			"termination_id": terminationIDStr,
+			"error":          err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid termination ID",
+		}))
+	}
+
+	handler.logger.InfoT("incoming settlement payslip generate request", requestID, map[string]interface{}{
+		"termination_id": terminationID,
+	})
+
+	// Get host from request
+	host := ctx.Scheme() + "://" + ctx.Request().Host
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.payslipServices.GenerateSettlementPayslip(serviceCtx, uint(terminationID), host)
+	if err != nil {
+		handler.logger.ErrorT("service error", requestID, map[string]interface{}{
+			"termination_id": terminationID,
+			"error":          err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	handler.logger.InfoT("settlement payslip generated successfully", requestID, map[string]interface{}{
+		"termination_id": terminationID,
+		"print_url":      response.PrintURL,
+	})
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler PayslipHandler) PrintSettlement(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	// Get token from query parameter
+	token := ctx.QueryParam("token")
+	if token == "" {
+		handler.logger.ErrorT("missing token", requestID, map[string]interface{}{
+			"error": "Token is required",
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Token is required",
+		}))
+	}
+
+	handler.logger.InfoT("incoming settlement payslip print request", requestID, map[string]interface{}{
+		"token": token,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	data, err := handler.payslipServices.GetSettlementPayslipData(serviceCtx, token)
+	if err != nil {
+		handler.logger.ErrorT("service error", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		if errors.Is(err, payslipService.ErrInvalidToken) {
+			return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+				"error": err.Error(),
+			}))
+		}
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Generate HTML
+	html, err := handler.generateSettlementPayslipHTML(data)
+	if err != nil {
+		handler.logger.ErrorT("failed to generate HTML", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to generate payslip",
+		}))
+	}
+
+	handler.logger.InfoT("settlement payslip printed successfully", requestID, map[string]interface{}{
+		"employee_name": data.EmployeeName,
+	})
+
+	return ctx.HTML(http.StatusOK, html)
+}
+
 func (handler PayslipHandler) formatRupiah(amount float64) string {
 	// Convert to integer (in rupiah)
 	rupiah := int64(amount)
@@ -542,6 +650,7 @@ func (handler PayslipHandler) generatePayslipHTML(data *payslip.PayslipData) (st
   <h2>Payslip</h2>
 
   <p><strong>Employee:</strong> {{.EmployeeName}}<br>
+     {{if .EmployeeNumber}}<strong>Employee No:</strong> {{.EmployeeNumber}}<br>{{end}}
      <strong>Period:</strong> {{.PeriodName}}</p>
 
   <div class="section-title">Work Summary</div>
@@ -636,3 +745,228 @@ func (handler PayslipHandler) generatePayslipHTML(data *payslip.PayslipData) (st
 
 	return result.String(), nil
 }
+
+func (handler PayslipHandler) generateSettlementPayslipHTML(data *payslip.SettlementPayslipData) (string, error) {
+	// Create template functions
+	funcMap := template.FuncMap{
+		"formatRupiah": handler.formatRupiah,
+	}
+
+	const htmlTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <title>Final Settlement</title>
+  <style>
+    @media print {
+      body {
+        margin: 0;
+        -webkit-print-color-adjust: exact;
+      }
+    }
+
+    body {
+      font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+      max-width: 800px;
+      margin: 40px auto;
+      padding: 20px;
+    }
+
+    h1, h2 {
+      text-align: center;
+      margin-bottom: 10px;
+    }
+
+    .section-title {
+      background-color: #f0f0f0;
+      padding: 8px;
+      font-weight: bold;
+      margin-top: 20px;
+    }
+
+    table {
+      width: 100%;
+      border-collapse: collapse;
+      margin-top: 10px;
+    }
+
+    th, td {
+      padding: 8px;
+      border: 1px solid #ccc;
+      text-align: left;
+    }
+
+    .right {
+      text-align: right;
+    }
+
+    .total-row {
+      font-weight: bold;
+      background-color: #f9f9f9;
+    }
+
+    .footer {
+      margin-top: 40px;
+      text-align: center;
+      font-size: 12px;
+      color: #666;
+    }
+  </style>
+</head>
+<body onload="window.print()">
+
+  <h1>{{.CompanyName}}</h1>
+  <h2>Final Settlement</h2>
+
+  <p><strong>Employee:</strong> {{.EmployeeName}}<br>
+     {{if .EmployeeNumber}}<strong>Employee No:</strong> {{.EmployeeNumber}}<br>{{end}}
+     {{if .JoinDate}}<strong>Join Date:</strong> {{.JoinDate.Format "2006-01-02"}}<br>{{end}}
+     <strong>Last Working Day:</strong> {{.LastWorkingDay.Format "2006-01-02"}}<br>
+     <strong>Reason:</strong> {{.Reason}}<br>
+     <strong>Length of Service:</strong> {{.ServiceMonths}} months</p>
+
+  <div class="section-title">Final Salary</div>
+  <table>
+    <tr>
+      <th>Description</th>
+      <th class="right">Amount</th>
+    </tr>
+    {{if .StartDate}}
+    <tr>
+      <td>Unpaid Since</td>
+      <td class="right">{{.StartDate.Format "2006-01-02"}}</td>
+    </tr>
+    {{end}}
+    <tr>
+      <td>Total Working Days</td>
+      <td class="right">{{.TotalWorking}}</td>
+    </tr>
+    <tr>
+      <td>Daily Rate</td>
+      <td class="right">{{formatRupiah .DailyRate}}</td>
+    </tr>
+    <tr class="total-row">
+      <td>Prorated Salary</td>
+      <td class="right">{{formatRupiah .ProratedSalary}}</td>
+    </tr>
+  </table>
+
+  {{if .OvertimeDetails}}
+  <div class="section-title">Overtime</div>
+  <table>
+    <tr>
+      <th>Date</th>
+      <th>Hours</th>
+      <th class="right">Amount</th>
+    </tr>
+    {{range .OvertimeDetails}}
+    <tr>
+      <td>{{.Date}}</td>
+      <td>{{.Hours}}</td>
+      <td class="right">{{formatRupiah .Amount}}</td>
+    </tr>
+    {{end}}
+    <tr class="total-row">
+      <td colspan="2">Total Overtime</td>
+      <td class="right">{{formatRupiah .TotalOvertime}}</td>
+    </tr>
+  </table>
+  {{end}}
+
+  {{if .Reimbursements}}
+  <div class="section-title">Reimbursements</div>
+  <table>
+    <tr>
+      <th>Description</th>
+      <th class="right">Amount</th>
+    </tr>
+    {{range .Reimbursements}}
+    <tr>
+      <td>{{.Title}}</td>
+      <td class="right">{{formatRupiah .Amount}}</td>
+    </tr>
+    {{end}}
+    <tr class="total-row">
+      <td>Total Reimbursement</td>
+      <td class="right">{{formatRupiah .TotalReimbursement}}</td>
+    </tr>
+  </table>
+  {{end}}
+
+  <div class="section-title">Termination Benefits</div>
+  <table>
+    <tr>
+      <th>Description</th>
+      <th class="right">Amount</th>
+    </tr>
+    <tr>
+      <td>Unused Leave ({{.UnusedLeaveDays}} days)</td>
+      <td class="right">{{formatRupiah .LeaveEncashment}}</td>
+    </tr>
+    {{if .SeverancePay}}
+    <tr>
+      <td>Severance Pay ({{.SeveranceMonths}} x {{formatRupiah .MonthlyWage}})</td>
+      <td class="right">{{formatRupiah .SeverancePay}}</td>
+    </tr>
+    {{end}}
+    {{if .ServiceAwardPay}}
+    <tr>
+      <td>Service Award ({{.ServiceAwardMonths}} x {{formatRupiah .MonthlyWage}})</td>
+      <td class="right">{{formatRupiah .ServiceAwardPay}}</td>
+    </tr>
+    {{end}}
+    {{if .CompensationPay}}
+    <tr>
+      <td>Contract Compensation ({{printf "%.2f" .CompensationMonths}} x {{formatRupiah .MonthlyWage}})</td>
+      <td class="right">{{formatRupiah .CompensationPay}}</td>
+    </tr>
+    {{end}}
+    {{if .SeparationPay}}
+    <tr>
+      <td>Separation Pay</td>
+      <td class="right">{{formatRupiah .SeparationPay}}</td>
+    </tr>
+    {{end}}
+  </table>
+
+  {{if .LoanDeduction}}
+  <div class="section-title">Deductions</div>
+  <table>
+    <tr>
+      <td>Outstanding Loan</td>
+      <td class="right">- {{formatRupiah .LoanDeduction}}</td>
+    </tr>
+  </table>
+  {{end}}
+
+  <div class="section-title">Net Settlement</div>
+  <table>
+    <tr class="total-row">
+      <td>Take Home Pay</td>
+      <td class="right">{{formatRupiah .TakeHomePay}}</td>
+    </tr>
+  </table>
+
+  <div class="footer">
+    This final settlement was generated automatically and does not require a signature.<br>
+    {{if .SettledAt}}Settled at: {{.SettledAt.Format "2006-01-02 15:04:05"}}<br>{{end}}
+    Generated at: {{.GeneratedAt.Format "2006-01-02 15:04:05"}}
+  </div>
+
+</body>
+</html>`
+
+	tmpl, err := template.New("payslip_settlement").Funcs(funcMap).Parse(htmlTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	// Execute template
+	var result strings.Builder
+	err = tmpl.Execute(&result, data)
+	if err != nil {
+		return "", err
+	}
+
+	return result.String(), nil
+}
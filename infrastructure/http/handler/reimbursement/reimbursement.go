@@ -1,10 +1,14 @@
 package reimbursement
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
 	"github.com/riskykurniawan15/payrolls/models/reimbursement"
@@ -20,6 +24,20 @@ type (
 		Update(ctx echo.Context) error
 		Delete(ctx echo.Context) error
 		List(ctx echo.Context) error
+		UploadAttachment(ctx echo.Context) error
+		ListAttachments(ctx echo.Context) error
+		DownloadAttachment(ctx echo.Context) error
+		DeleteAttachment(ctx echo.Context) error
+		ListAll(ctx echo.Context) error
+		GetForReview(ctx echo.Context) error
+		DownloadReviewAttachment(ctx echo.Context) error
+		Approve(ctx echo.Context) error
+		Reject(ctx echo.Context) error
+		CreateCategory(ctx echo.Context) error
+		UpdateCategory(ctx echo.Context) error
+		ListCategories(ctx echo.Context) error
+		GetBalance(ctx echo.Context) error
+		ListDuplicates(ctx echo.Context) error
 	}
 
 	ReimbursementHandler struct {
@@ -49,6 +67,7 @@ func (handler ReimbursementHandler) Create(ctx echo.Context) error {
 	}
 
 	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"category_id": req.CategoryID,
 		"title":       req.Title,
 		"date":        req.Date,
 		"amount":      req.Amount,
@@ -272,6 +291,7 @@ func (handler ReimbursementHandler) List(ctx echo.Context) error {
 		Limit:     limit,
 		StartDate: &startDate,
 		EndDate:   &endDate,
+		Status:    ctx.QueryParam("status"),
 		SortBy:    sortBy,
 		SortDesc:  sortDesc,
 	}
@@ -300,3 +320,608 @@ func (handler ReimbursementHandler) List(ctx echo.Context) error {
 		"meta": response.Pagination,
 	}))
 }
+
+func (handler ReimbursementHandler) UploadAttachment(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		handler.logger.ErrorT("failed to read uploaded file", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "File is required",
+		}))
+	}
+
+	if fileHeader.Size > constant.ReceiptMaxSize {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "File exceeds the maximum size of 5 MB",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":        id,
+		"file_name": fileHeader.Filename,
+		"file_size": fileHeader.Size,
+	})
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Failed to open uploaded file",
+		}))
+	}
+	defer file.Close()
+
+	// Read one byte past the limit so the service can reject oversized bodies
+	content, err := io.ReadAll(io.LimitReader(file, constant.ReceiptMaxSize+1))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Failed to read uploaded file",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.UploadAttachment(serviceCtx, uint(id), userID, reimbursement.UploadAttachmentRequest{
+		FileName: fileHeader.Filename,
+		Content:  content,
+	})
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler ReimbursementHandler) ListAttachments(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.ListAttachments(serviceCtx, uint(id), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler ReimbursementHandler) DownloadAttachment(ctx echo.Context) error {
+	id, attachmentID, err := parseAttachmentParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":            id,
+		"attachment_id": attachmentID,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	file, err := handler.reimbursementServices.DownloadAttachment(serviceCtx, id, attachmentID, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.FileName))
+	return ctx.Blob(http.StatusOK, file.ContentType, file.Content)
+}
+
+func (handler ReimbursementHandler) DeleteAttachment(ctx echo.Context) error {
+	id, attachmentID, err := parseAttachmentParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":            id,
+		"attachment_id": attachmentID,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	if err := handler.reimbursementServices.DeleteAttachment(serviceCtx, id, attachmentID, userID); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler ReimbursementHandler) ListAll(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+	userID, _ := strconv.ParseUint(ctx.QueryParam("user_id"), 10, 32)
+	startDate := ctx.QueryParam("start_date")
+	endDate := ctx.QueryParam("end_date")
+
+	req := reimbursement.ListAllReimbursementsRequest{
+		Page:               page,
+		Limit:              limit,
+		UserID:             uint(userID),
+		Status:             ctx.QueryParam("status"),
+		SuspectedDuplicate: ctx.QueryParam("suspected_duplicate") == "true",
+		UserIDs:            middleware.GetTeamUserIDs(ctx),
+	}
+	if startDate != "" {
+		req.StartDate = &startDate
+	}
+	if endDate != "" {
+		req.EndDate = &endDate
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":       req.Page,
+		"limit":      req.Limit,
+		"user_id":    req.UserID,
+		"start_date": startDate,
+		"end_date":   endDate,
+		"status":     req.Status,
+		"duplicate":  req.SuspectedDuplicate,
+	})
+
+	// Set default values before validation
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.ListAll(serviceCtx, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler ReimbursementHandler) GetForReview(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.GetForReview(serviceCtx, uint(id), middleware.GetTeamUserIDs(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler ReimbursementHandler) DownloadReviewAttachment(ctx echo.Context) error {
+	id, attachmentID, err := parseAttachmentParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":            id,
+		"attachment_id": attachmentID,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	file, err := handler.reimbursementServices.DownloadReviewAttachment(serviceCtx, id, attachmentID, middleware.GetTeamUserIDs(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.FileName))
+	return ctx.Blob(http.StatusOK, file.ContentType, file.Content)
+}
+
+func (handler ReimbursementHandler) Approve(ctx echo.Context) error {
+	return handler.review(ctx, true)
+}
+
+func (handler ReimbursementHandler) Reject(ctx echo.Context) error {
+	return handler.review(ctx, false)
+}
+
+func (handler ReimbursementHandler) review(ctx echo.Context, approve bool) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req reimbursement.ReviewReimbursementRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":              id,
+		"approve":         approve,
+		"approved_amount": req.ApprovedAmount,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get reviewer ID from middleware, team routes only reach the reviewer's reports
+	reviewerID := middleware.GetUserID(ctx)
+	req.UserIDs = middleware.GetTeamUserIDs(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	var response *reimbursement.ReimbursementResponse
+	if approve {
+		response, err = handler.reimbursementServices.Approve(serviceCtx, uint(id), reviewerID, req)
+	} else {
+		response, err = handler.reimbursementServices.Reject(serviceCtx, uint(id), reviewerID, req)
+	}
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, reimbursementServices.ErrSelfReview) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler ReimbursementHandler) CreateCategory(ctx echo.Context) error {
+	var req reimbursement.CreateCategoryRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"code": req.Code,
+		"name": req.Name,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.CreateCategory(serviceCtx, req, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler ReimbursementHandler) UpdateCategory(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req reimbursement.UpdateCategoryRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.UpdateCategory(serviceCtx, uint(id), req, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler ReimbursementHandler) ListCategories(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.ListCategories(serviceCtx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler ReimbursementHandler) GetBalance(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.GetBalance(serviceCtx, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler ReimbursementHandler) ListDuplicates(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+	userID, _ := strconv.ParseUint(ctx.QueryParam("user_id"), 10, 32)
+
+	req := reimbursement.DuplicateReportRequest{
+		Page:   page,
+		Limit:  limit,
+		UserID: uint(userID),
+		Reason: ctx.QueryParam("reason"),
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":    req.Page,
+		"limit":   req.Limit,
+		"user_id": req.UserID,
+		"reason":  req.Reason,
+	})
+
+	// Set default values before validation
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.reimbursementServices.ListDuplicates(serviceCtx, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+// parseAttachmentParams reads the reimbursement and attachment IDs from the URL
+func parseAttachmentParams(ctx echo.Context) (uint, uint, error) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	attachmentID, err := strconv.ParseUint(ctx.Param("attachment_id"), 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint(id), uint(attachmentID), nil
+}
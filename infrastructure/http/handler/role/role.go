@@ -0,0 +1,299 @@
+package role
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/role"
+	roleServices "github.com/riskykurniawan15/payrolls/services/role"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+type (
+	IRoleHandler interface {
+		ListPermissions(ctx echo.Context) error
+		List(ctx echo.Context) error
+		GetByID(ctx echo.Context) error
+		Create(ctx echo.Context) error
+		Update(ctx echo.Context) error
+		Delete(ctx echo.Context) error
+		GetUserRoles(ctx echo.Context) error
+		SetUserRoles(ctx echo.Context) error
+		OwnPermissions(ctx echo.Context) error
+	}
+
+	RoleHandler struct {
+		logger       logger.Logger
+		roleServices roleServices.IRoleService
+	}
+)
+
+func NewRoleHandlers(logger logger.Logger, roleServices roleServices.IRoleService) IRoleHandler {
+	return &RoleHandler{
+		logger:       logger,
+		roleServices: roleServices,
+	}
+}
+
+func (handler RoleHandler) ListPermissions(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.roleServices.ListPermissions(serviceCtx)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler RoleHandler) List(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.roleServices.ListRoles(serviceCtx)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler RoleHandler) GetByID(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.roleServices.GetRole(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler RoleHandler) Create(ctx echo.Context) error {
+	var req role.CreateRoleRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"name":        req.Name,
+		"permissions": req.Permissions,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.roleServices.CreateRole(serviceCtx, req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler RoleHandler) Update(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req role.UpdateRoleRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":          id,
+		"name":        req.Name,
+		"permissions": req.Permissions,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.roleServices.UpdateRole(serviceCtx, uint(id), req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler RoleHandler) Delete(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	if err := handler.roleServices.DeleteRole(serviceCtx, uint(id), middleware.GetUserID(ctx)); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler RoleHandler) GetUserRoles(ctx echo.Context) error {
+	// Get user ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.roleServices.GetUserRoles(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler RoleHandler) SetUserRoles(ctx echo.Context) error {
+	// Get user ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req role.SetUserRolesRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"user_id":  id,
+		"role_ids": req.RoleIDs,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.roleServices.SetUserRoles(serviceCtx, uint(id), req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler RoleHandler) OwnPermissions(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.roleServices.GetUserRoles(serviceCtx, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler RoleHandler) validationError(ctx echo.Context, err error) error {
+	if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+		return ctx.JSON(http.StatusBadRequest, entities.Response{
+			Status:  http.StatusBadRequest,
+			Message: "Bad Request",
+			Error:   "Validation failed",
+			Meta: map[string]interface{}{
+				"validation_errors": validationErrors.GetValidationErrors(),
+			},
+		})
+	}
+	return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+		"error": err.Error(),
+	}))
+}
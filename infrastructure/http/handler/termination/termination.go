@@ -0,0 +1,268 @@
+package termination
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/termination"
+	terminationServices "github.com/riskykurniawan15/payrolls/services/termination"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+type (
+	ITerminationHandler interface {
+		List(ctx echo.Context) error
+		GetByID(ctx echo.Context) error
+		Create(ctx echo.Context) error
+		Update(ctx echo.Context) error
+		Cancel(ctx echo.Context) error
+		Settle(ctx echo.Context) error
+	}
+
+	TerminationHandler struct {
+		logger              logger.Logger
+		terminationServices terminationServices.ITerminationService
+	}
+)
+
+func NewTerminationHandlers(logger logger.Logger, terminationServices terminationServices.ITerminationService) ITerminationHandler {
+	return &TerminationHandler{
+		logger:              logger,
+		terminationServices: terminationServices,
+	}
+}
+
+func (handler TerminationHandler) List(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+
+	req := termination.ListTerminationsRequest{
+		Page:   page,
+		Limit:  limit,
+		Status: ctx.QueryParam("status"),
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":   req.Page,
+		"limit":  req.Limit,
+		"status": req.Status,
+	})
+
+	// Set default values before validation
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.terminationServices.ListTerminations(serviceCtx, req)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler TerminationHandler) GetByID(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.terminationServices.GetTermination(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler TerminationHandler) Create(ctx echo.Context) error {
+	var req termination.CreateTerminationRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		handler.logger.ErrorT("failed to bind request body", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"user_id":          req.UserID,
+		"last_working_day": req.LastWorkingDay,
+		"reason":           req.Reason,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.terminationServices.CreateTermination(serviceCtx, req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler TerminationHandler) Update(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req termination.UpdateTerminationRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":               id,
+		"last_working_day": req.LastWorkingDay,
+		"reason":           req.Reason,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		return handler.validationError(ctx, err)
+	}
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.terminationServices.UpdateTermination(serviceCtx, uint(id), req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler TerminationHandler) Cancel(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	if err := handler.terminationServices.CancelTermination(serviceCtx, uint(id), middleware.GetUserID(ctx)); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler TerminationHandler) Settle(ctx echo.Context) error {
+	// Get ID from URL parameter
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req termination.SettleTerminationRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	// The body is optional, an empty body settles for real
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":      id,
+		"dry_run": req.DryRun,
+	})
+
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	response, err := handler.terminationServices.SettleTermination(serviceCtx, uint(id), req, middleware.GetUserID(ctx))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data":    response,
+		"dry_run": req.DryRun,
+	}))
+}
+
+func (handler TerminationHandler) validationError(ctx echo.Context, err error) error {
+	if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+		return ctx.JSON(http.StatusBadRequest, entities.Response{
+			Status:  http.StatusBadRequest,
+			Message: "Bad Request",
+			Error:   "Validation failed",
+			Meta: map[string]interface{}{
+				"validation_errors": validationErrors.GetValidationErrors(),
+			},
+		})
+	}
+	return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+		"error": err.Error(),
+	}))
+}
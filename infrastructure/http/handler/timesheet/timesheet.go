@@ -0,0 +1,121 @@
+package timesheet
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/timesheet"
+	timesheetServices "github.com/riskykurniawan15/payrolls/services/timesheet"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+type (
+	ITimesheetHandler interface {
+		Get(ctx echo.Context) error
+		GetAll(ctx echo.Context) error
+	}
+
+	TimesheetHandler struct {
+		logger            logger.Logger
+		timesheetServices timesheetServices.ITimesheetService
+	}
+)
+
+func NewTimesheetHandlers(logger logger.Logger, timesheetServices timesheetServices.ITimesheetService) ITimesheetHandler {
+	return &TimesheetHandler{
+		logger:            logger,
+		timesheetServices: timesheetServices,
+	}
+}
+
+func (handler TimesheetHandler) Get(ctx echo.Context) error {
+	// Employees only see their own timesheet
+	req := timesheet.TimesheetRequest{
+		Month:  ctx.QueryParam("month"),
+		UserID: middleware.GetUserID(ctx),
+		Format: ctx.QueryParam("format"),
+	}
+
+	return handler.respond(ctx, req)
+}
+
+func (handler TimesheetHandler) GetAll(ctx echo.Context) error {
+	// Without user_id the report covers every employee
+	userID, _ := strconv.ParseUint(ctx.QueryParam("user_id"), 10, 32)
+
+	req := timesheet.TimesheetRequest{
+		Month:  ctx.QueryParam("month"),
+		UserID: uint(userID),
+		Format: ctx.QueryParam("format"),
+	}
+
+	return handler.respond(ctx, req)
+}
+
+func (handler TimesheetHandler) respond(ctx echo.Context, req timesheet.TimesheetRequest) error {
+	requestID := middleware.GetRequestID(ctx)
+
+	// Set default values before validation
+	if req.Month == "" {
+		req.Month = time.Now().Format("2006-01")
+	}
+	if req.Format == "" {
+		req.Format = constant.TimesheetFormatJSON
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"month":   req.Month,
+		"user_id": req.UserID,
+		"format":  req.Format,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	if req.Format == constant.TimesheetFormatJSON {
+		response, err := handler.timesheetServices.GetTimesheets(serviceCtx, req)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+				"error": err.Error(),
+			}))
+		}
+
+		return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+			"data": response,
+		}))
+	}
+
+	export, err := handler.timesheetServices.Export(serviceCtx, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", export.FileName))
+	return ctx.Blob(http.StatusOK, export.ContentType, export.Content)
+}
@@ -1,9 +1,14 @@
 package user
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
 	"github.com/riskykurniawan15/payrolls/models/user"
@@ -15,7 +20,33 @@ import (
 type (
 	IUserHandler interface {
 		Login(ctx echo.Context) error
+		Refresh(ctx echo.Context) error
+		Logout(ctx echo.Context) error
 		Profile(ctx echo.Context) error
+		List(ctx echo.Context) error
+		Create(ctx echo.Context) error
+		GetByID(ctx echo.Context) error
+		Update(ctx echo.Context) error
+		Deactivate(ctx echo.Context) error
+		Activate(ctx echo.Context) error
+		Unlock(ctx echo.Context) error
+		ResetPassword(ctx echo.Context) error
+		RedeemPasswordReset(ctx echo.Context) error
+		ChangePassword(ctx echo.Context) error
+		GetOwnEmployeeProfile(ctx echo.Context) error
+		UpdateOwnEmployeeProfile(ctx echo.Context) error
+		GetEmployeeProfile(ctx echo.Context) error
+		SaveEmployeeProfile(ctx echo.Context) error
+		RevokeSessions(ctx echo.Context) error
+		VerifyTwoFactor(ctx echo.Context) error
+		TwoFactorStatus(ctx echo.Context) error
+		SetupTwoFactor(ctx echo.Context) error
+		EnableTwoFactor(ctx echo.Context) error
+		DisableTwoFactor(ctx echo.Context) error
+		RegenerateRecoveryCodes(ctx echo.Context) error
+		ResetTwoFactor(ctx echo.Context) error
+		OIDCLogin(ctx echo.Context) error
+		OIDCCallback(ctx echo.Context) error
 	}
 
 	UserHandler struct {
@@ -70,14 +101,24 @@ func (handler UserHandler) Login(ctx echo.Context) error {
 	// Add request ID to context
 	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
 
+	// Record where the session was opened
+	req.IP = ctx.RealIP()
+	req.UserAgent = ctx.Request().UserAgent()
+
 	// Call service
 	response, err := handler.userServices.Login(serviceCtx, req)
 	if err != nil {
 		handler.logger.ErrorT("service error", requestID, map[string]interface{}{
 			"error": err.Error(),
 		})
-		return ctx.JSON(http.StatusUnauthorized, entities.ResponseFormater(http.StatusUnauthorized, map[string]interface{}{
-			"error": err.Error(),
+
+		return loginErrorResponse(ctx, err)
+	}
+
+	// The password was right but a second factor is needed, the client continues at /auth/2fa/verify
+	if response.TwoFactorChallenge != nil {
+		return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+			"data": response.TwoFactorChallenge,
 		}))
 	}
 
@@ -91,6 +132,99 @@ func (handler UserHandler) Login(ctx echo.Context) error {
 	}))
 }
 
+// loginErrorResponse answers a failed login, throttled and locked logins tell the client when to try again
+func loginErrorResponse(ctx echo.Context, err error) error {
+	var blocked *userServices.LoginBlockedError
+	if errors.As(err, &blocked) {
+		retryAfter := int(math.Ceil(blocked.RetryAfter.Seconds()))
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		return ctx.JSON(http.StatusTooManyRequests, entities.ResponseFormater(http.StatusTooManyRequests, map[string]interface{}{
+			"error": err.Error(),
+			"meta": map[string]interface{}{
+				"reason":      blocked.Reason,
+				"retry_after": retryAfter,
+			},
+		}))
+	}
+
+	return ctx.JSON(http.StatusUnauthorized, entities.ResponseFormater(http.StatusUnauthorized, map[string]interface{}{
+		"error": err.Error(),
+	}))
+}
+
+func (handler UserHandler) Refresh(ctx echo.Context) error {
+	var req user.RefreshTokenRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming refresh request", requestID, nil)
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Record where the session was refreshed
+	req.IP = ctx.RealIP()
+	req.UserAgent = ctx.Request().UserAgent()
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.Refresh(serviceCtx, req)
+	if err != nil {
+		handler.logger.WarningT("refresh failed", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx.JSON(http.StatusUnauthorized, entities.ResponseFormater(http.StatusUnauthorized, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) Logout(ctx echo.Context) error {
+	userCtx := middleware.GetUserContext(ctx)
+	requestID := middleware.GetRequestID(ctx)
+
+	handler.logger.InfoT("incoming logout request", requestID, map[string]interface{}{
+		"user_id": userCtx.UserID,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	if err := handler.userServices.Logout(serviceCtx, userCtx.TokenID); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 func (handler UserHandler) Profile(ctx echo.Context) error {
 	// Get user ID from middleware context
 	userID := middleware.GetUserID(ctx)
@@ -125,3 +259,1105 @@ func (handler UserHandler) Profile(ctx echo.Context) error {
 		"data": response,
 	}))
 }
+
+func (handler UserHandler) List(ctx echo.Context) error {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.QueryParam("page"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+
+	req := user.ListUsersRequest{
+		Page:   page,
+		Limit:  limit,
+		Search: ctx.QueryParam("search"),
+		Role:   ctx.QueryParam("role"),
+	}
+	if statusStr := ctx.QueryParam("status"); statusStr != "" {
+		status, err := strconv.ParseInt(statusStr, 10, 8)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+				"error": "Invalid status format",
+			}))
+		}
+		statusValue := int8(status)
+		req.Status = &statusValue
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"page":   req.Page,
+		"limit":  req.Limit,
+		"search": req.Search,
+		"role":   req.Role,
+		"status": req.Status,
+	})
+
+	// Set default values before validation
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.ListUsers(serviceCtx, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response.Data,
+		"meta": response.Pagination,
+	}))
+}
+
+func (handler UserHandler) Create(ctx echo.Context) error {
+	var req user.CreateUserRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"username": req.Username,
+		"role":     req.Role,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Any role other than employee grants more than user:manage, so it takes role:manage
+	if req.Role != constant.EmployeeRole && !middleware.HasPermission(ctx, constant.PermissionRoleManage) {
+		return ctx.JSON(http.StatusForbidden, entities.ResponseFormater(http.StatusForbidden, map[string]interface{}{
+			"error": "Access denied. Required permission: " + constant.PermissionRoleManage,
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.CreateUser(serviceCtx, req, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) GetByID(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.GetUser(serviceCtx, uint(id))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) Update(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req user.UpdateUserRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":              id,
+		"role":            req.Role,
+		"salary":          req.Salary,
+		"grade":           req.Grade,
+		"employment_type": req.EmploymentType,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Changing a role is role assignment, which user:manage alone does not cover
+	if req.Role != nil && !middleware.HasPermission(ctx, constant.PermissionRoleManage) {
+		return ctx.JSON(http.StatusForbidden, entities.ResponseFormater(http.StatusForbidden, map[string]interface{}{
+			"error": "Access denied. Required permission: " + constant.PermissionRoleManage,
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID and the caller's permissions to context, they decide whether the target user is protected
+	serviceCtx := middleware.AddPermissionsToContext(middleware.AddRequestIDToContext(ctx.Request().Context(), requestID), middleware.GetPermissions(ctx))
+
+	// Call service
+	response, err := handler.userServices.UpdateUser(serviceCtx, uint(id), req, userID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, userServices.ErrProtectedUser) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) Deactivate(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID and the caller's permissions to context, they decide whether the target user is protected
+	serviceCtx := middleware.AddPermissionsToContext(middleware.AddRequestIDToContext(ctx.Request().Context(), requestID), middleware.GetPermissions(ctx))
+
+	// Call service
+	response, err := handler.userServices.Deactivate(serviceCtx, uint(id), userID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, userServices.ErrProtectedUser) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) Activate(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID and the caller's permissions to context, they decide whether the target user is protected
+	serviceCtx := middleware.AddPermissionsToContext(middleware.AddRequestIDToContext(ctx.Request().Context(), requestID), middleware.GetPermissions(ctx))
+
+	// Call service
+	response, err := handler.userServices.Activate(serviceCtx, uint(id), userID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, userServices.ErrProtectedUser) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) Unlock(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.Unlock(serviceCtx, uint(id), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) ResetPassword(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID and the caller's permissions to context, they decide whether the target user is protected
+	serviceCtx := middleware.AddPermissionsToContext(middleware.AddRequestIDToContext(ctx.Request().Context(), requestID), middleware.GetPermissions(ctx))
+
+	// Call service
+	response, err := handler.userServices.ResetPassword(serviceCtx, uint(id), userID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, userServices.ErrProtectedUser) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusCreated, entities.ResponseFormater(http.StatusCreated, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) RedeemPasswordReset(ctx echo.Context) error {
+	var req user.ResetPasswordRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming password reset request", requestID, nil)
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	if err := handler.userServices.RedeemPasswordReset(serviceCtx, req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler UserHandler) ChangePassword(ctx echo.Context) error {
+	var req user.ChangePasswordRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	handler.logger.InfoT("incoming change password request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Record where the new session was opened
+	req.IP = ctx.RealIP()
+	req.UserAgent = ctx.Request().UserAgent()
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.ChangePassword(serviceCtx, userID, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) GetOwnEmployeeProfile(ctx echo.Context) error {
+	// Get user context from middleware
+	userID := middleware.GetUserID(ctx)
+	requestID := middleware.GetRequestID(ctx)
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.GetEmployeeProfile(serviceCtx, userID, middleware.HasPermission(ctx, constant.PermissionEmployeeReadSensitive))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) UpdateOwnEmployeeProfile(ctx echo.Context) error {
+	var req user.UpdateOwnProfileRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.UpdateOwnProfile(serviceCtx, userID, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) GetEmployeeProfile(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.GetEmployeeProfile(serviceCtx, uint(id), middleware.HasPermission(ctx, constant.PermissionEmployeeReadSensitive))
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) SaveEmployeeProfile(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	var req user.SaveEmployeeProfileRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id":              id,
+		"full_name":       req.FullName,
+		"employee_number": req.EmployeeNumber,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID and the caller's permissions to context, they decide whether the target user is protected
+	serviceCtx := middleware.AddPermissionsToContext(middleware.AddRequestIDToContext(ctx.Request().Context(), requestID), middleware.GetPermissions(ctx))
+
+	// Call service
+	response, err := handler.userServices.SaveEmployeeProfile(serviceCtx, uint(id), req, userID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, userServices.ErrProtectedUser) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) RevokeSessions(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID and the caller's permissions to context, they decide whether the target user is protected
+	serviceCtx := middleware.AddPermissionsToContext(middleware.AddRequestIDToContext(ctx.Request().Context(), requestID), middleware.GetPermissions(ctx))
+
+	// Call service
+	response, err := handler.userServices.RevokeSessions(serviceCtx, uint(id), userID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, userServices.ErrProtectedUser) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) VerifyTwoFactor(ctx echo.Context) error {
+	var req user.VerifyTwoFactorRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	handler.logger.InfoT("incoming two-factor verification", requestID, nil)
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Record where the session was opened
+	req.IP = ctx.RealIP()
+	req.UserAgent = ctx.Request().UserAgent()
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.VerifyTwoFactor(serviceCtx, req)
+	if err != nil {
+		handler.logger.ErrorT("service error", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return loginErrorResponse(ctx, err)
+	}
+
+	handler.logger.InfoT("login successful", requestID, map[string]interface{}{
+		"user_id": response.User.ID,
+	})
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) TwoFactorStatus(ctx echo.Context) error {
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+	requestID := middleware.GetRequestID(ctx)
+
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.TwoFactorStatus(serviceCtx, userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) SetupTwoFactor(ctx echo.Context) error {
+	var req user.SetupTwoFactorRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	handler.logger.InfoT("incoming two-factor setup request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.SetupTwoFactor(serviceCtx, userID, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) EnableTwoFactor(ctx echo.Context) error {
+	var req user.TwoFactorCodeRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	handler.logger.InfoT("incoming enable two-factor request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.EnableTwoFactor(serviceCtx, userID, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) DisableTwoFactor(ctx echo.Context) error {
+	var req user.DisableTwoFactorRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	handler.logger.InfoT("incoming disable two-factor request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	if err := handler.userServices.DisableTwoFactor(serviceCtx, userID, req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler UserHandler) RegenerateRecoveryCodes(ctx echo.Context) error {
+	var req user.TwoFactorCodeRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		}))
+	}
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	handler.logger.InfoT("incoming regenerate recovery codes request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.RegenerateRecoveryCodes(serviceCtx, userID, req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+func (handler UserHandler) ResetTwoFactor(ctx echo.Context) error {
+	// Get ID from URL parameter
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid ID format",
+		}))
+	}
+
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	// Get user ID from middleware
+	userID := middleware.GetUserID(ctx)
+
+	// Add request ID and the caller's permissions to context, they decide whether the target user is protected
+	serviceCtx := middleware.AddPermissionsToContext(middleware.AddRequestIDToContext(ctx.Request().Context(), requestID), middleware.GetPermissions(ctx))
+
+	// Call service
+	response, err := handler.userServices.ResetTwoFactor(serviceCtx, uint(id), userID)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, userServices.ErrProtectedUser) {
+			status = http.StatusForbidden
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
+
+// OIDCLogin sends the browser to the identity provider, clients asking for JSON receive the URL instead
+func (handler UserHandler) OIDCLogin(ctx echo.Context) error {
+	requestID := middleware.GetRequestID(ctx)
+	handler.logger.InfoT("incoming single sign-on request", requestID, nil)
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.OIDCLogin(serviceCtx)
+	if err != nil {
+		handler.logger.ErrorT("service error", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		status := http.StatusInternalServerError
+		if errors.Is(err, userServices.ErrSingleSignOnDisabled) {
+			status = http.StatusNotFound
+		}
+		return ctx.JSON(status, entities.ResponseFormater(status, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	if strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), echo.MIMEApplicationJSON) {
+		return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+			"data": response,
+		}))
+	}
+	return ctx.Redirect(http.StatusFound, response.AuthorizationURL)
+}
+
+// OIDCCallback is where the identity provider sends the browser back to, it answers like a password login
+func (handler UserHandler) OIDCCallback(ctx echo.Context) error {
+	var req user.OIDCCallbackRequest
+	requestID := middleware.GetRequestID(ctx)
+
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid query parameters",
+		}))
+	}
+
+	handler.logger.InfoT("incoming single sign-on callback", requestID, nil)
+
+	// Validate request
+	if err := ctx.Validate(&req); err != nil {
+		if validationErrors, ok := err.(*validator.ValidationErrors); ok {
+			return ctx.JSON(http.StatusBadRequest, entities.Response{
+				Status:  http.StatusBadRequest,
+				Message: "Bad Request",
+				Error:   "Validation failed",
+				Meta: map[string]interface{}{
+					"validation_errors": validationErrors.GetValidationErrors(),
+				},
+			})
+		}
+		return ctx.JSON(http.StatusBadRequest, entities.ResponseFormater(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		}))
+	}
+
+	// Record where the session was opened
+	req.IP = ctx.RealIP()
+	req.UserAgent = ctx.Request().UserAgent()
+
+	// Add request ID to context
+	serviceCtx := middleware.AddRequestIDToContext(ctx.Request().Context(), requestID)
+
+	// Call service
+	response, err := handler.userServices.OIDCCallback(serviceCtx, req)
+	if err != nil {
+		handler.logger.ErrorT("service error", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		if errors.Is(err, userServices.ErrSingleSignOnDisabled) {
+			return ctx.JSON(http.StatusNotFound, entities.ResponseFormater(http.StatusNotFound, map[string]interface{}{
+				"error": err.Error(),
+			}))
+		}
+		return loginErrorResponse(ctx, err)
+	}
+
+	// The account has an authenticator, the client continues at /auth/2fa/verify
+	if response.TwoFactorChallenge != nil {
+		return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+			"data": response.TwoFactorChallenge,
+		}))
+	}
+
+	handler.logger.InfoT("login successful", requestID, map[string]interface{}{
+		"user_id": response.User.ID,
+	})
+
+	return ctx.JSON(http.StatusOK, entities.ResponseFormater(http.StatusOK, map[string]interface{}{
+		"data": response,
+	}))
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"strings"
 	"time"
@@ -25,19 +26,35 @@ func NewAuditTrailMiddleware(auditTrailService auditTrailService.IAuditTrailServ
 	}
 }
 
+// sensitivePaths skip payload recording entirely, login payloads are kept with the password masked so
+// failed attempts can be traced back to the username
 var sensitivePaths = []string{
-	"/auth/login",
 	"/health",
 }
 
+// sensitiveFields are masked in the recorded payload so user management stays audited without storing
+// passwords, identity numbers or two-factor and recovery codes
+var sensitiveFields = []string{
+	"password",
+	"new_password",
+	"current_password",
+	"token",
+	"nik",
+	"npwp",
+	"bank_account_number",
+	"refresh_token",
+	"challenge_token",
+	"code",
+}
+
 func (m *AuditTrailMiddleware) AuditTrail() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			start := time.Now()
 
-			// Capture request body
+			// Capture request body, uploads are never logged so they are streamed to the handler untouched
 			var requestBody []byte
-			if c.Request().Body != nil {
+			if c.Request().Body != nil && !isMultipartRequest(c) {
 				requestBody, _ = io.ReadAll(c.Request().Body)
 				// Restore body for handler
 				c.Request().Body = io.NopCloser(bytes.NewBuffer(requestBody))
@@ -56,9 +73,11 @@ func (m *AuditTrailMiddleware) AuditTrail() echo.MiddlewareFunc {
 			// Calculate response time
 			responseTime := int(time.Since(start).Milliseconds())
 
-			// Get user context from JWT if available
-			var userID *uint
-			if user := c.Get("user"); user != nil {
+			// Get user context from JWT if available, requests made with an API key are recorded against the key
+			var userID, apiKeyID *uint
+			if id := GetAPIKeyID(c); id != 0 {
+				apiKeyID = &id
+			} else if user := c.Get("user"); user != nil {
 				if userContext, ok := user.(jwt.UserContext); ok {
 					userID = &userContext.UserID
 				}
@@ -70,14 +89,15 @@ func (m *AuditTrailMiddleware) AuditTrail() echo.MiddlewareFunc {
 				Method:         c.Request().Method,
 				Path:           c.Request().URL.Path,
 				UserID:         userID,
+				APIKeyID:       apiKeyID,
 				ResponseCode:   c.Response().Status,
 				ResponseTimeMs: &responseTime,
 				UserAgent:      getStringPtr(c.Request().UserAgent()),
 			}
 
-			// Handle request payload (exclude sensitive data)
+			// Handle request payload (exclude sensitive data and file uploads)
 			if len(requestBody) > 0 && !isSensitiveEndpoint(c.Request().URL.Path) {
-				payloadStr := string(requestBody)
+				payloadStr := maskSensitiveFields(requestBody)
 				auditReq.Payload = &payloadStr
 			}
 
@@ -116,14 +136,8 @@ func (rc *responseCapture) Write(b []byte) (int, error) {
 }
 
 // Helper functions
+// getClientIP relies on the engine's IP extractor, which only trusts forwarded headers from configured proxies
 func getClientIP(c echo.Context) string {
-	// Check for forwarded headers first
-	if ip := c.Request().Header.Get("X-Forwarded-For"); ip != "" {
-		return strings.Split(ip, ",")[0]
-	}
-	if ip := c.Request().Header.Get("X-Real-IP"); ip != "" {
-		return ip
-	}
 	return c.RealIP()
 }
 
@@ -142,3 +156,31 @@ func isSensitiveEndpoint(path string) bool {
 	}
 	return false
 }
+
+func isMultipartRequest(c echo.Context) bool {
+	return strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm)
+}
+
+func maskSensitiveFields(body []byte) string {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return string(body)
+	}
+
+	masked := false
+	for _, field := range sensitiveFields {
+		if _, ok := payload[field]; ok {
+			payload[field] = "******"
+			masked = true
+		}
+	}
+	if !masked {
+		return string(body)
+	}
+
+	maskedBody, err := json.Marshal(payload)
+	if err != nil {
+		return ""
+	}
+	return string(maskedBody)
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMaskSensitiveFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		masked []string
+		kept   map[string]string
+	}{
+		{
+			name:   "login password",
+			body:   `{"username":"budi","password":"secret"}`,
+			masked: []string{"password"},
+			kept:   map[string]string{"username": "budi"},
+		},
+		{
+			name:   "two-factor code",
+			body:   `{"challenge_token":"abc","code":"123456"}`,
+			masked: []string{"challenge_token", "code"},
+		},
+		{
+			name:   "recovery code when disabling two-factor",
+			body:   `{"password":"secret","code":"ABCD-EFGH"}`,
+			masked: []string{"password", "code"},
+		},
+		{
+			name: "nothing sensitive",
+			body: `{"title":"Taxi","amount":50000}`,
+			kept: map[string]string{"title": "Taxi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var payload map[string]interface{}
+			if err := json.Unmarshal([]byte(maskSensitiveFields([]byte(tt.body))), &payload); err != nil {
+				t.Fatalf("maskSensitiveFields() returned invalid JSON: %v", err)
+			}
+			for _, field := range tt.masked {
+				if payload[field] != "******" {
+					t.Errorf("maskSensitiveFields() %s = %v, want masked", field, payload[field])
+				}
+			}
+			for field, want := range tt.kept {
+				if payload[field] != want {
+					t.Errorf("maskSensitiveFields() %s = %v, want %v", field, payload[field], want)
+				}
+			}
+		})
+	}
+}
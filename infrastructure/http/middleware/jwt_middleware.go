@@ -1,23 +1,49 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+	"github.com/riskykurniawan15/payrolls/models/api_key"
 	"github.com/riskykurniawan15/payrolls/utils/jwt"
 )
 
+// APIKeyHeader carries the key of a system-to-system integration
+const APIKeyHeader = "X-API-Key"
+
 type (
 	JWTConfig struct {
-		SecretKey string
+		SecretKey      string
+		TokenValidator TokenValidator
+		// PasswordChangePaths are the only routes reachable while the user must change their password
+		PasswordChangePaths []string
+		// TwoFactorSetupPaths are the only routes reachable while the user must enrol in two-factor authentication
+		TwoFactorSetupPaths []string
+		// APIKeyAuthenticator makes the routes accept an API key in place of a bearer token, nil rejects keys
+		APIKeyAuthenticator APIKeyAuthenticator
+	}
+
+	// TokenValidator reports whether the session behind an access token is still active
+	TokenValidator interface {
+		IsTokenActive(ctx context.Context, tokenID string) bool
+	}
+
+	// APIKeyAuthenticator resolves an API key to the user it acts as and the permissions it may use
+	APIKeyAuthenticator interface {
+		AuthenticateAPIKey(ctx context.Context, key, ip string) (*api_key.Identity, error)
 	}
 )
 
 func JWTMiddleware(config JWTConfig) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			if key := c.Request().Header.Get(APIKeyHeader); key != "" {
+				return authenticateAPIKey(c, next, config.APIKeyAuthenticator, key)
+			}
+
 			// Get Authorization header
 			authHeader := c.Request().Header.Get("Authorization")
 			if authHeader == "" {
@@ -47,6 +73,27 @@ func JWTMiddleware(config JWTConfig) echo.MiddlewareFunc {
 			// Extract user context
 			userCtx := jwt.ExtractUserContext(claims)
 
+			// Reject tokens whose session was logged out, rotated or revoked
+			if config.TokenValidator != nil && !config.TokenValidator.IsTokenActive(c.Request().Context(), userCtx.TokenID) {
+				return c.JSON(http.StatusUnauthorized, entities.ResponseFormater(http.StatusUnauthorized, map[string]interface{}{
+					"error": "Token has been revoked",
+				}))
+			}
+
+			// Accounts with a seeded or admin issued password must choose their own before doing anything else
+			if userCtx.MustChangePassword && !containsPath(config.PasswordChangePaths, c.Path()) {
+				return c.JSON(http.StatusForbidden, entities.ResponseFormater(http.StatusForbidden, map[string]interface{}{
+					"error": "Password change required",
+				}))
+			}
+
+			// Holders of roles that require two-factor authentication must enrol before doing anything else
+			if userCtx.TwoFactorSetupRequired && !containsPath(config.TwoFactorSetupPaths, c.Path()) {
+				return c.JSON(http.StatusForbidden, entities.ResponseFormater(http.StatusForbidden, map[string]interface{}{
+					"error": "Two-factor authentication setup required",
+				}))
+			}
+
 			// Set user context to context
 			c.Set("user", userCtx)
 
@@ -55,6 +102,41 @@ func JWTMiddleware(config JWTConfig) echo.MiddlewareFunc {
 	}
 }
 
+// authenticateAPIKey lets an integration through as the user who issued the key, with only the key's permissions
+func authenticateAPIKey(c echo.Context, next echo.HandlerFunc, authenticator APIKeyAuthenticator, key string) error {
+	if authenticator == nil {
+		return c.JSON(http.StatusUnauthorized, entities.ResponseFormater(http.StatusUnauthorized, map[string]interface{}{
+			"error": "API keys are not accepted on this endpoint",
+		}))
+	}
+
+	identity, err := authenticator.AuthenticateAPIKey(c.Request().Context(), key, c.RealIP())
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, entities.ResponseFormater(http.StatusUnauthorized, map[string]interface{}{
+			"error": "Invalid, expired or revoked API key",
+		}))
+	}
+
+	c.Set("user", jwt.UserContext{
+		UserID:   identity.UserID,
+		Username: identity.Prefix,
+	})
+	c.Set("api_key_id", identity.APIKeyID)
+	// PermissionMiddleware uses these instead of the permissions of the user
+	c.Set(PermissionsKey, identity.Permissions)
+
+	return next(c)
+}
+
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper function to get user context from context
 func GetUserContext(c echo.Context) jwt.UserContext {
 	userCtx, _ := c.Get("user").(jwt.UserContext)
@@ -78,3 +160,9 @@ func GetRole(c echo.Context) string {
 	userCtx := GetUserContext(c)
 	return userCtx.Role
 }
+
+// GetAPIKeyID returns the ID of the API key that authenticated the request, zero for user sessions
+func GetAPIKeyID(c echo.Context) uint {
+	id, _ := c.Get("api_key_id").(uint)
+	return id
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+)
+
+// PermissionsKey holds the caller's permissions, on the echo context and on the context passed to services
+const PermissionsKey = "permissions"
+
+// PermissionChecker returns every permission granted to a user through their roles
+type PermissionChecker interface {
+	GetUserPermissions(ctx context.Context, userID uint) ([]string, error)
+}
+
+// PermissionMiddleware creates middleware that allows access when the user holds any of the required permissions.
+// Without required permissions it only loads them for HasPermission
+func PermissionMiddleware(checker PermissionChecker, required ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID := GetUserID(c)
+			if userID == 0 {
+				return c.JSON(http.StatusUnauthorized, entities.ResponseFormater(http.StatusUnauthorized, map[string]interface{}{
+					"error": "User not found in token",
+				}))
+			}
+
+			// Permissions are loaded once per request, nested groups reuse them
+			permissions, ok := c.Get(PermissionsKey).([]string)
+			if !ok {
+				var err error
+				permissions, err = checker.GetUserPermissions(c.Request().Context(), userID)
+				if err != nil {
+					return c.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+						"error": "Failed to load permissions",
+					}))
+				}
+				c.Set(PermissionsKey, permissions)
+			}
+
+			if len(required) == 0 {
+				return next(c)
+			}
+
+			for _, permission := range required {
+				if containsPermission(permissions, permission) {
+					return next(c)
+				}
+			}
+
+			return c.JSON(http.StatusForbidden, entities.ResponseFormater(http.StatusForbidden, map[string]interface{}{
+				"error": "Access denied. Required permission: " + strings.Join(required, " or "),
+			}))
+		}
+	}
+}
+
+// GetPermissions returns the permissions loaded by PermissionMiddleware
+func GetPermissions(c echo.Context) []string {
+	permissions, _ := c.Get(PermissionsKey).([]string)
+	return permissions
+}
+
+// AddPermissionsToContext carries the permissions of the caller to the service layer
+func AddPermissionsToContext(ctx context.Context, permissions []string) context.Context {
+	return context.WithValue(ctx, PermissionsKey, permissions)
+}
+
+// GetPermissionsFromContext retrieves the permissions added by AddPermissionsToContext
+func GetPermissionsFromContext(ctx context.Context) []string {
+	if ctx == nil {
+		return nil
+	}
+	permissions, _ := ctx.Value(PermissionsKey).([]string)
+	return permissions
+}
+
+// HasPermission checks a permission loaded by PermissionMiddleware
+func HasPermission(c echo.Context, permission string) bool {
+	return containsPermission(GetPermissions(c), permission)
+}
+
+func containsPermission(permissions []string, permission string) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/entities"
+)
+
+// TeamResolver returns the ids of every direct and indirect report of a manager
+type TeamResolver interface {
+	ReportIDs(ctx context.Context, managerID uint) ([]uint, error)
+}
+
+// TeamScopeMiddleware limits the wrapped admin handlers to the reports of the signed in manager.
+// The ids are stored for GetTeamUserIDs, a manager without reports gets an empty scope that matches nothing
+func TeamScopeMiddleware(resolver TeamResolver) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID := GetUserID(c)
+			if userID == 0 {
+				return c.JSON(http.StatusUnauthorized, entities.ResponseFormater(http.StatusUnauthorized, map[string]interface{}{
+					"error": "User not found in token",
+				}))
+			}
+
+			ids, err := resolver.ReportIDs(c.Request().Context(), userID)
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, entities.ResponseFormater(http.StatusInternalServerError, map[string]interface{}{
+					"error": "Failed to load team",
+				}))
+			}
+			if ids == nil {
+				ids = []uint{}
+			}
+			c.Set("team_user_ids", ids)
+
+			return next(c)
+		}
+	}
+}
+
+// GetTeamUserIDs returns the scope set by TeamScopeMiddleware, nil outside team routes
+func GetTeamUserIDs(c echo.Context) []uint {
+	ids, _ := c.Get("team_user_ids").([]uint)
+	return ids
+}
@@ -1,95 +1,346 @@
-package router
-
-import (
-	"github.com/labstack/echo/v4"
-	echoMiddleware "github.com/labstack/echo/v4/middleware"
-	dep "github.com/riskykurniawan15/payrolls/infrastructure/http"
-	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
-	"github.com/riskykurniawan15/payrolls/utils/validator"
-)
-
-func Routers(dep *dep.Dependencies, jwtSecret string) *echo.Echo {
-	engine := echo.New()
-
-	// Add custom validator
-	engine.Validator = validator.NewCustomValidator()
-
-	// Add request ID middleware globally
-	engine.Use(middleware.RequestIDMiddleware())
-	engine.Use(echoMiddleware.Recover())
-
-	// Add audit trail middleware globally
-	auditTrailMiddleware := middleware.NewAuditTrailMiddleware(dep.AuditTrailService)
-	engine.Use(auditTrailMiddleware.AuditTrail())
-
-	// Public routes
-	engine.GET("/health", dep.HealthHandlers.Metric)
-	engine.POST("/auth/login", dep.UserHandlers.Login)
-
-	// Protected routes with JWT middleware
-	jwtConfig := middleware.JWTConfig{SecretKey: jwtSecret}
-	protected := engine.Group("/user", middleware.JWTMiddleware(jwtConfig))
-	{
-		protected.GET("/profile", dep.UserHandlers.Profile)
-	}
-
-	// Period routes
-	periods := engine.Group("/periods", middleware.JWTMiddleware(jwtConfig), middleware.AdminOnlyMiddleware())
-	{
-		periods.POST("", dep.PeriodHandlers.Create)
-		periods.GET("", dep.PeriodHandlers.List)
-		periods.GET("/:id", dep.PeriodHandlers.GetByID)
-		periods.PUT("/:id", dep.PeriodHandlers.Update)
-		periods.DELETE("/:id", dep.PeriodHandlers.Delete)
-
-		// Period detail routes
-		periods.POST("/:id/run-payroll", dep.PeriodDetailHandlers.RunPayroll)
-	}
-
-	// Attendance routes (for all authenticated users)
-	attendances := engine.Group("/attendances", middleware.JWTMiddleware(jwtConfig), middleware.EmployeeOnlyMiddleware())
-	{
-		attendances.GET("", dep.AttendanceHandlers.GetAttendances)
-		attendances.GET("/:id", dep.AttendanceHandlers.GetAttendanceByID)
-		attendances.POST("/check-in", dep.AttendanceHandlers.CheckIn)
-		attendances.POST("/check-out", dep.AttendanceHandlers.CheckOut)
-		attendances.POST("/check-out/:id", dep.AttendanceHandlers.CheckOutByID)
-	}
-
-	// Overtime routes (for all authenticated users)
-	overtimes := engine.Group("/overtimes", middleware.JWTMiddleware(jwtConfig), middleware.EmployeeOnlyMiddleware())
-	{
-		overtimes.POST("", dep.OvertimeHandlers.Create)
-		overtimes.GET("", dep.OvertimeHandlers.List)
-		overtimes.GET("/:id", dep.OvertimeHandlers.GetByID)
-		overtimes.PUT("/:id", dep.OvertimeHandlers.Update)
-		overtimes.DELETE("/:id", dep.OvertimeHandlers.Delete)
-	}
-
-	// Reimbursement routes (for all authenticated users)
-	reimbursements := engine.Group("/reimbursements", middleware.JWTMiddleware(jwtConfig), middleware.EmployeeOnlyMiddleware())
-	{
-		reimbursements.POST("", dep.ReimbursementHandlers.Create)
-		reimbursements.GET("", dep.ReimbursementHandlers.List)
-		reimbursements.GET("/:id", dep.ReimbursementHandlers.GetByID)
-		reimbursements.PUT("/:id", dep.ReimbursementHandlers.Update)
-		reimbursements.DELETE("/:id", dep.ReimbursementHandlers.Delete)
-	}
-
-	// Payslip routes (employee only)
-	payslips := engine.Group("/payslip", middleware.JWTMiddleware(jwtConfig), middleware.EmployeeOnlyMiddleware())
-	{
-		payslips.GET("", dep.PayslipHandlers.List)
-		payslips.GET("/generate/:id", dep.PayslipHandlers.Generate)
-	}
-	engine.GET("/payslip/print", dep.PayslipHandlers.Print)
-
-	// Payslip summary routes (admin only)
-	payslipSummary := engine.Group("/payslip/summary", middleware.JWTMiddleware(jwtConfig), middleware.AdminOnlyMiddleware())
-	{
-		payslipSummary.GET("/generate/:id", dep.PayslipHandlers.GenerateSummary)
-	}
-	engine.GET("/payslip/summary/print", dep.PayslipHandlers.PrintSummary)
-
-	return engine
-}
+package router
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/riskykurniawan15/payrolls/constant"
+	dep "github.com/riskykurniawan15/payrolls/infrastructure/http"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+func Routers(dep *dep.Dependencies, jwtSecret string, trustedProxies []*net.IPNet) *echo.Echo {
+	engine := echo.New()
+
+	// The client IP drives the login throttle and the audit trail, so forwarded headers
+	// are only believed when they come from a configured proxy
+	engine.IPExtractor = echo.ExtractIPDirect()
+	if len(trustedProxies) > 0 {
+		options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+		for _, proxy := range trustedProxies {
+			options = append(options, echo.TrustIPRange(proxy))
+		}
+		engine.IPExtractor = echo.ExtractIPFromXFFHeader(options...)
+	}
+
+	// Add custom validator
+	engine.Validator = validator.NewCustomValidator()
+
+	// Add request ID middleware globally
+	engine.Use(middleware.RequestIDMiddleware())
+	engine.Use(echoMiddleware.Recover())
+
+	// Add audit trail middleware globally
+	auditTrailMiddleware := middleware.NewAuditTrailMiddleware(dep.AuditTrailService)
+	engine.Use(auditTrailMiddleware.AuditTrail())
+
+	// Public routes
+	engine.GET("/health", dep.HealthHandlers.Metric)
+	engine.POST("/auth/login", dep.UserHandlers.Login)
+	engine.POST("/auth/refresh", dep.UserHandlers.Refresh)
+	engine.POST("/auth/reset-password", dep.UserHandlers.RedeemPasswordReset)
+	engine.POST("/auth/2fa/verify", dep.UserHandlers.VerifyTwoFactor)
+	engine.GET("/auth/oidc/login", dep.UserHandlers.OIDCLogin)
+	engine.GET("/auth/oidc/callback", dep.UserHandlers.OIDCCallback)
+
+	// Protected routes with JWT middleware
+	jwtConfig := middleware.JWTConfig{
+		SecretKey:           jwtSecret,
+		TokenValidator:      dep.UserService,
+		PasswordChangePaths: []string{"/user/password", "/user/profile", "/auth/logout"},
+		TwoFactorSetupPaths: []string{"/user/2fa", "/user/2fa/setup", "/user/2fa/enable", "/user/password", "/user/profile", "/auth/logout"},
+	}
+	engine.POST("/auth/logout", dep.UserHandlers.Logout, middleware.JWTMiddleware(jwtConfig))
+
+	// Management and report routes also accept an API key, self service stays with user sessions
+	integrationConfig := jwtConfig
+	integrationConfig.APIKeyAuthenticator = dep.APIKeyService
+
+	// Routes are guarded by the permissions granted through the user's roles
+	requirePermission := func(permissions ...string) echo.MiddlewareFunc {
+		return middleware.PermissionMiddleware(dep.RoleService, permissions...)
+	}
+
+	// Uploads are refused before they are parsed once the body grows past the file limit
+	uploadLimit := func(maxFileSize int64) echo.MiddlewareFunc {
+		return echoMiddleware.BodyLimit(strconv.FormatInt(maxFileSize+constant.UploadBodyOverhead, 10))
+	}
+
+	protected := engine.Group("/user", middleware.JWTMiddleware(jwtConfig), requirePermission())
+	{
+		protected.GET("/profile", dep.UserHandlers.Profile)
+		protected.POST("/password", dep.UserHandlers.ChangePassword)
+		protected.GET("/permissions", dep.RoleHandlers.OwnPermissions)
+		protected.GET("/employee-profile", dep.UserHandlers.GetOwnEmployeeProfile)
+		protected.PUT("/employee-profile", dep.UserHandlers.UpdateOwnEmployeeProfile)
+		protected.GET("/2fa", dep.UserHandlers.TwoFactorStatus)
+		protected.POST("/2fa/setup", dep.UserHandlers.SetupTwoFactor)
+		protected.POST("/2fa/enable", dep.UserHandlers.EnableTwoFactor)
+		protected.POST("/2fa/disable", dep.UserHandlers.DisableTwoFactor)
+		protected.POST("/2fa/recovery-codes", dep.UserHandlers.RegenerateRecoveryCodes)
+	}
+
+	// User management routes
+	users := engine.Group("/users", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionUserManage))
+	{
+		users.GET("", dep.UserHandlers.List)
+		users.POST("", dep.UserHandlers.Create)
+		users.POST("/import", dep.EmployeeImportHandlers.Import, uploadLimit(constant.EmployeeImportMaxSize))
+		users.GET("/:id", dep.UserHandlers.GetByID)
+		users.PUT("/:id", dep.UserHandlers.Update)
+		users.POST("/:id/deactivate", dep.UserHandlers.Deactivate)
+		users.POST("/:id/activate", dep.UserHandlers.Activate)
+		users.POST("/:id/unlock", dep.UserHandlers.Unlock)
+		users.POST("/:id/revoke-sessions", dep.UserHandlers.RevokeSessions)
+		users.GET("/:id/profile", dep.UserHandlers.GetEmployeeProfile)
+		users.PUT("/:id/profile", dep.UserHandlers.SaveEmployeeProfile)
+	}
+
+	// Credential resets hand out or strip a login, so they need a signed in user and never accept an API key
+	userCredentials := engine.Group("/users", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionUserManage))
+	{
+		userCredentials.POST("/:id/reset-password", dep.UserHandlers.ResetPassword)
+		userCredentials.POST("/:id/reset-2fa", dep.UserHandlers.ResetTwoFactor)
+	}
+
+	// Role and permission management routes
+	engine.GET("/permissions", dep.RoleHandlers.ListPermissions, middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionRoleManage))
+	roles := engine.Group("/roles", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionRoleManage))
+	{
+		roles.GET("", dep.RoleHandlers.List)
+		roles.POST("", dep.RoleHandlers.Create)
+		roles.GET("/:id", dep.RoleHandlers.GetByID)
+		roles.PUT("/:id", dep.RoleHandlers.Update)
+		roles.DELETE("/:id", dep.RoleHandlers.Delete)
+	}
+	userRoles := engine.Group("/users/:id/roles", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionRoleManage))
+	{
+		userRoles.GET("", dep.RoleHandlers.GetUserRoles)
+		userRoles.PUT("", dep.RoleHandlers.SetUserRoles)
+	}
+
+	// API key management routes
+	apiKeys := engine.Group("/api-keys", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionAPIKeyManage))
+	{
+		apiKeys.GET("", dep.APIKeyHandlers.List)
+		apiKeys.POST("", dep.APIKeyHandlers.Create)
+		apiKeys.GET("/:id", dep.APIKeyHandlers.GetByID)
+		apiKeys.DELETE("/:id", dep.APIKeyHandlers.Revoke)
+	}
+
+	// Department routes
+	departments := engine.Group("/departments", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionDepartmentManage))
+	{
+		departments.GET("", dep.DepartmentHandlers.List)
+		departments.POST("", dep.DepartmentHandlers.Create)
+		departments.GET("/:id", dep.DepartmentHandlers.GetByID)
+		departments.PUT("/:id", dep.DepartmentHandlers.Update)
+		departments.DELETE("/:id", dep.DepartmentHandlers.Delete)
+	}
+
+	// Team routes, the review handlers only see the direct and indirect reports of the signed in manager
+	team := engine.Group("/team", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionTeamReview), middleware.TeamScopeMiddleware(dep.DepartmentService))
+	{
+		team.GET("/members", dep.DepartmentHandlers.TeamMembers)
+		team.GET("/summary", dep.DepartmentHandlers.TeamSummary)
+		team.GET("/attendances", dep.AttendanceHandlers.AdminList)
+		team.GET("/attendance-corrections", dep.AttendanceCorrectionHandlers.ListAll)
+		team.POST("/attendance-corrections/:id/approve", dep.AttendanceCorrectionHandlers.Approve)
+		team.POST("/attendance-corrections/:id/reject", dep.AttendanceCorrectionHandlers.Reject)
+		team.GET("/overtimes", dep.OvertimeHandlers.ListAll)
+		team.POST("/overtimes/:id/approve", dep.OvertimeHandlers.Approve)
+		team.POST("/overtimes/:id/reject", dep.OvertimeHandlers.Reject)
+		team.GET("/reimbursements", dep.ReimbursementHandlers.ListAll)
+		team.GET("/reimbursements/:id", dep.ReimbursementHandlers.GetForReview)
+		team.GET("/reimbursements/:id/attachments/:attachment_id", dep.ReimbursementHandlers.DownloadReviewAttachment)
+		team.POST("/reimbursements/:id/approve", dep.ReimbursementHandlers.Approve)
+		team.POST("/reimbursements/:id/reject", dep.ReimbursementHandlers.Reject)
+	}
+
+	// Period routes
+	periods := engine.Group("/periods", middleware.JWTMiddleware(integrationConfig))
+	{
+		periods.POST("", dep.PeriodHandlers.Create, requirePermission(constant.PermissionPeriodManage))
+		periods.GET("", dep.PeriodHandlers.List, requirePermission(constant.PermissionPeriodManage, constant.PermissionPayrollRun, constant.PermissionPayrollReport))
+		periods.GET("/:id", dep.PeriodHandlers.GetByID, requirePermission(constant.PermissionPeriodManage, constant.PermissionPayrollRun, constant.PermissionPayrollReport))
+		periods.PUT("/:id", dep.PeriodHandlers.Update, requirePermission(constant.PermissionPeriodManage))
+		periods.DELETE("/:id", dep.PeriodHandlers.Delete, requirePermission(constant.PermissionPeriodManage))
+
+		// Period detail routes
+		periods.POST("/:id/run-payroll", dep.PeriodDetailHandlers.RunPayroll, requirePermission(constant.PermissionPayrollRun))
+	}
+
+	// Attendance routes
+	attendances := engine.Group("/attendances", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionAttendanceSelf))
+	{
+		attendances.GET("", dep.AttendanceHandlers.GetAttendances)
+		attendances.GET("/:id", dep.AttendanceHandlers.GetAttendanceByID)
+		attendances.POST("/check-in", dep.AttendanceHandlers.CheckIn)
+		attendances.POST("/check-out", dep.AttendanceHandlers.CheckOut)
+		attendances.POST("/check-out/:id", dep.AttendanceHandlers.CheckOutByID)
+	}
+
+	// Attendance management routes
+	attendanceManagement := engine.Group("/attendances/manage", middleware.JWTMiddleware(integrationConfig))
+	{
+		attendanceManagement.GET("", dep.AttendanceHandlers.AdminList, requirePermission(constant.PermissionAttendanceReadAll, constant.PermissionAttendanceManage))
+		attendanceManagement.GET("/:id", dep.AttendanceHandlers.AdminGetByID, requirePermission(constant.PermissionAttendanceReadAll, constant.PermissionAttendanceManage))
+		attendanceManagement.POST("", dep.AttendanceHandlers.AdminCreate, requirePermission(constant.PermissionAttendanceManage))
+		attendanceManagement.PUT("/:id", dep.AttendanceHandlers.AdminUpdate, requirePermission(constant.PermissionAttendanceManage))
+	}
+
+	// Attendance correction routes
+	attendanceCorrections := engine.Group("/attendance-corrections", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionAttendanceSelf))
+	{
+		attendanceCorrections.POST("", dep.AttendanceCorrectionHandlers.Create)
+		attendanceCorrections.GET("", dep.AttendanceCorrectionHandlers.List)
+		attendanceCorrections.GET("/:id", dep.AttendanceCorrectionHandlers.GetByID)
+	}
+
+	// Attendance correction review routes
+	attendanceCorrectionReviews := engine.Group("/attendance-corrections/review", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionAttendanceCorrectionReview))
+	{
+		attendanceCorrectionReviews.GET("", dep.AttendanceCorrectionHandlers.ListAll)
+		attendanceCorrectionReviews.POST("/:id/approve", dep.AttendanceCorrectionHandlers.Approve)
+		attendanceCorrectionReviews.POST("/:id/reject", dep.AttendanceCorrectionHandlers.Reject)
+	}
+
+	// Timesheet routes
+	timesheets := engine.Group("/timesheets", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionAttendanceSelf))
+	{
+		timesheets.GET("", dep.TimesheetHandlers.Get)
+	}
+
+	// Timesheet report routes
+	timesheetReports := engine.Group("/timesheets/report", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionAttendanceReadAll))
+	{
+		timesheetReports.GET("", dep.TimesheetHandlers.GetAll)
+	}
+
+	// Overtime routes
+	overtimes := engine.Group("/overtimes", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionOvertimeSelf))
+	{
+		overtimes.POST("", dep.OvertimeHandlers.Create)
+		overtimes.GET("", dep.OvertimeHandlers.List)
+		overtimes.GET("/proposals", dep.OvertimeHandlers.ListProposals)
+		overtimes.POST("/proposals/confirm", dep.OvertimeHandlers.ConfirmProposal)
+		overtimes.GET("/:id", dep.OvertimeHandlers.GetByID)
+		overtimes.PUT("/:id", dep.OvertimeHandlers.Update)
+		overtimes.DELETE("/:id", dep.OvertimeHandlers.Delete)
+	}
+
+	// Overtime review routes
+	overtimeReviews := engine.Group("/overtimes/review", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionOvertimeReview))
+	{
+		overtimeReviews.GET("", dep.OvertimeHandlers.ListAll)
+		overtimeReviews.POST("/:id/approve", dep.OvertimeHandlers.Approve)
+		overtimeReviews.POST("/:id/reject", dep.OvertimeHandlers.Reject)
+	}
+
+	// Reimbursement routes
+	reimbursements := engine.Group("/reimbursements", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionReimbursementSelf))
+	{
+		reimbursements.POST("", dep.ReimbursementHandlers.Create)
+		reimbursements.GET("", dep.ReimbursementHandlers.List)
+		reimbursements.GET("/balance", dep.ReimbursementHandlers.GetBalance)
+		reimbursements.GET("/:id", dep.ReimbursementHandlers.GetByID)
+		reimbursements.PUT("/:id", dep.ReimbursementHandlers.Update)
+		reimbursements.DELETE("/:id", dep.ReimbursementHandlers.Delete)
+		reimbursements.POST("/:id/attachments", dep.ReimbursementHandlers.UploadAttachment, uploadLimit(constant.ReceiptMaxSize))
+		reimbursements.GET("/:id/attachments", dep.ReimbursementHandlers.ListAttachments)
+		reimbursements.GET("/:id/attachments/:attachment_id", dep.ReimbursementHandlers.DownloadAttachment)
+		reimbursements.DELETE("/:id/attachments/:attachment_id", dep.ReimbursementHandlers.DeleteAttachment)
+	}
+
+	// Reimbursement review routes
+	reimbursementReviews := engine.Group("/reimbursements/review", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionReimbursementReview))
+	{
+		reimbursementReviews.GET("", dep.ReimbursementHandlers.ListAll)
+		reimbursementReviews.GET("/duplicates", dep.ReimbursementHandlers.ListDuplicates)
+		reimbursementReviews.GET("/:id", dep.ReimbursementHandlers.GetForReview)
+		reimbursementReviews.GET("/:id/attachments/:attachment_id", dep.ReimbursementHandlers.DownloadReviewAttachment)
+		reimbursementReviews.POST("/:id/approve", dep.ReimbursementHandlers.Approve)
+		reimbursementReviews.POST("/:id/reject", dep.ReimbursementHandlers.Reject)
+	}
+
+	// Reimbursement category routes
+	reimbursementCategories := engine.Group("/reimbursement-categories", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionReimbursementCategoryManage))
+	{
+		reimbursementCategories.GET("", dep.ReimbursementHandlers.ListCategories)
+		reimbursementCategories.POST("", dep.ReimbursementHandlers.CreateCategory)
+		reimbursementCategories.PUT("/:id", dep.ReimbursementHandlers.UpdateCategory)
+	}
+
+	// Office location routes
+	officeLocations := engine.Group("/office-locations", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionOfficeLocationManage))
+	{
+		officeLocations.POST("", dep.OfficeLocationHandlers.Create)
+		officeLocations.GET("", dep.OfficeLocationHandlers.List)
+		officeLocations.GET("/:id", dep.OfficeLocationHandlers.GetByID)
+		officeLocations.PUT("/:id", dep.OfficeLocationHandlers.Update)
+		officeLocations.DELETE("/:id", dep.OfficeLocationHandlers.Delete)
+	}
+
+	// Geofence override routes
+	geofenceOverrides := engine.Group("/geofence-overrides", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionOfficeLocationManage))
+	{
+		geofenceOverrides.POST("", dep.OfficeLocationHandlers.CreateOverride)
+		geofenceOverrides.GET("", dep.OfficeLocationHandlers.ListOverrides)
+		geofenceOverrides.DELETE("/:id", dep.OfficeLocationHandlers.DeleteOverride)
+	}
+
+	// Attendance import routes
+	attendanceImports := engine.Group("/attendance-imports", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionAttendanceManage))
+	{
+		attendanceImports.POST("", dep.AttendanceImportHandlers.Import, uploadLimit(constant.AttendanceImportMaxSize))
+		attendanceImports.GET("", dep.AttendanceImportHandlers.ListImports)
+	}
+
+	// Device user mapping routes
+	deviceUsers := engine.Group("/device-users", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionAttendanceManage))
+	{
+		deviceUsers.POST("", dep.AttendanceImportHandlers.CreateDeviceUser)
+		deviceUsers.GET("", dep.AttendanceImportHandlers.ListDeviceUsers)
+		deviceUsers.DELETE("/:id", dep.AttendanceImportHandlers.DeleteDeviceUser)
+	}
+
+	// Notification routes (any authenticated user)
+	notifications := engine.Group("/notifications", middleware.JWTMiddleware(jwtConfig))
+	{
+		notifications.GET("", dep.NotificationHandlers.List)
+		notifications.POST("/:id/read", dep.NotificationHandlers.MarkAsRead)
+	}
+
+	// Payslip routes
+	payslips := engine.Group("/payslip", middleware.JWTMiddleware(jwtConfig), requirePermission(constant.PermissionPayslipSelf))
+	{
+		payslips.GET("", dep.PayslipHandlers.List)
+		payslips.GET("/generate/:id", dep.PayslipHandlers.Generate)
+	}
+	engine.GET("/payslip/print", dep.PayslipHandlers.Print)
+
+	// Payslip summary routes
+	payslipSummary := engine.Group("/payslip/summary", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionPayrollReport))
+	{
+		payslipSummary.GET("/generate/:id", dep.PayslipHandlers.GenerateSummary)
+	}
+	engine.GET("/payslip/summary/print", dep.PayslipHandlers.PrintSummary)
+
+	// Termination routes, the final settlement has its own payslip
+	terminations := engine.Group("/terminations", middleware.JWTMiddleware(integrationConfig), requirePermission(constant.PermissionTerminationManage))
+	{
+		terminations.GET("", dep.TerminationHandlers.List)
+		terminations.POST("", dep.TerminationHandlers.Create)
+		terminations.GET("/:id", dep.TerminationHandlers.GetByID)
+		terminations.PUT("/:id", dep.TerminationHandlers.Update)
+		terminations.DELETE("/:id", dep.TerminationHandlers.Cancel)
+		terminations.POST("/:id/settle", dep.TerminationHandlers.Settle)
+		terminations.GET("/:id/payslip", dep.PayslipHandlers.GenerateSettlement)
+	}
+	engine.GET("/payslip/settlement/print", dep.PayslipHandlers.PrintSettlement)
+
+	return engine
+}
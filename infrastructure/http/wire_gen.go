@@ -9,44 +9,80 @@ package http
 import (
 	"github.com/google/wire"
 	"github.com/riskykurniawan15/payrolls/config"
+	api_key3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/api_key"
 	attendance3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/attendance"
+	attendance_correction3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/attendance_correction"
+	attendance_import3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/attendance_import"
+	department3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/department"
+	employee_import3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/employee_import"
 	health3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/health"
+	notification3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/notification"
+	office_location3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/office_location"
 	overtime3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/overtime"
 	payslip2 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/payslip"
 	period3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/period"
 	period_detail3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/period_detail"
 	reimbursement3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/reimbursement"
+	role3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/role"
+	termination3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/termination"
+	timesheet2 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/timesheet"
 	user3 "github.com/riskykurniawan15/payrolls/infrastructure/http/handler/user"
+	"github.com/riskykurniawan15/payrolls/repositories/api_key"
 	"github.com/riskykurniawan15/payrolls/repositories/attendance"
+	"github.com/riskykurniawan15/payrolls/repositories/attendance_correction"
+	"github.com/riskykurniawan15/payrolls/repositories/attendance_import"
 	"github.com/riskykurniawan15/payrolls/repositories/audit_trail"
+	"github.com/riskykurniawan15/payrolls/repositories/auth_session"
+	"github.com/riskykurniawan15/payrolls/repositories/department"
 	"github.com/riskykurniawan15/payrolls/repositories/health"
 	"github.com/riskykurniawan15/payrolls/repositories/instance"
+	"github.com/riskykurniawan15/payrolls/repositories/login_attempt"
+	"github.com/riskykurniawan15/payrolls/repositories/notification"
+	"github.com/riskykurniawan15/payrolls/repositories/office_location"
 	"github.com/riskykurniawan15/payrolls/repositories/overtime"
 	"github.com/riskykurniawan15/payrolls/repositories/period"
 	"github.com/riskykurniawan15/payrolls/repositories/period_detail"
 	"github.com/riskykurniawan15/payrolls/repositories/reimbursement"
+	"github.com/riskykurniawan15/payrolls/repositories/role"
+	"github.com/riskykurniawan15/payrolls/repositories/termination"
 	"github.com/riskykurniawan15/payrolls/repositories/user"
+	api_key2 "github.com/riskykurniawan15/payrolls/services/api_key"
 	attendance2 "github.com/riskykurniawan15/payrolls/services/attendance"
+	attendance_correction2 "github.com/riskykurniawan15/payrolls/services/attendance_correction"
+	attendance_import2 "github.com/riskykurniawan15/payrolls/services/attendance_import"
 	audit_trail2 "github.com/riskykurniawan15/payrolls/services/audit_trail"
+	department2 "github.com/riskykurniawan15/payrolls/services/department"
+	employee_import2 "github.com/riskykurniawan15/payrolls/services/employee_import"
 	health2 "github.com/riskykurniawan15/payrolls/services/health"
+	notification2 "github.com/riskykurniawan15/payrolls/services/notification"
+	office_location2 "github.com/riskykurniawan15/payrolls/services/office_location"
 	overtime2 "github.com/riskykurniawan15/payrolls/services/overtime"
 	"github.com/riskykurniawan15/payrolls/services/payslip"
 	period2 "github.com/riskykurniawan15/payrolls/services/period"
 	period_detail2 "github.com/riskykurniawan15/payrolls/services/period_detail"
 	reimbursement2 "github.com/riskykurniawan15/payrolls/services/reimbursement"
+	role2 "github.com/riskykurniawan15/payrolls/services/role"
+	termination2 "github.com/riskykurniawan15/payrolls/services/termination"
+	"github.com/riskykurniawan15/payrolls/services/timesheet"
 	user2 "github.com/riskykurniawan15/payrolls/services/user"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/storage"
 	"gorm.io/gorm"
 )
 
 // Injectors from dep_manager.go:
 
-func InitializeHandler(db *gorm.DB, cfg config.Config, logger2 logger.Logger) *Dependencies {
+func InitializeHandler(db *gorm.DB, cfg config.Config, logger2 logger.Logger, store storage.Storage) *Dependencies {
 	iHealthRepositories := health.NewHealthRepositories(db)
 	iHealthServices := health2.NewHealthService(iHealthRepositories)
 	iHealthHandler := health3.NewHealthHandlers(iHealthServices)
 	iUserRepository := user.NewUserRepository(db)
-	iUserService := user2.NewUserService(cfg, iUserRepository, logger2)
+	iAuthSessionRepository := auth_session.NewAuthSessionRepository(db)
+	iLoginAttemptRepository := login_attempt.NewLoginAttemptRepository(db)
+	iRoleRepository := role.NewRoleRepository(db)
+	iDepartmentRepository := department.NewDepartmentRepository(db)
+	iInstanceRepository := instance.NewInstanceRepository(db)
+	iUserService := user2.NewUserService(cfg, iUserRepository, iAuthSessionRepository, iLoginAttemptRepository, iRoleRepository, iDepartmentRepository, iInstanceRepository, logger2)
 	iUserHandler := user3.NewUserHandlers(logger2, iUserService)
 	iPeriodRepository := period.NewPeriodRepository(db)
 	iPeriodService := period2.NewPeriodService(logger2, iPeriodRepository)
@@ -55,29 +91,70 @@ func InitializeHandler(db *gorm.DB, cfg config.Config, logger2 logger.Logger) *D
 	iAttendanceRepository := attendance.NewAttendanceRepository(db)
 	iOvertimeRepository := overtime.NewOvertimeRepository(db)
 	iReimbursementRepository := reimbursement.NewReimbursementRepository(db)
-	iInstanceRepository := instance.NewInstanceRepository(db)
 	iPeriodDetailService := period_detail2.NewPeriodDetailService(logger2, iPeriodDetailRepository, iPeriodRepository, iUserRepository, iAttendanceRepository, iOvertimeRepository, iReimbursementRepository, iInstanceRepository)
 	iPeriodDetailHandler := period_detail3.NewPeriodDetailHandlers(iPeriodDetailService, logger2)
-	iAttendanceService := attendance2.NewAttendanceService(logger2, iAttendanceRepository)
+	iOfficeLocationRepository := office_location.NewOfficeLocationRepository(db)
+	iNotificationRepository := notification.NewNotificationRepository(db)
+	iNotificationService := notification2.NewNotificationService(logger2, iNotificationRepository)
+	iAttendanceService := attendance2.NewAttendanceService(logger2, cfg, iAttendanceRepository, iOfficeLocationRepository, iUserRepository, iNotificationService)
 	iAttendanceHandler := attendance3.NewAttendanceHandlers(logger2, iAttendanceService)
-	iOvertimeService := overtime2.NewOvertimeService(logger2, iOvertimeRepository, iAttendanceRepository)
+	iOvertimeService := overtime2.NewOvertimeService(logger2, cfg, iOvertimeRepository, iAttendanceRepository, iUserRepository, iNotificationService)
 	iOvertimeHandler := overtime3.NewOvertimeHandlers(logger2, iOvertimeService)
-	iReimbursementService := reimbursement2.NewReimbursementService(logger2, iReimbursementRepository)
+	iReimbursementService := reimbursement2.NewReimbursementService(logger2, cfg, iReimbursementRepository, iUserRepository, store, iNotificationService)
 	iReimbursementHandler := reimbursement3.NewReimbursementHandlers(logger2, iReimbursementService)
-	iPayslipService := payslip.NewPayslipService(logger2, iPeriodDetailRepository, cfg)
+	iTerminationRepository := termination.NewTerminationRepository(db)
+	iPayslipService := payslip.NewPayslipService(logger2, iPeriodDetailRepository, iTerminationRepository, cfg)
 	iPayslipHandler := payslip2.NewPayslipHandlers(logger2, iPayslipService)
+	iOfficeLocationService := office_location2.NewOfficeLocationService(logger2, iOfficeLocationRepository, iUserRepository)
+	iOfficeLocationHandler := office_location3.NewOfficeLocationHandlers(logger2, iOfficeLocationService)
+	iAttendanceImportRepository := attendance_import.NewAttendanceImportRepository(db)
+	iAttendanceImportService := attendance_import2.NewAttendanceImportService(logger2, cfg, iAttendanceImportRepository, iAttendanceRepository, iUserRepository, iInstanceRepository)
+	iAttendanceImportHandler := attendance_import3.NewAttendanceImportHandlers(logger2, iAttendanceImportService)
+	iAttendanceCorrectionRepository := attendance_correction.NewAttendanceCorrectionRepository(db)
+	iAttendanceCorrectionService := attendance_correction2.NewAttendanceCorrectionService(logger2, iAttendanceCorrectionRepository, iAttendanceRepository, iInstanceRepository)
+	iAttendanceCorrectionHandler := attendance_correction3.NewAttendanceCorrectionHandlers(logger2, iAttendanceCorrectionService)
+	iNotificationHandler := notification3.NewNotificationHandlers(logger2, iNotificationService)
+	iTimesheetService := timesheet.NewTimesheetService(logger2, cfg, iAttendanceRepository, iOvertimeRepository, iUserRepository)
+	iTimesheetHandler := timesheet2.NewTimesheetHandlers(logger2, iTimesheetService)
+	iRoleService := role2.NewRoleService(logger2, iInstanceRepository, iRoleRepository, iUserRepository)
+	iRoleHandler := role3.NewRoleHandlers(logger2, iRoleService)
+	iAPIKeyRepository := api_key.NewAPIKeyRepository(db)
+	iAPIKeyService := api_key2.NewAPIKeyService(logger2, iInstanceRepository, iAPIKeyRepository, iRoleRepository, iUserRepository)
+	iAPIKeyHandler := api_key3.NewAPIKeyHandlers(logger2, iAPIKeyService)
+	iDepartmentService := department2.NewDepartmentService(logger2, cfg, iDepartmentRepository)
+	iDepartmentHandler := department3.NewDepartmentHandlers(logger2, iDepartmentService)
+	iEmployeeImportService := employee_import2.NewEmployeeImportService(logger2, cfg, iInstanceRepository, iUserRepository, iRoleRepository, iDepartmentRepository)
+	iEmployeeImportHandler := employee_import3.NewEmployeeImportHandlers(logger2, iEmployeeImportService)
+	iTerminationService := termination2.NewTerminationService(logger2, cfg, iInstanceRepository, iTerminationRepository, iUserRepository, iAuthSessionRepository, iAttendanceRepository, iOvertimeRepository, iReimbursementRepository)
+	iTerminationHandler := termination3.NewTerminationHandlers(logger2, iTerminationService)
 	iAuditTrailRepository := audit_trail.NewAuditTrailRepository(db)
 	iAuditTrailService := audit_trail2.NewAuditTrailService(iAuditTrailRepository)
 	dependencies := &Dependencies{
-		HealthHandlers:        iHealthHandler,
-		UserHandlers:          iUserHandler,
-		PeriodHandlers:        iPeriodHandler,
-		PeriodDetailHandlers:  iPeriodDetailHandler,
-		AttendanceHandlers:    iAttendanceHandler,
-		OvertimeHandlers:      iOvertimeHandler,
-		ReimbursementHandlers: iReimbursementHandler,
-		PayslipHandlers:       iPayslipHandler,
-		AuditTrailService:     iAuditTrailService,
+		HealthHandlers:               iHealthHandler,
+		UserHandlers:                 iUserHandler,
+		PeriodHandlers:               iPeriodHandler,
+		PeriodDetailHandlers:         iPeriodDetailHandler,
+		AttendanceHandlers:           iAttendanceHandler,
+		OvertimeHandlers:             iOvertimeHandler,
+		ReimbursementHandlers:        iReimbursementHandler,
+		PayslipHandlers:              iPayslipHandler,
+		OfficeLocationHandlers:       iOfficeLocationHandler,
+		AttendanceImportHandlers:     iAttendanceImportHandler,
+		AttendanceCorrectionHandlers: iAttendanceCorrectionHandler,
+		NotificationHandlers:         iNotificationHandler,
+		AttendanceService:            iAttendanceService,
+		TimesheetHandlers:            iTimesheetHandler,
+		RoleHandlers:                 iRoleHandler,
+		APIKeyHandlers:               iAPIKeyHandler,
+		DepartmentHandlers:           iDepartmentHandler,
+		EmployeeImportHandlers:       iEmployeeImportHandler,
+		TerminationHandlers:          iTerminationHandler,
+		AuditTrailService:            iAuditTrailService,
+		UserService:                  iUserService,
+		RoleService:                  iRoleService,
+		APIKeyService:                iAPIKeyService,
+		DepartmentService:            iDepartmentService,
+		TerminationService:           iTerminationService,
 	}
 	return dependencies
 }
@@ -85,19 +162,35 @@ func InitializeHandler(db *gorm.DB, cfg config.Config, logger2 logger.Logger) *D
 // dep_manager.go:
 
 type Dependencies struct {
-	HealthHandlers        health3.IHealthHandler
-	UserHandlers          user3.IUserHandler
-	PeriodHandlers        period3.IPeriodHandler
-	PeriodDetailHandlers  period_detail3.IPeriodDetailHandler
-	AttendanceHandlers    attendance3.IAttendanceHandler
-	OvertimeHandlers      overtime3.IOvertimeHandler
-	ReimbursementHandlers reimbursement3.IReimbursementHandler
-	PayslipHandlers       payslip2.IPayslipHandler
-	AuditTrailService     audit_trail2.IAuditTrailService
+	HealthHandlers               health3.IHealthHandler
+	UserHandlers                 user3.IUserHandler
+	PeriodHandlers               period3.IPeriodHandler
+	PeriodDetailHandlers         period_detail3.IPeriodDetailHandler
+	AttendanceHandlers           attendance3.IAttendanceHandler
+	OvertimeHandlers             overtime3.IOvertimeHandler
+	ReimbursementHandlers        reimbursement3.IReimbursementHandler
+	PayslipHandlers              payslip2.IPayslipHandler
+	OfficeLocationHandlers       office_location3.IOfficeLocationHandler
+	AttendanceImportHandlers     attendance_import3.IAttendanceImportHandler
+	AttendanceCorrectionHandlers attendance_correction3.IAttendanceCorrectionHandler
+	NotificationHandlers         notification3.INotificationHandler
+	TimesheetHandlers            timesheet2.ITimesheetHandler
+	RoleHandlers                 role3.IRoleHandler
+	APIKeyHandlers               api_key3.IAPIKeyHandler
+	DepartmentHandlers           department3.IDepartmentHandler
+	EmployeeImportHandlers       employee_import3.IEmployeeImportHandler
+	TerminationHandlers          termination3.ITerminationHandler
+	AuditTrailService            audit_trail2.IAuditTrailService
+	AttendanceService            attendance2.IAttendanceService
+	UserService                  user2.IUserService
+	RoleService                  role2.IRoleService
+	APIKeyService                api_key2.IAPIKeyService
+	DepartmentService            department2.IDepartmentService
+	TerminationService           termination2.ITerminationService
 }
 
-var RepositorySet = wire.NewSet(health.NewHealthRepositories, user.NewUserRepository, period.NewPeriodRepository, period_detail.NewPeriodDetailRepository, attendance.NewAttendanceRepository, audit_trail.NewAuditTrailRepository, overtime.NewOvertimeRepository, reimbursement.NewReimbursementRepository, instance.NewInstanceRepository)
+var RepositorySet = wire.NewSet(health.NewHealthRepositories, user.NewUserRepository, period.NewPeriodRepository, period_detail.NewPeriodDetailRepository, attendance.NewAttendanceRepository, audit_trail.NewAuditTrailRepository, overtime.NewOvertimeRepository, reimbursement.NewReimbursementRepository, instance.NewInstanceRepository, office_location.NewOfficeLocationRepository, attendance_import.NewAttendanceImportRepository, attendance_correction.NewAttendanceCorrectionRepository, notification.NewNotificationRepository, auth_session.NewAuthSessionRepository, login_attempt.NewLoginAttemptRepository, role.NewRoleRepository, api_key.NewAPIKeyRepository, department.NewDepartmentRepository, termination.NewTerminationRepository)
 
-var ServicesSet = wire.NewSet(health2.NewHealthService, user2.NewUserService, period2.NewPeriodService, period_detail2.NewPeriodDetailService, attendance2.NewAttendanceService, audit_trail2.NewAuditTrailService, overtime2.NewOvertimeService, reimbursement2.NewReimbursementService, payslip.NewPayslipService)
+var ServicesSet = wire.NewSet(health2.NewHealthService, user2.NewUserService, period2.NewPeriodService, period_detail2.NewPeriodDetailService, attendance2.NewAttendanceService, audit_trail2.NewAuditTrailService, overtime2.NewOvertimeService, reimbursement2.NewReimbursementService, payslip.NewPayslipService, office_location2.NewOfficeLocationService, attendance_import2.NewAttendanceImportService, attendance_correction2.NewAttendanceCorrectionService, notification2.NewNotificationService, timesheet.NewTimesheetService, role2.NewRoleService, api_key2.NewAPIKeyService, department2.NewDepartmentService, employee_import2.NewEmployeeImportService, termination2.NewTerminationService)
 
-var HandlerSet = wire.NewSet(health3.NewHealthHandlers, user3.NewUserHandlers, period3.NewPeriodHandlers, period_detail3.NewPeriodDetailHandlers, attendance3.NewAttendanceHandlers, overtime3.NewOvertimeHandlers, reimbursement3.NewReimbursementHandlers, payslip2.NewPayslipHandlers)
+var HandlerSet = wire.NewSet(health3.NewHealthHandlers, user3.NewUserHandlers, period3.NewPeriodHandlers, period_detail3.NewPeriodDetailHandlers, attendance3.NewAttendanceHandlers, overtime3.NewOvertimeHandlers, reimbursement3.NewReimbursementHandlers, payslip2.NewPayslipHandlers, office_location3.NewOfficeLocationHandlers, attendance_import3.NewAttendanceImportHandlers, attendance_correction3.NewAttendanceCorrectionHandlers, notification3.NewNotificationHandlers, timesheet2.NewTimesheetHandlers, role3.NewRoleHandlers, api_key3.NewAPIKeyHandlers, department3.NewDepartmentHandlers, employee_import3.NewEmployeeImportHandlers, termination3.NewTerminationHandlers)
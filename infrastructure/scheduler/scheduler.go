@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+type (
+	// Job is a task executed periodically in the background
+	Job struct {
+		Name     string
+		Interval time.Duration
+		Run      func(ctx context.Context) error
+	}
+
+	Scheduler struct {
+		logger logger.Logger
+		jobs   []Job
+		cancel context.CancelFunc
+		wg     sync.WaitGroup
+	}
+)
+
+func NewScheduler(logger logger.Logger) *Scheduler {
+	return &Scheduler{logger: logger}
+}
+
+// Register adds a job, it must be called before Start
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every registered job on its own ticker until Stop is called
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			s.logger.Error(fmt.Sprintf("Scheduler job %s skipped, interval must be positive", job.Name))
+			continue
+		}
+
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+
+			ticker := time.NewTicker(job.Interval)
+			defer ticker.Stop()
+
+			s.logger.Info(fmt.Sprintf("Scheduler job %s started, interval %s", job.Name, job.Interval))
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					s.run(ctx, job)
+				}
+			}
+		}(job)
+	}
+}
+
+// Stop cancels running jobs and waits for them to return
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	requestID := uuid.New().String()
+	jobCtx := middleware.AddRequestIDToContext(ctx, requestID)
+
+	start := time.Now()
+	if err := job.Run(jobCtx); err != nil {
+		s.logger.ErrorT("scheduler job failed", requestID, map[string]interface{}{
+			"job":   job.Name,
+			"error": err.Error(),
+		})
+		return
+	}
+
+	s.logger.InfoT("scheduler job finished", requestID, map[string]interface{}{
+		"job":         job.Name,
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+}
@@ -18,6 +18,9 @@ func main() {
 	// Connect to database using existing driver
 	db := driver.ConnectDB(cfg.PostgressDB)
 
+	// Prepare file storage for uploads
+	store := driver.ConnectStorage(cfg.Storage)
+
 	// Initialize logger with config
 	log := logger.NewLoggerWithConfig(logger.LoggerConfig{
 		OutputMode: cfg.Logger.OutputMode,
@@ -50,5 +53,6 @@ func main() {
 		Config:      cfg,
 		Logger:      log,
 		PostgressDB: db,
+		Storage:     store,
 	})
 }
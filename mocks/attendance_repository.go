@@ -25,6 +25,65 @@ func (_m *MockIAttendanceRepository) EXPECT() *MockIAttendanceRepository_Expecte
 	return &MockIAttendanceRepository_Expecter{mock: &_m.Mock}
 }
 
+// AutoClose provides a mock function with given fields: ctx, id, checkOut, closedAt
+func (_m *MockIAttendanceRepository) AutoClose(ctx context.Context, id uint, checkOut time.Time, closedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, id, checkOut, closedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoClose")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, id, checkOut, closedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, id, checkOut, closedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, id, checkOut, closedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAttendanceRepository_AutoClose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoClose'
+type MockIAttendanceRepository_AutoClose_Call struct {
+	*mock.Call
+}
+
+// AutoClose is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - checkOut time.Time
+//   - closedAt time.Time
+func (_e *MockIAttendanceRepository_Expecter) AutoClose(ctx interface{}, id interface{}, checkOut interface{}, closedAt interface{}) *MockIAttendanceRepository_AutoClose_Call {
+	return &MockIAttendanceRepository_AutoClose_Call{Call: _e.mock.On("AutoClose", ctx, id, checkOut, closedAt)}
+}
+
+func (_c *MockIAttendanceRepository_AutoClose_Call) Run(run func(ctx context.Context, id uint, checkOut time.Time, closedAt time.Time)) *MockIAttendanceRepository_AutoClose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_AutoClose_Call) Return(_a0 bool, _a1 error) *MockIAttendanceRepository_AutoClose_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_AutoClose_Call) RunAndReturn(run func(context.Context, uint, time.Time, time.Time) (bool, error)) *MockIAttendanceRepository_AutoClose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAttendance provides a mock function with given fields: ctx, _a1
 func (_m *MockIAttendanceRepository) CreateAttendance(ctx context.Context, _a1 attendance.Attendance) (attendance.Attendance, error) {
 	ret := _m.Called(ctx, _a1)
@@ -82,6 +141,72 @@ func (_c *MockIAttendanceRepository_CreateAttendance_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetAllAttendances provides a mock function with given fields: ctx, req
+func (_m *MockIAttendanceRepository) GetAllAttendances(ctx context.Context, req attendance.AdminAttendanceListRequest) ([]attendance.AttendanceWithUser, int64, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllAttendances")
+	}
+
+	var r0 []attendance.AttendanceWithUser
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, attendance.AdminAttendanceListRequest) ([]attendance.AttendanceWithUser, int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, attendance.AdminAttendanceListRequest) []attendance.AttendanceWithUser); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]attendance.AttendanceWithUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, attendance.AdminAttendanceListRequest) int64); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, attendance.AdminAttendanceListRequest) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIAttendanceRepository_GetAllAttendances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllAttendances'
+type MockIAttendanceRepository_GetAllAttendances_Call struct {
+	*mock.Call
+}
+
+// GetAllAttendances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req attendance.AdminAttendanceListRequest
+func (_e *MockIAttendanceRepository_Expecter) GetAllAttendances(ctx interface{}, req interface{}) *MockIAttendanceRepository_GetAllAttendances_Call {
+	return &MockIAttendanceRepository_GetAllAttendances_Call{Call: _e.mock.On("GetAllAttendances", ctx, req)}
+}
+
+func (_c *MockIAttendanceRepository_GetAllAttendances_Call) Run(run func(ctx context.Context, req attendance.AdminAttendanceListRequest)) *MockIAttendanceRepository_GetAllAttendances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(attendance.AdminAttendanceListRequest))
+	})
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_GetAllAttendances_Call) Return(_a0 []attendance.AttendanceWithUser, _a1 int64, _a2 error) *MockIAttendanceRepository_GetAllAttendances_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_GetAllAttendances_Call) RunAndReturn(run func(context.Context, attendance.AdminAttendanceListRequest) ([]attendance.AttendanceWithUser, int64, error)) *MockIAttendanceRepository_GetAllAttendances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAttendanceByID provides a mock function with given fields: ctx, id, userID
 func (_m *MockIAttendanceRepository) GetAttendanceByID(ctx context.Context, id uint, userID uint) (attendance.Attendance, error) {
 	ret := _m.Called(ctx, id, userID)
@@ -198,6 +323,64 @@ func (_c *MockIAttendanceRepository_GetAttendanceByIDForUpdate_Call) RunAndRetur
 	return _c
 }
 
+// GetAttendanceWithUserByID provides a mock function with given fields: ctx, id, workStartTime
+func (_m *MockIAttendanceRepository) GetAttendanceWithUserByID(ctx context.Context, id uint, workStartTime string) (attendance.AttendanceWithUser, error) {
+	ret := _m.Called(ctx, id, workStartTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttendanceWithUserByID")
+	}
+
+	var r0 attendance.AttendanceWithUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) (attendance.AttendanceWithUser, error)); ok {
+		return rf(ctx, id, workStartTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) attendance.AttendanceWithUser); ok {
+		r0 = rf(ctx, id, workStartTime)
+	} else {
+		r0 = ret.Get(0).(attendance.AttendanceWithUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, id, workStartTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAttendanceRepository_GetAttendanceWithUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttendanceWithUserByID'
+type MockIAttendanceRepository_GetAttendanceWithUserByID_Call struct {
+	*mock.Call
+}
+
+// GetAttendanceWithUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - workStartTime string
+func (_e *MockIAttendanceRepository_Expecter) GetAttendanceWithUserByID(ctx interface{}, id interface{}, workStartTime interface{}) *MockIAttendanceRepository_GetAttendanceWithUserByID_Call {
+	return &MockIAttendanceRepository_GetAttendanceWithUserByID_Call{Call: _e.mock.On("GetAttendanceWithUserByID", ctx, id, workStartTime)}
+}
+
+func (_c *MockIAttendanceRepository_GetAttendanceWithUserByID_Call) Run(run func(ctx context.Context, id uint, workStartTime string)) *MockIAttendanceRepository_GetAttendanceWithUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_GetAttendanceWithUserByID_Call) Return(_a0 attendance.AttendanceWithUser, _a1 error) *MockIAttendanceRepository_GetAttendanceWithUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_GetAttendanceWithUserByID_Call) RunAndReturn(run func(context.Context, uint, string) (attendance.AttendanceWithUser, error)) *MockIAttendanceRepository_GetAttendanceWithUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAttendances provides a mock function with given fields: ctx, userID, page, limit, startDate, endDate
 func (_m *MockIAttendanceRepository) GetAttendances(ctx context.Context, userID uint, page int, limit int, startDate *time.Time, endDate *time.Time) ([]attendance.Attendance, int64, error) {
 	ret := _m.Called(ctx, userID, page, limit, startDate, endDate)
@@ -328,6 +511,67 @@ func (_c *MockIAttendanceRepository_GetByUserAndDate_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetByUserAndDateRange provides a mock function with given fields: ctx, userID, startDate, endDate
+func (_m *MockIAttendanceRepository) GetByUserAndDateRange(ctx context.Context, userID uint, startDate time.Time, endDate time.Time) ([]attendance.Attendance, error) {
+	ret := _m.Called(ctx, userID, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserAndDateRange")
+	}
+
+	var r0 []attendance.Attendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) ([]attendance.Attendance, error)); ok {
+		return rf(ctx, userID, startDate, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) []attendance.Attendance); ok {
+		r0 = rf(ctx, userID, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]attendance.Attendance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAttendanceRepository_GetByUserAndDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserAndDateRange'
+type MockIAttendanceRepository_GetByUserAndDateRange_Call struct {
+	*mock.Call
+}
+
+// GetByUserAndDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockIAttendanceRepository_Expecter) GetByUserAndDateRange(ctx interface{}, userID interface{}, startDate interface{}, endDate interface{}) *MockIAttendanceRepository_GetByUserAndDateRange_Call {
+	return &MockIAttendanceRepository_GetByUserAndDateRange_Call{Call: _e.mock.On("GetByUserAndDateRange", ctx, userID, startDate, endDate)}
+}
+
+func (_c *MockIAttendanceRepository_GetByUserAndDateRange_Call) Run(run func(ctx context.Context, userID uint, startDate time.Time, endDate time.Time)) *MockIAttendanceRepository_GetByUserAndDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_GetByUserAndDateRange_Call) Return(_a0 []attendance.Attendance, _a1 error) *MockIAttendanceRepository_GetByUserAndDateRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_GetByUserAndDateRange_Call) RunAndReturn(run func(context.Context, uint, time.Time, time.Time) ([]attendance.Attendance, error)) *MockIAttendanceRepository_GetByUserAndDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestCheckInByUserID provides a mock function with given fields: ctx, userID, date
 func (_m *MockIAttendanceRepository) GetLatestCheckInByUserID(ctx context.Context, userID uint, date *time.Time) (attendance.Attendance, error) {
 	ret := _m.Called(ctx, userID, date)
@@ -386,6 +630,66 @@ func (_c *MockIAttendanceRepository_GetLatestCheckInByUserID_Call) RunAndReturn(
 	return _c
 }
 
+// GetOpenAttendancesBefore provides a mock function with given fields: ctx, before, limit
+func (_m *MockIAttendanceRepository) GetOpenAttendancesBefore(ctx context.Context, before time.Time, limit int) ([]attendance.Attendance, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenAttendancesBefore")
+	}
+
+	var r0 []attendance.Attendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]attendance.Attendance, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []attendance.Attendance); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]attendance.Attendance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIAttendanceRepository_GetOpenAttendancesBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenAttendancesBefore'
+type MockIAttendanceRepository_GetOpenAttendancesBefore_Call struct {
+	*mock.Call
+}
+
+// GetOpenAttendancesBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockIAttendanceRepository_Expecter) GetOpenAttendancesBefore(ctx interface{}, before interface{}, limit interface{}) *MockIAttendanceRepository_GetOpenAttendancesBefore_Call {
+	return &MockIAttendanceRepository_GetOpenAttendancesBefore_Call{Call: _e.mock.On("GetOpenAttendancesBefore", ctx, before, limit)}
+}
+
+func (_c *MockIAttendanceRepository_GetOpenAttendancesBefore_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockIAttendanceRepository_GetOpenAttendancesBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_GetOpenAttendancesBefore_Call) Return(_a0 []attendance.Attendance, _a1 error) *MockIAttendanceRepository_GetOpenAttendancesBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAttendanceRepository_GetOpenAttendancesBefore_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]attendance.Attendance, error)) *MockIAttendanceRepository_GetOpenAttendancesBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAttendance provides a mock function with given fields: ctx, _a1
 func (_m *MockIAttendanceRepository) UpdateAttendance(ctx context.Context, _a1 attendance.Attendance) (attendance.Attendance, error) {
 	ret := _m.Called(ctx, _a1)
@@ -238,6 +238,66 @@ func (_c *MockIOvertimeRepository_GetByUserAndDateRange_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetPayableByUser provides a mock function with given fields: ctx, userID, endDate
+func (_m *MockIOvertimeRepository) GetPayableByUser(ctx context.Context, userID uint, endDate time.Time) ([]overtime.Overtime, error) {
+	ret := _m.Called(ctx, userID, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayableByUser")
+	}
+
+	var r0 []overtime.Overtime
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) ([]overtime.Overtime, error)); ok {
+		return rf(ctx, userID, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) []overtime.Overtime); ok {
+		r0 = rf(ctx, userID, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]overtime.Overtime)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time) error); ok {
+		r1 = rf(ctx, userID, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIOvertimeRepository_GetPayableByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayableByUser'
+type MockIOvertimeRepository_GetPayableByUser_Call struct {
+	*mock.Call
+}
+
+// GetPayableByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - endDate time.Time
+func (_e *MockIOvertimeRepository_Expecter) GetPayableByUser(ctx interface{}, userID interface{}, endDate interface{}) *MockIOvertimeRepository_GetPayableByUser_Call {
+	return &MockIOvertimeRepository_GetPayableByUser_Call{Call: _e.mock.On("GetPayableByUser", ctx, userID, endDate)}
+}
+
+func (_c *MockIOvertimeRepository_GetPayableByUser_Call) Run(run func(ctx context.Context, userID uint, endDate time.Time)) *MockIOvertimeRepository_GetPayableByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_GetPayableByUser_Call) Return(_a0 []overtime.Overtime, _a1 error) *MockIOvertimeRepository_GetPayableByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_GetPayableByUser_Call) RunAndReturn(run func(context.Context, uint, time.Time) ([]overtime.Overtime, error)) *MockIOvertimeRepository_GetPayableByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotalHoursByUserAndDate provides a mock function with given fields: ctx, userID, date
 func (_m *MockIOvertimeRepository) GetTotalHoursByUserAndDate(ctx context.Context, userID uint, date time.Time) (float64, error) {
 	ret := _m.Called(ctx, userID, date)
@@ -356,6 +416,209 @@ func (_c *MockIOvertimeRepository_List_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListAll provides a mock function with given fields: ctx, req
+func (_m *MockIOvertimeRepository) ListAll(ctx context.Context, req overtime.ListAllOvertimesRequest) (*overtime.ListOvertimesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAll")
+	}
+
+	var r0 *overtime.ListOvertimesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, overtime.ListAllOvertimesRequest) (*overtime.ListOvertimesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, overtime.ListAllOvertimesRequest) *overtime.ListOvertimesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*overtime.ListOvertimesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, overtime.ListAllOvertimesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIOvertimeRepository_ListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAll'
+type MockIOvertimeRepository_ListAll_Call struct {
+	*mock.Call
+}
+
+// ListAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req overtime.ListAllOvertimesRequest
+func (_e *MockIOvertimeRepository_Expecter) ListAll(ctx interface{}, req interface{}) *MockIOvertimeRepository_ListAll_Call {
+	return &MockIOvertimeRepository_ListAll_Call{Call: _e.mock.On("ListAll", ctx, req)}
+}
+
+func (_c *MockIOvertimeRepository_ListAll_Call) Run(run func(ctx context.Context, req overtime.ListAllOvertimesRequest)) *MockIOvertimeRepository_ListAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(overtime.ListAllOvertimesRequest))
+	})
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_ListAll_Call) Return(_a0 *overtime.ListOvertimesResponse, _a1 error) *MockIOvertimeRepository_ListAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_ListAll_Call) RunAndReturn(run func(context.Context, overtime.ListAllOvertimesRequest) (*overtime.ListOvertimesResponse, error)) *MockIOvertimeRepository_ListAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPaid provides a mock function with given fields: ctx, ids, periodDetailID, paidAt
+func (_m *MockIOvertimeRepository) MarkPaid(ctx context.Context, ids []uint, periodDetailID uint, paidAt time.Time) error {
+	ret := _m.Called(ctx, ids, periodDetailID, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPaid")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint, uint, time.Time) error); ok {
+		r0 = rf(ctx, ids, periodDetailID, paidAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIOvertimeRepository_MarkPaid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPaid'
+type MockIOvertimeRepository_MarkPaid_Call struct {
+	*mock.Call
+}
+
+// MarkPaid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint
+//   - periodDetailID uint
+//   - paidAt time.Time
+func (_e *MockIOvertimeRepository_Expecter) MarkPaid(ctx interface{}, ids interface{}, periodDetailID interface{}, paidAt interface{}) *MockIOvertimeRepository_MarkPaid_Call {
+	return &MockIOvertimeRepository_MarkPaid_Call{Call: _e.mock.On("MarkPaid", ctx, ids, periodDetailID, paidAt)}
+}
+
+func (_c *MockIOvertimeRepository_MarkPaid_Call) Run(run func(ctx context.Context, ids []uint, periodDetailID uint, paidAt time.Time)) *MockIOvertimeRepository_MarkPaid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint), args[2].(uint), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_MarkPaid_Call) Return(_a0 error) *MockIOvertimeRepository_MarkPaid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_MarkPaid_Call) RunAndReturn(run func(context.Context, []uint, uint, time.Time) error) *MockIOvertimeRepository_MarkPaid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPaidByPeriodID provides a mock function with given fields: ctx, periodID
+func (_m *MockIOvertimeRepository) ResetPaidByPeriodID(ctx context.Context, periodID uint) error {
+	ret := _m.Called(ctx, periodID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPaidByPeriodID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, periodID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIOvertimeRepository_ResetPaidByPeriodID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPaidByPeriodID'
+type MockIOvertimeRepository_ResetPaidByPeriodID_Call struct {
+	*mock.Call
+}
+
+// ResetPaidByPeriodID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - periodID uint
+func (_e *MockIOvertimeRepository_Expecter) ResetPaidByPeriodID(ctx interface{}, periodID interface{}) *MockIOvertimeRepository_ResetPaidByPeriodID_Call {
+	return &MockIOvertimeRepository_ResetPaidByPeriodID_Call{Call: _e.mock.On("ResetPaidByPeriodID", ctx, periodID)}
+}
+
+func (_c *MockIOvertimeRepository_ResetPaidByPeriodID_Call) Run(run func(ctx context.Context, periodID uint)) *MockIOvertimeRepository_ResetPaidByPeriodID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_ResetPaidByPeriodID_Call) Return(_a0 error) *MockIOvertimeRepository_ResetPaidByPeriodID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_ResetPaidByPeriodID_Call) RunAndReturn(run func(context.Context, uint) error) *MockIOvertimeRepository_ResetPaidByPeriodID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function with given fields: ctx, id, updates
+func (_m *MockIOvertimeRepository) Review(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ret := _m.Called(ctx, id, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Review")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, map[string]interface{}) error); ok {
+		r0 = rf(ctx, id, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIOvertimeRepository_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type MockIOvertimeRepository_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - updates map[string]interface{}
+func (_e *MockIOvertimeRepository_Expecter) Review(ctx interface{}, id interface{}, updates interface{}) *MockIOvertimeRepository_Review_Call {
+	return &MockIOvertimeRepository_Review_Call{Call: _e.mock.On("Review", ctx, id, updates)}
+}
+
+func (_c *MockIOvertimeRepository_Review_Call) Run(run func(ctx context.Context, id uint, updates map[string]interface{})) *MockIOvertimeRepository_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_Review_Call) Return(_a0 error) *MockIOvertimeRepository_Review_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIOvertimeRepository_Review_Call) RunAndReturn(run func(context.Context, uint, map[string]interface{}) error) *MockIOvertimeRepository_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, id, updates
 func (_m *MockIOvertimeRepository) Update(ctx context.Context, id uint, updates map[string]interface{}) error {
 	ret := _m.Called(ctx, id, updates)
@@ -6,9 +6,12 @@ import (
 	context "context"
 
 	payslip "github.com/riskykurniawan15/payrolls/models/payslip"
-	mock "github.com/stretchr/testify/mock"
 
 	period_detail "github.com/riskykurniawan15/payrolls/models/period_detail"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockIPeriodDetailRepository is an autogenerated mock type for the IPeriodDetailRepository type
@@ -450,9 +453,9 @@ func (_c *MockIPeriodDetailRepository_GetPayslipSummaryData_Call) RunAndReturn(r
 	return _c
 }
 
-// GetUsersByBatch provides a mock function with given fields: ctx, lastID, limit
-func (_m *MockIPeriodDetailRepository) GetUsersByBatch(ctx context.Context, lastID uint, limit int) ([]uint, error) {
-	ret := _m.Called(ctx, lastID, limit)
+// GetUsersByBatch provides a mock function with given fields: ctx, lastID, limit, periodEnd
+func (_m *MockIPeriodDetailRepository) GetUsersByBatch(ctx context.Context, lastID uint, limit int, periodEnd time.Time) ([]uint, error) {
+	ret := _m.Called(ctx, lastID, limit, periodEnd)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUsersByBatch")
@@ -460,19 +463,19 @@ func (_m *MockIPeriodDetailRepository) GetUsersByBatch(ctx context.Context, last
 
 	var r0 []uint
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, int) ([]uint, error)); ok {
-		return rf(ctx, lastID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int, time.Time) ([]uint, error)); ok {
+		return rf(ctx, lastID, limit, periodEnd)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, int) []uint); ok {
-		r0 = rf(ctx, lastID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int, time.Time) []uint); ok {
+		r0 = rf(ctx, lastID, limit, periodEnd)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]uint)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, int) error); ok {
-		r1 = rf(ctx, lastID, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, int, time.Time) error); ok {
+		r1 = rf(ctx, lastID, limit, periodEnd)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -489,13 +492,14 @@ type MockIPeriodDetailRepository_GetUsersByBatch_Call struct {
 //   - ctx context.Context
 //   - lastID uint
 //   - limit int
-func (_e *MockIPeriodDetailRepository_Expecter) GetUsersByBatch(ctx interface{}, lastID interface{}, limit interface{}) *MockIPeriodDetailRepository_GetUsersByBatch_Call {
-	return &MockIPeriodDetailRepository_GetUsersByBatch_Call{Call: _e.mock.On("GetUsersByBatch", ctx, lastID, limit)}
+//   - periodEnd time.Time
+func (_e *MockIPeriodDetailRepository_Expecter) GetUsersByBatch(ctx interface{}, lastID interface{}, limit interface{}, periodEnd interface{}) *MockIPeriodDetailRepository_GetUsersByBatch_Call {
+	return &MockIPeriodDetailRepository_GetUsersByBatch_Call{Call: _e.mock.On("GetUsersByBatch", ctx, lastID, limit, periodEnd)}
 }
 
-func (_c *MockIPeriodDetailRepository_GetUsersByBatch_Call) Run(run func(ctx context.Context, lastID uint, limit int)) *MockIPeriodDetailRepository_GetUsersByBatch_Call {
+func (_c *MockIPeriodDetailRepository_GetUsersByBatch_Call) Run(run func(ctx context.Context, lastID uint, limit int, periodEnd time.Time)) *MockIPeriodDetailRepository_GetUsersByBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(int))
+		run(args[0].(context.Context), args[1].(uint), args[2].(int), args[3].(time.Time))
 	})
 	return _c
 }
@@ -505,7 +509,7 @@ func (_c *MockIPeriodDetailRepository_GetUsersByBatch_Call) Return(_a0 []uint, _
 	return _c
 }
 
-func (_c *MockIPeriodDetailRepository_GetUsersByBatch_Call) RunAndReturn(run func(context.Context, uint, int) ([]uint, error)) *MockIPeriodDetailRepository_GetUsersByBatch_Call {
+func (_c *MockIPeriodDetailRepository_GetUsersByBatch_Call) RunAndReturn(run func(context.Context, uint, int, time.Time) ([]uint, error)) *MockIPeriodDetailRepository_GetUsersByBatch_Call {
 	_c.Call.Return(run)
 	return _c
 }
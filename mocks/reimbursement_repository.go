@@ -71,6 +71,147 @@ func (_c *MockIReimbursementRepository_Create_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CreateAttachment provides a mock function with given fields: ctx, attachment
+func (_m *MockIReimbursementRepository) CreateAttachment(ctx context.Context, attachment *reimbursement.ReimbursementAttachment) error {
+	ret := _m.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *reimbursement.ReimbursementAttachment) error); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_CreateAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAttachment'
+type MockIReimbursementRepository_CreateAttachment_Call struct {
+	*mock.Call
+}
+
+// CreateAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment *reimbursement.ReimbursementAttachment
+func (_e *MockIReimbursementRepository_Expecter) CreateAttachment(ctx interface{}, attachment interface{}) *MockIReimbursementRepository_CreateAttachment_Call {
+	return &MockIReimbursementRepository_CreateAttachment_Call{Call: _e.mock.On("CreateAttachment", ctx, attachment)}
+}
+
+func (_c *MockIReimbursementRepository_CreateAttachment_Call) Run(run func(ctx context.Context, attachment *reimbursement.ReimbursementAttachment)) *MockIReimbursementRepository_CreateAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*reimbursement.ReimbursementAttachment))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_CreateAttachment_Call) Return(_a0 error) *MockIReimbursementRepository_CreateAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_CreateAttachment_Call) RunAndReturn(run func(context.Context, *reimbursement.ReimbursementAttachment) error) *MockIReimbursementRepository_CreateAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCategory provides a mock function with given fields: ctx, category
+func (_m *MockIReimbursementRepository) CreateCategory(ctx context.Context, category *reimbursement.ReimbursementCategory) error {
+	ret := _m.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *reimbursement.ReimbursementCategory) error); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type MockIReimbursementRepository_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *reimbursement.ReimbursementCategory
+func (_e *MockIReimbursementRepository_Expecter) CreateCategory(ctx interface{}, category interface{}) *MockIReimbursementRepository_CreateCategory_Call {
+	return &MockIReimbursementRepository_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, category)}
+}
+
+func (_c *MockIReimbursementRepository_CreateCategory_Call) Run(run func(ctx context.Context, category *reimbursement.ReimbursementCategory)) *MockIReimbursementRepository_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*reimbursement.ReimbursementCategory))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_CreateCategory_Call) Return(_a0 error) *MockIReimbursementRepository_CreateCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_CreateCategory_Call) RunAndReturn(run func(context.Context, *reimbursement.ReimbursementCategory) error) *MockIReimbursementRepository_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDuplicates provides a mock function with given fields: ctx, duplicates
+func (_m *MockIReimbursementRepository) CreateDuplicates(ctx context.Context, duplicates []reimbursement.ReimbursementDuplicate) error {
+	ret := _m.Called(ctx, duplicates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDuplicates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []reimbursement.ReimbursementDuplicate) error); ok {
+		r0 = rf(ctx, duplicates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_CreateDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDuplicates'
+type MockIReimbursementRepository_CreateDuplicates_Call struct {
+	*mock.Call
+}
+
+// CreateDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - duplicates []reimbursement.ReimbursementDuplicate
+func (_e *MockIReimbursementRepository_Expecter) CreateDuplicates(ctx interface{}, duplicates interface{}) *MockIReimbursementRepository_CreateDuplicates_Call {
+	return &MockIReimbursementRepository_CreateDuplicates_Call{Call: _e.mock.On("CreateDuplicates", ctx, duplicates)}
+}
+
+func (_c *MockIReimbursementRepository_CreateDuplicates_Call) Run(run func(ctx context.Context, duplicates []reimbursement.ReimbursementDuplicate)) *MockIReimbursementRepository_CreateDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]reimbursement.ReimbursementDuplicate))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_CreateDuplicates_Call) Return(_a0 error) *MockIReimbursementRepository_CreateDuplicates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_CreateDuplicates_Call) RunAndReturn(run func(context.Context, []reimbursement.ReimbursementDuplicate) error) *MockIReimbursementRepository_CreateDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *MockIReimbursementRepository) Delete(ctx context.Context, id uint) error {
 	ret := _m.Called(ctx, id)
@@ -118,29 +259,76 @@ func (_c *MockIReimbursementRepository_Delete_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *MockIReimbursementRepository) GetByID(ctx context.Context, id uint) (*reimbursement.Reimbursement, error) {
+// DeleteAttachment provides a mock function with given fields: ctx, id
+func (_m *MockIReimbursementRepository) DeleteAttachment(ctx context.Context, id uint) error {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for DeleteAttachment")
 	}
 
-	var r0 *reimbursement.Reimbursement
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type MockIReimbursementRepository_DeleteAttachment_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIReimbursementRepository_Expecter) DeleteAttachment(ctx interface{}, id interface{}) *MockIReimbursementRepository_DeleteAttachment_Call {
+	return &MockIReimbursementRepository_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", ctx, id)}
+}
+
+func (_c *MockIReimbursementRepository_DeleteAttachment_Call) Run(run func(ctx context.Context, id uint)) *MockIReimbursementRepository_DeleteAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_DeleteAttachment_Call) Return(_a0 error) *MockIReimbursementRepository_DeleteAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_DeleteAttachment_Call) RunAndReturn(run func(context.Context, uint) error) *MockIReimbursementRepository_DeleteAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAttachmentsByChecksum provides a mock function with given fields: ctx, checksum, excludeReimbursementID
+func (_m *MockIReimbursementRepository) FindAttachmentsByChecksum(ctx context.Context, checksum string, excludeReimbursementID uint) ([]reimbursement.ReimbursementAttachment, error) {
+	ret := _m.Called(ctx, checksum, excludeReimbursementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAttachmentsByChecksum")
+	}
+
+	var r0 []reimbursement.ReimbursementAttachment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint) (*reimbursement.Reimbursement, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint) ([]reimbursement.ReimbursementAttachment, error)); ok {
+		return rf(ctx, checksum, excludeReimbursementID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint) *reimbursement.Reimbursement); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint) []reimbursement.ReimbursementAttachment); ok {
+		r0 = rf(ctx, checksum, excludeReimbursementID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*reimbursement.Reimbursement)
+			r0 = ret.Get(0).([]reimbursement.ReimbursementAttachment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint) error); ok {
+		r1 = rf(ctx, checksum, excludeReimbursementID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -148,58 +336,59 @@ func (_m *MockIReimbursementRepository) GetByID(ctx context.Context, id uint) (*
 	return r0, r1
 }
 
-// MockIReimbursementRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIReimbursementRepository_GetByID_Call struct {
+// MockIReimbursementRepository_FindAttachmentsByChecksum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAttachmentsByChecksum'
+type MockIReimbursementRepository_FindAttachmentsByChecksum_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// FindAttachmentsByChecksum is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uint
-func (_e *MockIReimbursementRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockIReimbursementRepository_GetByID_Call {
-	return &MockIReimbursementRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - checksum string
+//   - excludeReimbursementID uint
+func (_e *MockIReimbursementRepository_Expecter) FindAttachmentsByChecksum(ctx interface{}, checksum interface{}, excludeReimbursementID interface{}) *MockIReimbursementRepository_FindAttachmentsByChecksum_Call {
+	return &MockIReimbursementRepository_FindAttachmentsByChecksum_Call{Call: _e.mock.On("FindAttachmentsByChecksum", ctx, checksum, excludeReimbursementID)}
 }
 
-func (_c *MockIReimbursementRepository_GetByID_Call) Run(run func(ctx context.Context, id uint)) *MockIReimbursementRepository_GetByID_Call {
+func (_c *MockIReimbursementRepository_FindAttachmentsByChecksum_Call) Run(run func(ctx context.Context, checksum string, excludeReimbursementID uint)) *MockIReimbursementRepository_FindAttachmentsByChecksum_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint))
+		run(args[0].(context.Context), args[1].(string), args[2].(uint))
 	})
 	return _c
 }
 
-func (_c *MockIReimbursementRepository_GetByID_Call) Return(_a0 *reimbursement.Reimbursement, _a1 error) *MockIReimbursementRepository_GetByID_Call {
+func (_c *MockIReimbursementRepository_FindAttachmentsByChecksum_Call) Return(_a0 []reimbursement.ReimbursementAttachment, _a1 error) *MockIReimbursementRepository_FindAttachmentsByChecksum_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockIReimbursementRepository_GetByID_Call) RunAndReturn(run func(context.Context, uint) (*reimbursement.Reimbursement, error)) *MockIReimbursementRepository_GetByID_Call {
+func (_c *MockIReimbursementRepository_FindAttachmentsByChecksum_Call) RunAndReturn(run func(context.Context, string, uint) ([]reimbursement.ReimbursementAttachment, error)) *MockIReimbursementRepository_FindAttachmentsByChecksum_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByUserAndDateRange provides a mock function with given fields: ctx, userID, startDate, endDate
-func (_m *MockIReimbursementRepository) GetByUserAndDateRange(ctx context.Context, userID uint, startDate time.Time, endDate time.Time) ([]reimbursement.Reimbursement, error) {
-	ret := _m.Called(ctx, userID, startDate, endDate)
+// FindSameAmountClaims provides a mock function with given fields: ctx, userID, amount, startDate, endDate, excludeID
+func (_m *MockIReimbursementRepository) FindSameAmountClaims(ctx context.Context, userID uint, amount float64, startDate time.Time, endDate time.Time, excludeID uint) ([]reimbursement.Reimbursement, error) {
+	ret := _m.Called(ctx, userID, amount, startDate, endDate, excludeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByUserAndDateRange")
+		panic("no return value specified for FindSameAmountClaims")
 	}
 
 	var r0 []reimbursement.Reimbursement
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) ([]reimbursement.Reimbursement, error)); ok {
-		return rf(ctx, userID, startDate, endDate)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, float64, time.Time, time.Time, uint) ([]reimbursement.Reimbursement, error)); ok {
+		return rf(ctx, userID, amount, startDate, endDate, excludeID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) []reimbursement.Reimbursement); ok {
-		r0 = rf(ctx, userID, startDate, endDate)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, float64, time.Time, time.Time, uint) []reimbursement.Reimbursement); ok {
+		r0 = rf(ctx, userID, amount, startDate, endDate, excludeID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]reimbursement.Reimbursement)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, userID, startDate, endDate)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, float64, time.Time, time.Time, uint) error); ok {
+		r1 = rf(ctx, userID, amount, startDate, endDate, excludeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -207,60 +396,62 @@ func (_m *MockIReimbursementRepository) GetByUserAndDateRange(ctx context.Contex
 	return r0, r1
 }
 
-// MockIReimbursementRepository_GetByUserAndDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserAndDateRange'
-type MockIReimbursementRepository_GetByUserAndDateRange_Call struct {
+// MockIReimbursementRepository_FindSameAmountClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSameAmountClaims'
+type MockIReimbursementRepository_FindSameAmountClaims_Call struct {
 	*mock.Call
 }
 
-// GetByUserAndDateRange is a helper method to define mock.On call
+// FindSameAmountClaims is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uint
+//   - amount float64
 //   - startDate time.Time
 //   - endDate time.Time
-func (_e *MockIReimbursementRepository_Expecter) GetByUserAndDateRange(ctx interface{}, userID interface{}, startDate interface{}, endDate interface{}) *MockIReimbursementRepository_GetByUserAndDateRange_Call {
-	return &MockIReimbursementRepository_GetByUserAndDateRange_Call{Call: _e.mock.On("GetByUserAndDateRange", ctx, userID, startDate, endDate)}
+//   - excludeID uint
+func (_e *MockIReimbursementRepository_Expecter) FindSameAmountClaims(ctx interface{}, userID interface{}, amount interface{}, startDate interface{}, endDate interface{}, excludeID interface{}) *MockIReimbursementRepository_FindSameAmountClaims_Call {
+	return &MockIReimbursementRepository_FindSameAmountClaims_Call{Call: _e.mock.On("FindSameAmountClaims", ctx, userID, amount, startDate, endDate, excludeID)}
 }
 
-func (_c *MockIReimbursementRepository_GetByUserAndDateRange_Call) Run(run func(ctx context.Context, userID uint, startDate time.Time, endDate time.Time)) *MockIReimbursementRepository_GetByUserAndDateRange_Call {
+func (_c *MockIReimbursementRepository_FindSameAmountClaims_Call) Run(run func(ctx context.Context, userID uint, amount float64, startDate time.Time, endDate time.Time, excludeID uint)) *MockIReimbursementRepository_FindSameAmountClaims_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(uint), args[2].(float64), args[3].(time.Time), args[4].(time.Time), args[5].(uint))
 	})
 	return _c
 }
 
-func (_c *MockIReimbursementRepository_GetByUserAndDateRange_Call) Return(_a0 []reimbursement.Reimbursement, _a1 error) *MockIReimbursementRepository_GetByUserAndDateRange_Call {
+func (_c *MockIReimbursementRepository_FindSameAmountClaims_Call) Return(_a0 []reimbursement.Reimbursement, _a1 error) *MockIReimbursementRepository_FindSameAmountClaims_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockIReimbursementRepository_GetByUserAndDateRange_Call) RunAndReturn(run func(context.Context, uint, time.Time, time.Time) ([]reimbursement.Reimbursement, error)) *MockIReimbursementRepository_GetByUserAndDateRange_Call {
+func (_c *MockIReimbursementRepository_FindSameAmountClaims_Call) RunAndReturn(run func(context.Context, uint, float64, time.Time, time.Time, uint) ([]reimbursement.Reimbursement, error)) *MockIReimbursementRepository_FindSameAmountClaims_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function with given fields: ctx, req, userID
-func (_m *MockIReimbursementRepository) List(ctx context.Context, req reimbursement.ListReimbursementsRequest, userID uint) (*reimbursement.ListReimbursementsResponse, error) {
-	ret := _m.Called(ctx, req, userID)
+// GetAttachmentByID provides a mock function with given fields: ctx, id
+func (_m *MockIReimbursementRepository) GetAttachmentByID(ctx context.Context, id uint) (*reimbursement.ReimbursementAttachment, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for GetAttachmentByID")
 	}
 
-	var r0 *reimbursement.ListReimbursementsResponse
+	var r0 *reimbursement.ReimbursementAttachment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, reimbursement.ListReimbursementsRequest, uint) (*reimbursement.ListReimbursementsResponse, error)); ok {
-		return rf(ctx, req, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*reimbursement.ReimbursementAttachment, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, reimbursement.ListReimbursementsRequest, uint) *reimbursement.ListReimbursementsResponse); ok {
-		r0 = rf(ctx, req, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *reimbursement.ReimbursementAttachment); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*reimbursement.ListReimbursementsResponse)
+			r0 = ret.Get(0).(*reimbursement.ReimbursementAttachment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, reimbursement.ListReimbursementsRequest, uint) error); ok {
-		r1 = rf(ctx, req, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -268,80 +459,1034 @@ func (_m *MockIReimbursementRepository) List(ctx context.Context, req reimbursem
 	return r0, r1
 }
 
-// MockIReimbursementRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockIReimbursementRepository_List_Call struct {
+// MockIReimbursementRepository_GetAttachmentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttachmentByID'
+type MockIReimbursementRepository_GetAttachmentByID_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// GetAttachmentByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req reimbursement.ListReimbursementsRequest
-//   - userID uint
-func (_e *MockIReimbursementRepository_Expecter) List(ctx interface{}, req interface{}, userID interface{}) *MockIReimbursementRepository_List_Call {
-	return &MockIReimbursementRepository_List_Call{Call: _e.mock.On("List", ctx, req, userID)}
+//   - id uint
+func (_e *MockIReimbursementRepository_Expecter) GetAttachmentByID(ctx interface{}, id interface{}) *MockIReimbursementRepository_GetAttachmentByID_Call {
+	return &MockIReimbursementRepository_GetAttachmentByID_Call{Call: _e.mock.On("GetAttachmentByID", ctx, id)}
 }
 
-func (_c *MockIReimbursementRepository_List_Call) Run(run func(ctx context.Context, req reimbursement.ListReimbursementsRequest, userID uint)) *MockIReimbursementRepository_List_Call {
+func (_c *MockIReimbursementRepository_GetAttachmentByID_Call) Run(run func(ctx context.Context, id uint)) *MockIReimbursementRepository_GetAttachmentByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(reimbursement.ListReimbursementsRequest), args[2].(uint))
+		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *MockIReimbursementRepository_List_Call) Return(_a0 *reimbursement.ListReimbursementsResponse, _a1 error) *MockIReimbursementRepository_List_Call {
+func (_c *MockIReimbursementRepository_GetAttachmentByID_Call) Return(_a0 *reimbursement.ReimbursementAttachment, _a1 error) *MockIReimbursementRepository_GetAttachmentByID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockIReimbursementRepository_List_Call) RunAndReturn(run func(context.Context, reimbursement.ListReimbursementsRequest, uint) (*reimbursement.ListReimbursementsResponse, error)) *MockIReimbursementRepository_List_Call {
+func (_c *MockIReimbursementRepository_GetAttachmentByID_Call) RunAndReturn(run func(context.Context, uint) (*reimbursement.ReimbursementAttachment, error)) *MockIReimbursementRepository_GetAttachmentByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, id, updates
-func (_m *MockIReimbursementRepository) Update(ctx context.Context, id uint, updates map[string]interface{}) error {
-	ret := _m.Called(ctx, id, updates)
+// GetAttachmentsByReimbursementID provides a mock function with given fields: ctx, reimbursementID
+func (_m *MockIReimbursementRepository) GetAttachmentsByReimbursementID(ctx context.Context, reimbursementID uint) ([]reimbursement.ReimbursementAttachment, error) {
+	ret := _m.Called(ctx, reimbursementID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetAttachmentsByReimbursementID")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, map[string]interface{}) error); ok {
-		r0 = rf(ctx, id, updates)
+	var r0 []reimbursement.ReimbursementAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]reimbursement.ReimbursementAttachment, error)); ok {
+		return rf(ctx, reimbursementID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []reimbursement.ReimbursementAttachment); ok {
+		r0 = rf(ctx, reimbursementID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reimbursement.ReimbursementAttachment)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, reimbursementID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockIReimbursementRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockIReimbursementRepository_Update_Call struct {
+// MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttachmentsByReimbursementID'
+type MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// GetAttachmentsByReimbursementID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uint
-//   - updates map[string]interface{}
-func (_e *MockIReimbursementRepository_Expecter) Update(ctx interface{}, id interface{}, updates interface{}) *MockIReimbursementRepository_Update_Call {
-	return &MockIReimbursementRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, updates)}
+//   - reimbursementID uint
+func (_e *MockIReimbursementRepository_Expecter) GetAttachmentsByReimbursementID(ctx interface{}, reimbursementID interface{}) *MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call {
+	return &MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call{Call: _e.mock.On("GetAttachmentsByReimbursementID", ctx, reimbursementID)}
 }
 
-func (_c *MockIReimbursementRepository_Update_Call) Run(run func(ctx context.Context, id uint, updates map[string]interface{})) *MockIReimbursementRepository_Update_Call {
+func (_c *MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call) Run(run func(ctx context.Context, reimbursementID uint)) *MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(map[string]interface{}))
+		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *MockIReimbursementRepository_Update_Call) Return(_a0 error) *MockIReimbursementRepository_Update_Call {
-	_c.Call.Return(_a0)
+func (_c *MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call) Return(_a0 []reimbursement.ReimbursementAttachment, _a1 error) *MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockIReimbursementRepository_Update_Call) RunAndReturn(run func(context.Context, uint, map[string]interface{}) error) *MockIReimbursementRepository_Update_Call {
+func (_c *MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call) RunAndReturn(run func(context.Context, uint) ([]reimbursement.ReimbursementAttachment, error)) *MockIReimbursementRepository_GetAttachmentsByReimbursementID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIReimbursementRepository) GetByID(ctx context.Context, id uint) (*reimbursement.Reimbursement, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *reimbursement.Reimbursement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*reimbursement.Reimbursement, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *reimbursement.Reimbursement); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reimbursement.Reimbursement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIReimbursementRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIReimbursementRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockIReimbursementRepository_GetByID_Call {
+	return &MockIReimbursementRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockIReimbursementRepository_GetByID_Call) Run(run func(ctx context.Context, id uint)) *MockIReimbursementRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetByID_Call) Return(_a0 *reimbursement.Reimbursement, _a1 error) *MockIReimbursementRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetByID_Call) RunAndReturn(run func(context.Context, uint) (*reimbursement.Reimbursement, error)) *MockIReimbursementRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserAndDateRange provides a mock function with given fields: ctx, userID, startDate, endDate
+func (_m *MockIReimbursementRepository) GetByUserAndDateRange(ctx context.Context, userID uint, startDate time.Time, endDate time.Time) ([]reimbursement.Reimbursement, error) {
+	ret := _m.Called(ctx, userID, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserAndDateRange")
+	}
+
+	var r0 []reimbursement.Reimbursement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) ([]reimbursement.Reimbursement, error)); ok {
+		return rf(ctx, userID, startDate, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time, time.Time) []reimbursement.Reimbursement); ok {
+		r0 = rf(ctx, userID, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reimbursement.Reimbursement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_GetByUserAndDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserAndDateRange'
+type MockIReimbursementRepository_GetByUserAndDateRange_Call struct {
+	*mock.Call
+}
+
+// GetByUserAndDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockIReimbursementRepository_Expecter) GetByUserAndDateRange(ctx interface{}, userID interface{}, startDate interface{}, endDate interface{}) *MockIReimbursementRepository_GetByUserAndDateRange_Call {
+	return &MockIReimbursementRepository_GetByUserAndDateRange_Call{Call: _e.mock.On("GetByUserAndDateRange", ctx, userID, startDate, endDate)}
+}
+
+func (_c *MockIReimbursementRepository_GetByUserAndDateRange_Call) Run(run func(ctx context.Context, userID uint, startDate time.Time, endDate time.Time)) *MockIReimbursementRepository_GetByUserAndDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetByUserAndDateRange_Call) Return(_a0 []reimbursement.Reimbursement, _a1 error) *MockIReimbursementRepository_GetByUserAndDateRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetByUserAndDateRange_Call) RunAndReturn(run func(context.Context, uint, time.Time, time.Time) ([]reimbursement.Reimbursement, error)) *MockIReimbursementRepository_GetByUserAndDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryByCode provides a mock function with given fields: ctx, code
+func (_m *MockIReimbursementRepository) GetCategoryByCode(ctx context.Context, code string) (*reimbursement.ReimbursementCategory, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryByCode")
+	}
+
+	var r0 *reimbursement.ReimbursementCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*reimbursement.ReimbursementCategory, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *reimbursement.ReimbursementCategory); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reimbursement.ReimbursementCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_GetCategoryByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryByCode'
+type MockIReimbursementRepository_GetCategoryByCode_Call struct {
+	*mock.Call
+}
+
+// GetCategoryByCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockIReimbursementRepository_Expecter) GetCategoryByCode(ctx interface{}, code interface{}) *MockIReimbursementRepository_GetCategoryByCode_Call {
+	return &MockIReimbursementRepository_GetCategoryByCode_Call{Call: _e.mock.On("GetCategoryByCode", ctx, code)}
+}
+
+func (_c *MockIReimbursementRepository_GetCategoryByCode_Call) Run(run func(ctx context.Context, code string)) *MockIReimbursementRepository_GetCategoryByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetCategoryByCode_Call) Return(_a0 *reimbursement.ReimbursementCategory, _a1 error) *MockIReimbursementRepository_GetCategoryByCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetCategoryByCode_Call) RunAndReturn(run func(context.Context, string) (*reimbursement.ReimbursementCategory, error)) *MockIReimbursementRepository_GetCategoryByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryByID provides a mock function with given fields: ctx, id
+func (_m *MockIReimbursementRepository) GetCategoryByID(ctx context.Context, id uint) (*reimbursement.ReimbursementCategory, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryByID")
+	}
+
+	var r0 *reimbursement.ReimbursementCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*reimbursement.ReimbursementCategory, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *reimbursement.ReimbursementCategory); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reimbursement.ReimbursementCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_GetCategoryByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryByID'
+type MockIReimbursementRepository_GetCategoryByID_Call struct {
+	*mock.Call
+}
+
+// GetCategoryByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIReimbursementRepository_Expecter) GetCategoryByID(ctx interface{}, id interface{}) *MockIReimbursementRepository_GetCategoryByID_Call {
+	return &MockIReimbursementRepository_GetCategoryByID_Call{Call: _e.mock.On("GetCategoryByID", ctx, id)}
+}
+
+func (_c *MockIReimbursementRepository_GetCategoryByID_Call) Run(run func(ctx context.Context, id uint)) *MockIReimbursementRepository_GetCategoryByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetCategoryByID_Call) Return(_a0 *reimbursement.ReimbursementCategory, _a1 error) *MockIReimbursementRepository_GetCategoryByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetCategoryByID_Call) RunAndReturn(run func(context.Context, uint) (*reimbursement.ReimbursementCategory, error)) *MockIReimbursementRepository_GetCategoryByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDuplicatesByReimbursementID provides a mock function with given fields: ctx, reimbursementID
+func (_m *MockIReimbursementRepository) GetDuplicatesByReimbursementID(ctx context.Context, reimbursementID uint) ([]reimbursement.ReimbursementDuplicate, error) {
+	ret := _m.Called(ctx, reimbursementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDuplicatesByReimbursementID")
+	}
+
+	var r0 []reimbursement.ReimbursementDuplicate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]reimbursement.ReimbursementDuplicate, error)); ok {
+		return rf(ctx, reimbursementID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []reimbursement.ReimbursementDuplicate); ok {
+		r0 = rf(ctx, reimbursementID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reimbursement.ReimbursementDuplicate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, reimbursementID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuplicatesByReimbursementID'
+type MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call struct {
+	*mock.Call
+}
+
+// GetDuplicatesByReimbursementID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reimbursementID uint
+func (_e *MockIReimbursementRepository_Expecter) GetDuplicatesByReimbursementID(ctx interface{}, reimbursementID interface{}) *MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call {
+	return &MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call{Call: _e.mock.On("GetDuplicatesByReimbursementID", ctx, reimbursementID)}
+}
+
+func (_c *MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call) Run(run func(ctx context.Context, reimbursementID uint)) *MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call) Return(_a0 []reimbursement.ReimbursementDuplicate, _a1 error) *MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call) RunAndReturn(run func(context.Context, uint) ([]reimbursement.ReimbursementDuplicate, error)) *MockIReimbursementRepository_GetDuplicatesByReimbursementID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPayableByUser provides a mock function with given fields: ctx, userID, endDate
+func (_m *MockIReimbursementRepository) GetPayableByUser(ctx context.Context, userID uint, endDate time.Time) ([]reimbursement.Reimbursement, error) {
+	ret := _m.Called(ctx, userID, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayableByUser")
+	}
+
+	var r0 []reimbursement.Reimbursement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) ([]reimbursement.Reimbursement, error)); ok {
+		return rf(ctx, userID, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) []reimbursement.Reimbursement); ok {
+		r0 = rf(ctx, userID, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reimbursement.Reimbursement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time) error); ok {
+		r1 = rf(ctx, userID, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_GetPayableByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayableByUser'
+type MockIReimbursementRepository_GetPayableByUser_Call struct {
+	*mock.Call
+}
+
+// GetPayableByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - endDate time.Time
+func (_e *MockIReimbursementRepository_Expecter) GetPayableByUser(ctx interface{}, userID interface{}, endDate interface{}) *MockIReimbursementRepository_GetPayableByUser_Call {
+	return &MockIReimbursementRepository_GetPayableByUser_Call{Call: _e.mock.On("GetPayableByUser", ctx, userID, endDate)}
+}
+
+func (_c *MockIReimbursementRepository_GetPayableByUser_Call) Run(run func(ctx context.Context, userID uint, endDate time.Time)) *MockIReimbursementRepository_GetPayableByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetPayableByUser_Call) Return(_a0 []reimbursement.Reimbursement, _a1 error) *MockIReimbursementRepository_GetPayableByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetPayableByUser_Call) RunAndReturn(run func(context.Context, uint, time.Time) ([]reimbursement.Reimbursement, error)) *MockIReimbursementRepository_GetPayableByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsedAmount provides a mock function with given fields: ctx, userID, categoryID, startDate, endDate, excludeID
+func (_m *MockIReimbursementRepository) GetUsedAmount(ctx context.Context, userID uint, categoryID uint, startDate time.Time, endDate time.Time, excludeID uint) (float64, error) {
+	ret := _m.Called(ctx, userID, categoryID, startDate, endDate, excludeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsedAmount")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, time.Time, time.Time, uint) (float64, error)); ok {
+		return rf(ctx, userID, categoryID, startDate, endDate, excludeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, time.Time, time.Time, uint) float64); ok {
+		r0 = rf(ctx, userID, categoryID, startDate, endDate, excludeID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, time.Time, time.Time, uint) error); ok {
+		r1 = rf(ctx, userID, categoryID, startDate, endDate, excludeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_GetUsedAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsedAmount'
+type MockIReimbursementRepository_GetUsedAmount_Call struct {
+	*mock.Call
+}
+
+// GetUsedAmount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - categoryID uint
+//   - startDate time.Time
+//   - endDate time.Time
+//   - excludeID uint
+func (_e *MockIReimbursementRepository_Expecter) GetUsedAmount(ctx interface{}, userID interface{}, categoryID interface{}, startDate interface{}, endDate interface{}, excludeID interface{}) *MockIReimbursementRepository_GetUsedAmount_Call {
+	return &MockIReimbursementRepository_GetUsedAmount_Call{Call: _e.mock.On("GetUsedAmount", ctx, userID, categoryID, startDate, endDate, excludeID)}
+}
+
+func (_c *MockIReimbursementRepository_GetUsedAmount_Call) Run(run func(ctx context.Context, userID uint, categoryID uint, startDate time.Time, endDate time.Time, excludeID uint)) *MockIReimbursementRepository_GetUsedAmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(time.Time), args[4].(time.Time), args[5].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetUsedAmount_Call) Return(_a0 float64, _a1 error) *MockIReimbursementRepository_GetUsedAmount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_GetUsedAmount_Call) RunAndReturn(run func(context.Context, uint, uint, time.Time, time.Time, uint) (float64, error)) *MockIReimbursementRepository_GetUsedAmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, req, userID
+func (_m *MockIReimbursementRepository) List(ctx context.Context, req reimbursement.ListReimbursementsRequest, userID uint) (*reimbursement.ListReimbursementsResponse, error) {
+	ret := _m.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *reimbursement.ListReimbursementsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, reimbursement.ListReimbursementsRequest, uint) (*reimbursement.ListReimbursementsResponse, error)); ok {
+		return rf(ctx, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, reimbursement.ListReimbursementsRequest, uint) *reimbursement.ListReimbursementsResponse); ok {
+		r0 = rf(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reimbursement.ListReimbursementsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, reimbursement.ListReimbursementsRequest, uint) error); ok {
+		r1 = rf(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockIReimbursementRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req reimbursement.ListReimbursementsRequest
+//   - userID uint
+func (_e *MockIReimbursementRepository_Expecter) List(ctx interface{}, req interface{}, userID interface{}) *MockIReimbursementRepository_List_Call {
+	return &MockIReimbursementRepository_List_Call{Call: _e.mock.On("List", ctx, req, userID)}
+}
+
+func (_c *MockIReimbursementRepository_List_Call) Run(run func(ctx context.Context, req reimbursement.ListReimbursementsRequest, userID uint)) *MockIReimbursementRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reimbursement.ListReimbursementsRequest), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_List_Call) Return(_a0 *reimbursement.ListReimbursementsResponse, _a1 error) *MockIReimbursementRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_List_Call) RunAndReturn(run func(context.Context, reimbursement.ListReimbursementsRequest, uint) (*reimbursement.ListReimbursementsResponse, error)) *MockIReimbursementRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAll provides a mock function with given fields: ctx, req
+func (_m *MockIReimbursementRepository) ListAll(ctx context.Context, req reimbursement.ListAllReimbursementsRequest) (*reimbursement.ListReimbursementsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAll")
+	}
+
+	var r0 *reimbursement.ListReimbursementsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, reimbursement.ListAllReimbursementsRequest) (*reimbursement.ListReimbursementsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, reimbursement.ListAllReimbursementsRequest) *reimbursement.ListReimbursementsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reimbursement.ListReimbursementsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, reimbursement.ListAllReimbursementsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_ListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAll'
+type MockIReimbursementRepository_ListAll_Call struct {
+	*mock.Call
+}
+
+// ListAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req reimbursement.ListAllReimbursementsRequest
+func (_e *MockIReimbursementRepository_Expecter) ListAll(ctx interface{}, req interface{}) *MockIReimbursementRepository_ListAll_Call {
+	return &MockIReimbursementRepository_ListAll_Call{Call: _e.mock.On("ListAll", ctx, req)}
+}
+
+func (_c *MockIReimbursementRepository_ListAll_Call) Run(run func(ctx context.Context, req reimbursement.ListAllReimbursementsRequest)) *MockIReimbursementRepository_ListAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reimbursement.ListAllReimbursementsRequest))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_ListAll_Call) Return(_a0 *reimbursement.ListReimbursementsResponse, _a1 error) *MockIReimbursementRepository_ListAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_ListAll_Call) RunAndReturn(run func(context.Context, reimbursement.ListAllReimbursementsRequest) (*reimbursement.ListReimbursementsResponse, error)) *MockIReimbursementRepository_ListAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx, activeOnly
+func (_m *MockIReimbursementRepository) ListCategories(ctx context.Context, activeOnly bool) ([]reimbursement.ReimbursementCategory, error) {
+	ret := _m.Called(ctx, activeOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []reimbursement.ReimbursementCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]reimbursement.ReimbursementCategory, error)); ok {
+		return rf(ctx, activeOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []reimbursement.ReimbursementCategory); ok {
+		r0 = rf(ctx, activeOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reimbursement.ReimbursementCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, activeOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type MockIReimbursementRepository_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - activeOnly bool
+func (_e *MockIReimbursementRepository_Expecter) ListCategories(ctx interface{}, activeOnly interface{}) *MockIReimbursementRepository_ListCategories_Call {
+	return &MockIReimbursementRepository_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx, activeOnly)}
+}
+
+func (_c *MockIReimbursementRepository_ListCategories_Call) Run(run func(ctx context.Context, activeOnly bool)) *MockIReimbursementRepository_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_ListCategories_Call) Return(_a0 []reimbursement.ReimbursementCategory, _a1 error) *MockIReimbursementRepository_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_ListCategories_Call) RunAndReturn(run func(context.Context, bool) ([]reimbursement.ReimbursementCategory, error)) *MockIReimbursementRepository_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDuplicates provides a mock function with given fields: ctx, req
+func (_m *MockIReimbursementRepository) ListDuplicates(ctx context.Context, req reimbursement.DuplicateReportRequest) (*reimbursement.DuplicateReportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDuplicates")
+	}
+
+	var r0 *reimbursement.DuplicateReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, reimbursement.DuplicateReportRequest) (*reimbursement.DuplicateReportResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, reimbursement.DuplicateReportRequest) *reimbursement.DuplicateReportResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reimbursement.DuplicateReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, reimbursement.DuplicateReportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIReimbursementRepository_ListDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDuplicates'
+type MockIReimbursementRepository_ListDuplicates_Call struct {
+	*mock.Call
+}
+
+// ListDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req reimbursement.DuplicateReportRequest
+func (_e *MockIReimbursementRepository_Expecter) ListDuplicates(ctx interface{}, req interface{}) *MockIReimbursementRepository_ListDuplicates_Call {
+	return &MockIReimbursementRepository_ListDuplicates_Call{Call: _e.mock.On("ListDuplicates", ctx, req)}
+}
+
+func (_c *MockIReimbursementRepository_ListDuplicates_Call) Run(run func(ctx context.Context, req reimbursement.DuplicateReportRequest)) *MockIReimbursementRepository_ListDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(reimbursement.DuplicateReportRequest))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_ListDuplicates_Call) Return(_a0 *reimbursement.DuplicateReportResponse, _a1 error) *MockIReimbursementRepository_ListDuplicates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_ListDuplicates_Call) RunAndReturn(run func(context.Context, reimbursement.DuplicateReportRequest) (*reimbursement.DuplicateReportResponse, error)) *MockIReimbursementRepository_ListDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPaid provides a mock function with given fields: ctx, ids, periodDetailID, paidAt
+func (_m *MockIReimbursementRepository) MarkPaid(ctx context.Context, ids []uint, periodDetailID uint, paidAt time.Time) error {
+	ret := _m.Called(ctx, ids, periodDetailID, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPaid")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint, uint, time.Time) error); ok {
+		r0 = rf(ctx, ids, periodDetailID, paidAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_MarkPaid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPaid'
+type MockIReimbursementRepository_MarkPaid_Call struct {
+	*mock.Call
+}
+
+// MarkPaid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint
+//   - periodDetailID uint
+//   - paidAt time.Time
+func (_e *MockIReimbursementRepository_Expecter) MarkPaid(ctx interface{}, ids interface{}, periodDetailID interface{}, paidAt interface{}) *MockIReimbursementRepository_MarkPaid_Call {
+	return &MockIReimbursementRepository_MarkPaid_Call{Call: _e.mock.On("MarkPaid", ctx, ids, periodDetailID, paidAt)}
+}
+
+func (_c *MockIReimbursementRepository_MarkPaid_Call) Run(run func(ctx context.Context, ids []uint, periodDetailID uint, paidAt time.Time)) *MockIReimbursementRepository_MarkPaid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint), args[2].(uint), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_MarkPaid_Call) Return(_a0 error) *MockIReimbursementRepository_MarkPaid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_MarkPaid_Call) RunAndReturn(run func(context.Context, []uint, uint, time.Time) error) *MockIReimbursementRepository_MarkPaid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPaidBySettlement provides a mock function with given fields: ctx, ids, terminationID, paidAt
+func (_m *MockIReimbursementRepository) MarkPaidBySettlement(ctx context.Context, ids []uint, terminationID uint, paidAt time.Time) error {
+	ret := _m.Called(ctx, ids, terminationID, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPaidBySettlement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint, uint, time.Time) error); ok {
+		r0 = rf(ctx, ids, terminationID, paidAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_MarkPaidBySettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPaidBySettlement'
+type MockIReimbursementRepository_MarkPaidBySettlement_Call struct {
+	*mock.Call
+}
+
+// MarkPaidBySettlement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint
+//   - terminationID uint
+//   - paidAt time.Time
+func (_e *MockIReimbursementRepository_Expecter) MarkPaidBySettlement(ctx interface{}, ids interface{}, terminationID interface{}, paidAt interface{}) *MockIReimbursementRepository_MarkPaidBySettlement_Call {
+	return &MockIReimbursementRepository_MarkPaidBySettlement_Call{Call: _e.mock.On("MarkPaidBySettlement", ctx, ids, terminationID, paidAt)}
+}
+
+func (_c *MockIReimbursementRepository_MarkPaidBySettlement_Call) Run(run func(ctx context.Context, ids []uint, terminationID uint, paidAt time.Time)) *MockIReimbursementRepository_MarkPaidBySettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint), args[2].(uint), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_MarkPaidBySettlement_Call) Return(_a0 error) *MockIReimbursementRepository_MarkPaidBySettlement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_MarkPaidBySettlement_Call) RunAndReturn(run func(context.Context, []uint, uint, time.Time) error) *MockIReimbursementRepository_MarkPaidBySettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPaidByPeriodID provides a mock function with given fields: ctx, periodID
+func (_m *MockIReimbursementRepository) ResetPaidByPeriodID(ctx context.Context, periodID uint) error {
+	ret := _m.Called(ctx, periodID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPaidByPeriodID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, periodID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_ResetPaidByPeriodID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPaidByPeriodID'
+type MockIReimbursementRepository_ResetPaidByPeriodID_Call struct {
+	*mock.Call
+}
+
+// ResetPaidByPeriodID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - periodID uint
+func (_e *MockIReimbursementRepository_Expecter) ResetPaidByPeriodID(ctx interface{}, periodID interface{}) *MockIReimbursementRepository_ResetPaidByPeriodID_Call {
+	return &MockIReimbursementRepository_ResetPaidByPeriodID_Call{Call: _e.mock.On("ResetPaidByPeriodID", ctx, periodID)}
+}
+
+func (_c *MockIReimbursementRepository_ResetPaidByPeriodID_Call) Run(run func(ctx context.Context, periodID uint)) *MockIReimbursementRepository_ResetPaidByPeriodID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_ResetPaidByPeriodID_Call) Return(_a0 error) *MockIReimbursementRepository_ResetPaidByPeriodID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_ResetPaidByPeriodID_Call) RunAndReturn(run func(context.Context, uint) error) *MockIReimbursementRepository_ResetPaidByPeriodID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function with given fields: ctx, id, updates
+func (_m *MockIReimbursementRepository) Review(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ret := _m.Called(ctx, id, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Review")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, map[string]interface{}) error); ok {
+		r0 = rf(ctx, id, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type MockIReimbursementRepository_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - updates map[string]interface{}
+func (_e *MockIReimbursementRepository_Expecter) Review(ctx interface{}, id interface{}, updates interface{}) *MockIReimbursementRepository_Review_Call {
+	return &MockIReimbursementRepository_Review_Call{Call: _e.mock.On("Review", ctx, id, updates)}
+}
+
+func (_c *MockIReimbursementRepository_Review_Call) Run(run func(ctx context.Context, id uint, updates map[string]interface{})) *MockIReimbursementRepository_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_Review_Call) Return(_a0 error) *MockIReimbursementRepository_Review_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_Review_Call) RunAndReturn(run func(context.Context, uint, map[string]interface{}) error) *MockIReimbursementRepository_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, updates
+func (_m *MockIReimbursementRepository) Update(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ret := _m.Called(ctx, id, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, map[string]interface{}) error); ok {
+		r0 = rf(ctx, id, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIReimbursementRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - updates map[string]interface{}
+func (_e *MockIReimbursementRepository_Expecter) Update(ctx interface{}, id interface{}, updates interface{}) *MockIReimbursementRepository_Update_Call {
+	return &MockIReimbursementRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, updates)}
+}
+
+func (_c *MockIReimbursementRepository_Update_Call) Run(run func(ctx context.Context, id uint, updates map[string]interface{})) *MockIReimbursementRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_Update_Call) Return(_a0 error) *MockIReimbursementRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_Update_Call) RunAndReturn(run func(context.Context, uint, map[string]interface{}) error) *MockIReimbursementRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, id, updates
+func (_m *MockIReimbursementRepository) UpdateCategory(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ret := _m.Called(ctx, id, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, map[string]interface{}) error); ok {
+		r0 = rf(ctx, id, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIReimbursementRepository_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type MockIReimbursementRepository_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - updates map[string]interface{}
+func (_e *MockIReimbursementRepository_Expecter) UpdateCategory(ctx interface{}, id interface{}, updates interface{}) *MockIReimbursementRepository_UpdateCategory_Call {
+	return &MockIReimbursementRepository_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, id, updates)}
+}
+
+func (_c *MockIReimbursementRepository_UpdateCategory_Call) Run(run func(ctx context.Context, id uint, updates map[string]interface{})) *MockIReimbursementRepository_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_UpdateCategory_Call) Return(_a0 error) *MockIReimbursementRepository_UpdateCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIReimbursementRepository_UpdateCategory_Call) RunAndReturn(run func(context.Context, uint, map[string]interface{}) error) *MockIReimbursementRepository_UpdateCategory_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,9 +5,11 @@ package mocks
 import (
 	context "context"
 
+	user "github.com/riskykurniawan15/payrolls/models/user"
+
 	mock "github.com/stretchr/testify/mock"
 
-	user "github.com/riskykurniawan15/payrolls/models/user"
+	time "time"
 )
 
 // MockIUserRepository is an autogenerated mock type for the IUserRepository type
@@ -23,6 +25,204 @@ func (_m *MockIUserRepository) EXPECT() *MockIUserRepository_Expecter {
 	return &MockIUserRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountUnusedRecoveryCodes provides a mock function with given fields: ctx, userID
+func (_m *MockIUserRepository) CountUnusedRecoveryCodes(ctx context.Context, userID uint) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnusedRecoveryCodes")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_CountUnusedRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnusedRecoveryCodes'
+type MockIUserRepository_CountUnusedRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// CountUnusedRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *MockIUserRepository_Expecter) CountUnusedRecoveryCodes(ctx interface{}, userID interface{}) *MockIUserRepository_CountUnusedRecoveryCodes_Call {
+	return &MockIUserRepository_CountUnusedRecoveryCodes_Call{Call: _e.mock.On("CountUnusedRecoveryCodes", ctx, userID)}
+}
+
+func (_c *MockIUserRepository_CountUnusedRecoveryCodes_Call) Run(run func(ctx context.Context, userID uint)) *MockIUserRepository_CountUnusedRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_CountUnusedRecoveryCodes_Call) Return(_a0 int64, _a1 error) *MockIUserRepository_CountUnusedRecoveryCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_CountUnusedRecoveryCodes_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *MockIUserRepository_CountUnusedRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOIDCLoginState provides a mock function with given fields: ctx, state
+func (_m *MockIUserRepository) CreateOIDCLoginState(ctx context.Context, state *user.OIDCLoginState) error {
+	ret := _m.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOIDCLoginState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.OIDCLoginState) error); ok {
+		r0 = rf(ctx, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_CreateOIDCLoginState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOIDCLoginState'
+type MockIUserRepository_CreateOIDCLoginState_Call struct {
+	*mock.Call
+}
+
+// CreateOIDCLoginState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state *user.OIDCLoginState
+func (_e *MockIUserRepository_Expecter) CreateOIDCLoginState(ctx interface{}, state interface{}) *MockIUserRepository_CreateOIDCLoginState_Call {
+	return &MockIUserRepository_CreateOIDCLoginState_Call{Call: _e.mock.On("CreateOIDCLoginState", ctx, state)}
+}
+
+func (_c *MockIUserRepository_CreateOIDCLoginState_Call) Run(run func(ctx context.Context, state *user.OIDCLoginState)) *MockIUserRepository_CreateOIDCLoginState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.OIDCLoginState))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_CreateOIDCLoginState_Call) Return(_a0 error) *MockIUserRepository_CreateOIDCLoginState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_CreateOIDCLoginState_Call) RunAndReturn(run func(context.Context, *user.OIDCLoginState) error) *MockIUserRepository_CreateOIDCLoginState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePasswordResetToken provides a mock function with given fields: ctx, token
+func (_m *MockIUserRepository) CreatePasswordResetToken(ctx context.Context, token *user.PasswordResetToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePasswordResetToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.PasswordResetToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_CreatePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePasswordResetToken'
+type MockIUserRepository_CreatePasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// CreatePasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *user.PasswordResetToken
+func (_e *MockIUserRepository_Expecter) CreatePasswordResetToken(ctx interface{}, token interface{}) *MockIUserRepository_CreatePasswordResetToken_Call {
+	return &MockIUserRepository_CreatePasswordResetToken_Call{Call: _e.mock.On("CreatePasswordResetToken", ctx, token)}
+}
+
+func (_c *MockIUserRepository_CreatePasswordResetToken_Call) Run(run func(ctx context.Context, token *user.PasswordResetToken)) *MockIUserRepository_CreatePasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.PasswordResetToken))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_CreatePasswordResetToken_Call) Return(_a0 error) *MockIUserRepository_CreatePasswordResetToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_CreatePasswordResetToken_Call) RunAndReturn(run func(context.Context, *user.PasswordResetToken) error) *MockIUserRepository_CreatePasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTwoFactorChallenge provides a mock function with given fields: ctx, challenge
+func (_m *MockIUserRepository) CreateTwoFactorChallenge(ctx context.Context, challenge *user.TwoFactorChallenge) error {
+	ret := _m.Called(ctx, challenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTwoFactorChallenge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.TwoFactorChallenge) error); ok {
+		r0 = rf(ctx, challenge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_CreateTwoFactorChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTwoFactorChallenge'
+type MockIUserRepository_CreateTwoFactorChallenge_Call struct {
+	*mock.Call
+}
+
+// CreateTwoFactorChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challenge *user.TwoFactorChallenge
+func (_e *MockIUserRepository_Expecter) CreateTwoFactorChallenge(ctx interface{}, challenge interface{}) *MockIUserRepository_CreateTwoFactorChallenge_Call {
+	return &MockIUserRepository_CreateTwoFactorChallenge_Call{Call: _e.mock.On("CreateTwoFactorChallenge", ctx, challenge)}
+}
+
+func (_c *MockIUserRepository_CreateTwoFactorChallenge_Call) Run(run func(ctx context.Context, challenge *user.TwoFactorChallenge)) *MockIUserRepository_CreateTwoFactorChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.TwoFactorChallenge))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_CreateTwoFactorChallenge_Call) Return(_a0 error) *MockIUserRepository_CreateTwoFactorChallenge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_CreateTwoFactorChallenge_Call) RunAndReturn(run func(context.Context, *user.TwoFactorChallenge) error) *MockIUserRepository_CreateTwoFactorChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function with given fields: ctx, _a1
 func (_m *MockIUserRepository) CreateUser(ctx context.Context, _a1 user.User) (user.User, error) {
 	ret := _m.Called(ctx, _a1)
@@ -80,27 +280,76 @@ func (_c *MockIUserRepository_CreateUser_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// GetUserByID provides a mock function with given fields: ctx, id
-func (_m *MockIUserRepository) GetUserByID(ctx context.Context, id uint) (user.User, error) {
-	ret := _m.Called(ctx, id)
+// DeleteRecoveryCodes provides a mock function with given fields: ctx, userID
+func (_m *MockIUserRepository) DeleteRecoveryCodes(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByID")
+		panic("no return value specified for DeleteRecoveryCodes")
 	}
 
-	var r0 user.User
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_DeleteRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRecoveryCodes'
+type MockIUserRepository_DeleteRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// DeleteRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *MockIUserRepository_Expecter) DeleteRecoveryCodes(ctx interface{}, userID interface{}) *MockIUserRepository_DeleteRecoveryCodes_Call {
+	return &MockIUserRepository_DeleteRecoveryCodes_Call{Call: _e.mock.On("DeleteRecoveryCodes", ctx, userID)}
+}
+
+func (_c *MockIUserRepository_DeleteRecoveryCodes_Call) Run(run func(ctx context.Context, userID uint)) *MockIUserRepository_DeleteRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_DeleteRecoveryCodes_Call) Return(_a0 error) *MockIUserRepository_DeleteRecoveryCodes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_DeleteRecoveryCodes_Call) RunAndReturn(run func(context.Context, uint) error) *MockIUserRepository_DeleteRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEmployeeProfile provides a mock function with given fields: ctx, userID
+func (_m *MockIUserRepository) GetEmployeeProfile(ctx context.Context, userID uint) (*user.EmployeeProfile, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmployeeProfile")
+	}
+
+	var r0 *user.EmployeeProfile
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint) (user.User, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*user.EmployeeProfile, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint) user.User); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *user.EmployeeProfile); ok {
+		r0 = rf(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(user.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.EmployeeProfile)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -108,56 +357,58 @@ func (_m *MockIUserRepository) GetUserByID(ctx context.Context, id uint) (user.U
 	return r0, r1
 }
 
-// MockIUserRepository_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
-type MockIUserRepository_GetUserByID_Call struct {
+// MockIUserRepository_GetEmployeeProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployeeProfile'
+type MockIUserRepository_GetEmployeeProfile_Call struct {
 	*mock.Call
 }
 
-// GetUserByID is a helper method to define mock.On call
+// GetEmployeeProfile is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uint
-func (_e *MockIUserRepository_Expecter) GetUserByID(ctx interface{}, id interface{}) *MockIUserRepository_GetUserByID_Call {
-	return &MockIUserRepository_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+//   - userID uint
+func (_e *MockIUserRepository_Expecter) GetEmployeeProfile(ctx interface{}, userID interface{}) *MockIUserRepository_GetEmployeeProfile_Call {
+	return &MockIUserRepository_GetEmployeeProfile_Call{Call: _e.mock.On("GetEmployeeProfile", ctx, userID)}
 }
 
-func (_c *MockIUserRepository_GetUserByID_Call) Run(run func(ctx context.Context, id uint)) *MockIUserRepository_GetUserByID_Call {
+func (_c *MockIUserRepository_GetEmployeeProfile_Call) Run(run func(ctx context.Context, userID uint)) *MockIUserRepository_GetEmployeeProfile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *MockIUserRepository_GetUserByID_Call) Return(_a0 user.User, _a1 error) *MockIUserRepository_GetUserByID_Call {
+func (_c *MockIUserRepository_GetEmployeeProfile_Call) Return(_a0 *user.EmployeeProfile, _a1 error) *MockIUserRepository_GetEmployeeProfile_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockIUserRepository_GetUserByID_Call) RunAndReturn(run func(context.Context, uint) (user.User, error)) *MockIUserRepository_GetUserByID_Call {
+func (_c *MockIUserRepository_GetEmployeeProfile_Call) RunAndReturn(run func(context.Context, uint) (*user.EmployeeProfile, error)) *MockIUserRepository_GetEmployeeProfile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByUsername provides a mock function with given fields: ctx, username
-func (_m *MockIUserRepository) GetUserByUsername(ctx context.Context, username string) (user.User, error) {
-	ret := _m.Called(ctx, username)
+// GetEmployeeProfileByNumber provides a mock function with given fields: ctx, employeeNumber
+func (_m *MockIUserRepository) GetEmployeeProfileByNumber(ctx context.Context, employeeNumber string) (*user.EmployeeProfile, error) {
+	ret := _m.Called(ctx, employeeNumber)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByUsername")
+		panic("no return value specified for GetEmployeeProfileByNumber")
 	}
 
-	var r0 user.User
+	var r0 *user.EmployeeProfile
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (user.User, error)); ok {
-		return rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*user.EmployeeProfile, error)); ok {
+		return rf(ctx, employeeNumber)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) user.User); ok {
-		r0 = rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user.EmployeeProfile); ok {
+		r0 = rf(ctx, employeeNumber)
 	} else {
-		r0 = ret.Get(0).(user.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.EmployeeProfile)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, username)
+		r1 = rf(ctx, employeeNumber)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -165,31 +416,1183 @@ func (_m *MockIUserRepository) GetUserByUsername(ctx context.Context, username s
 	return r0, r1
 }
 
-// MockIUserRepository_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
-type MockIUserRepository_GetUserByUsername_Call struct {
+// MockIUserRepository_GetEmployeeProfileByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployeeProfileByNumber'
+type MockIUserRepository_GetEmployeeProfileByNumber_Call struct {
 	*mock.Call
 }
 
-// GetUserByUsername is a helper method to define mock.On call
+// GetEmployeeProfileByNumber is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockIUserRepository_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockIUserRepository_GetUserByUsername_Call {
-	return &MockIUserRepository_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+//   - employeeNumber string
+func (_e *MockIUserRepository_Expecter) GetEmployeeProfileByNumber(ctx interface{}, employeeNumber interface{}) *MockIUserRepository_GetEmployeeProfileByNumber_Call {
+	return &MockIUserRepository_GetEmployeeProfileByNumber_Call{Call: _e.mock.On("GetEmployeeProfileByNumber", ctx, employeeNumber)}
 }
 
-func (_c *MockIUserRepository_GetUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockIUserRepository_GetUserByUsername_Call {
+func (_c *MockIUserRepository_GetEmployeeProfileByNumber_Call) Run(run func(ctx context.Context, employeeNumber string)) *MockIUserRepository_GetEmployeeProfileByNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockIUserRepository_GetUserByUsername_Call) Return(_a0 user.User, _a1 error) *MockIUserRepository_GetUserByUsername_Call {
+func (_c *MockIUserRepository_GetEmployeeProfileByNumber_Call) Return(_a0 *user.EmployeeProfile, _a1 error) *MockIUserRepository_GetEmployeeProfileByNumber_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockIUserRepository_GetUserByUsername_Call) RunAndReturn(run func(context.Context, string) (user.User, error)) *MockIUserRepository_GetUserByUsername_Call {
+func (_c *MockIUserRepository_GetEmployeeProfileByNumber_Call) RunAndReturn(run func(context.Context, string) (*user.EmployeeProfile, error)) *MockIUserRepository_GetEmployeeProfileByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEmployees provides a mock function with given fields: ctx
+func (_m *MockIUserRepository) GetEmployees(ctx context.Context) ([]user.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmployees")
+	}
+
+	var r0 []user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]user.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []user.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetEmployees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmployees'
+type MockIUserRepository_GetEmployees_Call struct {
+	*mock.Call
+}
+
+// GetEmployees is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUserRepository_Expecter) GetEmployees(ctx interface{}) *MockIUserRepository_GetEmployees_Call {
+	return &MockIUserRepository_GetEmployees_Call{Call: _e.mock.On("GetEmployees", ctx)}
+}
+
+func (_c *MockIUserRepository_GetEmployees_Call) Run(run func(ctx context.Context)) *MockIUserRepository_GetEmployees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetEmployees_Call) Return(_a0 []user.User, _a1 error) *MockIUserRepository_GetEmployees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetEmployees_Call) RunAndReturn(run func(context.Context) ([]user.User, error)) *MockIUserRepository_GetEmployees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOIDCLoginStateByHash provides a mock function with given fields: ctx, stateHash
+func (_m *MockIUserRepository) GetOIDCLoginStateByHash(ctx context.Context, stateHash string) (*user.OIDCLoginState, error) {
+	ret := _m.Called(ctx, stateHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOIDCLoginStateByHash")
+	}
+
+	var r0 *user.OIDCLoginState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*user.OIDCLoginState, error)); ok {
+		return rf(ctx, stateHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user.OIDCLoginState); ok {
+		r0 = rf(ctx, stateHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.OIDCLoginState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, stateHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetOIDCLoginStateByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOIDCLoginStateByHash'
+type MockIUserRepository_GetOIDCLoginStateByHash_Call struct {
+	*mock.Call
+}
+
+// GetOIDCLoginStateByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stateHash string
+func (_e *MockIUserRepository_Expecter) GetOIDCLoginStateByHash(ctx interface{}, stateHash interface{}) *MockIUserRepository_GetOIDCLoginStateByHash_Call {
+	return &MockIUserRepository_GetOIDCLoginStateByHash_Call{Call: _e.mock.On("GetOIDCLoginStateByHash", ctx, stateHash)}
+}
+
+func (_c *MockIUserRepository_GetOIDCLoginStateByHash_Call) Run(run func(ctx context.Context, stateHash string)) *MockIUserRepository_GetOIDCLoginStateByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetOIDCLoginStateByHash_Call) Return(_a0 *user.OIDCLoginState, _a1 error) *MockIUserRepository_GetOIDCLoginStateByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetOIDCLoginStateByHash_Call) RunAndReturn(run func(context.Context, string) (*user.OIDCLoginState, error)) *MockIUserRepository_GetOIDCLoginStateByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPasswordResetTokenByHash provides a mock function with given fields: ctx, tokenHash
+func (_m *MockIUserRepository) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*user.PasswordResetToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPasswordResetTokenByHash")
+	}
+
+	var r0 *user.PasswordResetToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*user.PasswordResetToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user.PasswordResetToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.PasswordResetToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetPasswordResetTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPasswordResetTokenByHash'
+type MockIUserRepository_GetPasswordResetTokenByHash_Call struct {
+	*mock.Call
+}
+
+// GetPasswordResetTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockIUserRepository_Expecter) GetPasswordResetTokenByHash(ctx interface{}, tokenHash interface{}) *MockIUserRepository_GetPasswordResetTokenByHash_Call {
+	return &MockIUserRepository_GetPasswordResetTokenByHash_Call{Call: _e.mock.On("GetPasswordResetTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *MockIUserRepository_GetPasswordResetTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockIUserRepository_GetPasswordResetTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetPasswordResetTokenByHash_Call) Return(_a0 *user.PasswordResetToken, _a1 error) *MockIUserRepository_GetPasswordResetTokenByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetPasswordResetTokenByHash_Call) RunAndReturn(run func(context.Context, string) (*user.PasswordResetToken, error)) *MockIUserRepository_GetPasswordResetTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTwoFactorChallengeByHash provides a mock function with given fields: ctx, tokenHash
+func (_m *MockIUserRepository) GetTwoFactorChallengeByHash(ctx context.Context, tokenHash string) (*user.TwoFactorChallenge, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTwoFactorChallengeByHash")
+	}
+
+	var r0 *user.TwoFactorChallenge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*user.TwoFactorChallenge, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user.TwoFactorChallenge); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.TwoFactorChallenge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetTwoFactorChallengeByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTwoFactorChallengeByHash'
+type MockIUserRepository_GetTwoFactorChallengeByHash_Call struct {
+	*mock.Call
+}
+
+// GetTwoFactorChallengeByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockIUserRepository_Expecter) GetTwoFactorChallengeByHash(ctx interface{}, tokenHash interface{}) *MockIUserRepository_GetTwoFactorChallengeByHash_Call {
+	return &MockIUserRepository_GetTwoFactorChallengeByHash_Call{Call: _e.mock.On("GetTwoFactorChallengeByHash", ctx, tokenHash)}
+}
+
+func (_c *MockIUserRepository_GetTwoFactorChallengeByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockIUserRepository_GetTwoFactorChallengeByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetTwoFactorChallengeByHash_Call) Return(_a0 *user.TwoFactorChallenge, _a1 error) *MockIUserRepository_GetTwoFactorChallengeByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetTwoFactorChallengeByHash_Call) RunAndReturn(run func(context.Context, string) (*user.TwoFactorChallenge, error)) *MockIUserRepository_GetTwoFactorChallengeByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *MockIUserRepository) GetUserByEmail(ctx context.Context, email string) (user.User, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(user.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockIUserRepository_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockIUserRepository_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockIUserRepository_GetUserByEmail_Call {
+	return &MockIUserRepository_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *MockIUserRepository_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockIUserRepository_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetUserByEmail_Call) Return(_a0 user.User, _a1 error) *MockIUserRepository_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (user.User, error)) *MockIUserRepository_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: ctx, id
+func (_m *MockIUserRepository) GetUserByID(ctx context.Context, id uint) (user.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (user.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) user.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(user.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type MockIUserRepository_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserRepository_Expecter) GetUserByID(ctx interface{}, id interface{}) *MockIUserRepository_GetUserByID_Call {
+	return &MockIUserRepository_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+}
+
+func (_c *MockIUserRepository_GetUserByID_Call) Run(run func(ctx context.Context, id uint)) *MockIUserRepository_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetUserByID_Call) Return(_a0 user.User, _a1 error) *MockIUserRepository_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetUserByID_Call) RunAndReturn(run func(context.Context, uint) (user.User, error)) *MockIUserRepository_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByOIDCSubject provides a mock function with given fields: ctx, subject
+func (_m *MockIUserRepository) GetUserByOIDCSubject(ctx context.Context, subject string) (user.User, error) {
+	ret := _m.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByOIDCSubject")
+	}
+
+	var r0 user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.User, error)); ok {
+		return rf(ctx, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.User); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		r0 = ret.Get(0).(user.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetUserByOIDCSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByOIDCSubject'
+type MockIUserRepository_GetUserByOIDCSubject_Call struct {
+	*mock.Call
+}
+
+// GetUserByOIDCSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+func (_e *MockIUserRepository_Expecter) GetUserByOIDCSubject(ctx interface{}, subject interface{}) *MockIUserRepository_GetUserByOIDCSubject_Call {
+	return &MockIUserRepository_GetUserByOIDCSubject_Call{Call: _e.mock.On("GetUserByOIDCSubject", ctx, subject)}
+}
+
+func (_c *MockIUserRepository_GetUserByOIDCSubject_Call) Run(run func(ctx context.Context, subject string)) *MockIUserRepository_GetUserByOIDCSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetUserByOIDCSubject_Call) Return(_a0 user.User, _a1 error) *MockIUserRepository_GetUserByOIDCSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetUserByOIDCSubject_Call) RunAndReturn(run func(context.Context, string) (user.User, error)) *MockIUserRepository_GetUserByOIDCSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByUsername provides a mock function with given fields: ctx, username
+func (_m *MockIUserRepository) GetUserByUsername(ctx context.Context, username string) (user.User, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByUsername")
+	}
+
+	var r0 user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.User, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(user.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
+type MockIUserRepository_GetUserByUsername_Call struct {
+	*mock.Call
+}
+
+// GetUserByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockIUserRepository_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockIUserRepository_GetUserByUsername_Call {
+	return &MockIUserRepository_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+}
+
+func (_c *MockIUserRepository_GetUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockIUserRepository_GetUserByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_GetUserByUsername_Call) Return(_a0 user.User, _a1 error) *MockIUserRepository_GetUserByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_GetUserByUsername_Call) RunAndReturn(run func(context.Context, string) (user.User, error)) *MockIUserRepository_GetUserByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidatePasswordResetTokens provides a mock function with given fields: ctx, userID
+func (_m *MockIUserRepository) InvalidatePasswordResetTokens(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidatePasswordResetTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_InvalidatePasswordResetTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidatePasswordResetTokens'
+type MockIUserRepository_InvalidatePasswordResetTokens_Call struct {
+	*mock.Call
+}
+
+// InvalidatePasswordResetTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *MockIUserRepository_Expecter) InvalidatePasswordResetTokens(ctx interface{}, userID interface{}) *MockIUserRepository_InvalidatePasswordResetTokens_Call {
+	return &MockIUserRepository_InvalidatePasswordResetTokens_Call{Call: _e.mock.On("InvalidatePasswordResetTokens", ctx, userID)}
+}
+
+func (_c *MockIUserRepository_InvalidatePasswordResetTokens_Call) Run(run func(ctx context.Context, userID uint)) *MockIUserRepository_InvalidatePasswordResetTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_InvalidatePasswordResetTokens_Call) Return(_a0 error) *MockIUserRepository_InvalidatePasswordResetTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_InvalidatePasswordResetTokens_Call) RunAndReturn(run func(context.Context, uint) error) *MockIUserRepository_InvalidatePasswordResetTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsers provides a mock function with given fields: ctx, req
+func (_m *MockIUserRepository) ListUsers(ctx context.Context, req user.ListUsersRequest) (*user.ListUsersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 *user.ListUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.ListUsersRequest) (*user.ListUsersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, user.ListUsersRequest) *user.ListUsersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.ListUsersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, user.ListUsersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockIUserRepository_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req user.ListUsersRequest
+func (_e *MockIUserRepository_Expecter) ListUsers(ctx interface{}, req interface{}) *MockIUserRepository_ListUsers_Call {
+	return &MockIUserRepository_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, req)}
+}
+
+func (_c *MockIUserRepository_ListUsers_Call) Run(run func(ctx context.Context, req user.ListUsersRequest)) *MockIUserRepository_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.ListUsersRequest))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_ListUsers_Call) Return(_a0 *user.ListUsersResponse, _a1 error) *MockIUserRepository_ListUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_ListUsers_Call) RunAndReturn(run func(context.Context, user.ListUsersRequest) (*user.ListUsersResponse, error)) *MockIUserRepository_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailedLogin provides a mock function with given fields: ctx, id, since
+func (_m *MockIUserRepository) RecordFailedLogin(ctx context.Context, id uint, since time.Time) (int, error) {
+	ret := _m.Called(ctx, id, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailedLogin")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) (int, error)); ok {
+		return rf(ctx, id, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) int); ok {
+		r0 = rf(ctx, id, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time) error); ok {
+		r1 = rf(ctx, id, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_RecordFailedLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailedLogin'
+type MockIUserRepository_RecordFailedLogin_Call struct {
+	*mock.Call
+}
+
+// RecordFailedLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - since time.Time
+func (_e *MockIUserRepository_Expecter) RecordFailedLogin(ctx interface{}, id interface{}, since interface{}) *MockIUserRepository_RecordFailedLogin_Call {
+	return &MockIUserRepository_RecordFailedLogin_Call{Call: _e.mock.On("RecordFailedLogin", ctx, id, since)}
+}
+
+func (_c *MockIUserRepository_RecordFailedLogin_Call) Run(run func(ctx context.Context, id uint, since time.Time)) *MockIUserRepository_RecordFailedLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_RecordFailedLogin_Call) Return(_a0 int, _a1 error) *MockIUserRepository_RecordFailedLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_RecordFailedLogin_Call) RunAndReturn(run func(context.Context, uint, time.Time) (int, error)) *MockIUserRepository_RecordFailedLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordTwoFactorChallengeFailure provides a mock function with given fields: ctx, id
+func (_m *MockIUserRepository) RecordTwoFactorChallengeFailure(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTwoFactorChallengeFailure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_RecordTwoFactorChallengeFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordTwoFactorChallengeFailure'
+type MockIUserRepository_RecordTwoFactorChallengeFailure_Call struct {
+	*mock.Call
+}
+
+// RecordTwoFactorChallengeFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserRepository_Expecter) RecordTwoFactorChallengeFailure(ctx interface{}, id interface{}) *MockIUserRepository_RecordTwoFactorChallengeFailure_Call {
+	return &MockIUserRepository_RecordTwoFactorChallengeFailure_Call{Call: _e.mock.On("RecordTwoFactorChallengeFailure", ctx, id)}
+}
+
+func (_c *MockIUserRepository_RecordTwoFactorChallengeFailure_Call) Run(run func(ctx context.Context, id uint)) *MockIUserRepository_RecordTwoFactorChallengeFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_RecordTwoFactorChallengeFailure_Call) Return(_a0 error) *MockIUserRepository_RecordTwoFactorChallengeFailure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_RecordTwoFactorChallengeFailure_Call) RunAndReturn(run func(context.Context, uint) error) *MockIUserRepository_RecordTwoFactorChallengeFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceRecoveryCodes provides a mock function with given fields: ctx, userID, codeHashes
+func (_m *MockIUserRepository) ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error {
+	ret := _m.Called(ctx, userID, codeHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceRecoveryCodes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string) error); ok {
+		r0 = rf(ctx, userID, codeHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_ReplaceRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceRecoveryCodes'
+type MockIUserRepository_ReplaceRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// ReplaceRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - codeHashes []string
+func (_e *MockIUserRepository_Expecter) ReplaceRecoveryCodes(ctx interface{}, userID interface{}, codeHashes interface{}) *MockIUserRepository_ReplaceRecoveryCodes_Call {
+	return &MockIUserRepository_ReplaceRecoveryCodes_Call{Call: _e.mock.On("ReplaceRecoveryCodes", ctx, userID, codeHashes)}
+}
+
+func (_c *MockIUserRepository_ReplaceRecoveryCodes_Call) Run(run func(ctx context.Context, userID uint, codeHashes []string)) *MockIUserRepository_ReplaceRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_ReplaceRecoveryCodes_Call) Return(_a0 error) *MockIUserRepository_ReplaceRecoveryCodes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_ReplaceRecoveryCodes_Call) RunAndReturn(run func(context.Context, uint, []string) error) *MockIUserRepository_ReplaceRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveEmployeeProfile provides a mock function with given fields: ctx, profile
+func (_m *MockIUserRepository) SaveEmployeeProfile(ctx context.Context, profile *user.EmployeeProfile) error {
+	ret := _m.Called(ctx, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveEmployeeProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.EmployeeProfile) error); ok {
+		r0 = rf(ctx, profile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_SaveEmployeeProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveEmployeeProfile'
+type MockIUserRepository_SaveEmployeeProfile_Call struct {
+	*mock.Call
+}
+
+// SaveEmployeeProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profile *user.EmployeeProfile
+func (_e *MockIUserRepository_Expecter) SaveEmployeeProfile(ctx interface{}, profile interface{}) *MockIUserRepository_SaveEmployeeProfile_Call {
+	return &MockIUserRepository_SaveEmployeeProfile_Call{Call: _e.mock.On("SaveEmployeeProfile", ctx, profile)}
+}
+
+func (_c *MockIUserRepository_SaveEmployeeProfile_Call) Run(run func(ctx context.Context, profile *user.EmployeeProfile)) *MockIUserRepository_SaveEmployeeProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.EmployeeProfile))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_SaveEmployeeProfile_Call) Return(_a0 error) *MockIUserRepository_SaveEmployeeProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_SaveEmployeeProfile_Call) RunAndReturn(run func(context.Context, *user.EmployeeProfile) error) *MockIUserRepository_SaveEmployeeProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEmployeeProfile provides a mock function with given fields: ctx, userID, updates
+func (_m *MockIUserRepository) UpdateEmployeeProfile(ctx context.Context, userID uint, updates map[string]interface{}) error {
+	ret := _m.Called(ctx, userID, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmployeeProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, map[string]interface{}) error); ok {
+		r0 = rf(ctx, userID, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_UpdateEmployeeProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmployeeProfile'
+type MockIUserRepository_UpdateEmployeeProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateEmployeeProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - updates map[string]interface{}
+func (_e *MockIUserRepository_Expecter) UpdateEmployeeProfile(ctx interface{}, userID interface{}, updates interface{}) *MockIUserRepository_UpdateEmployeeProfile_Call {
+	return &MockIUserRepository_UpdateEmployeeProfile_Call{Call: _e.mock.On("UpdateEmployeeProfile", ctx, userID, updates)}
+}
+
+func (_c *MockIUserRepository_UpdateEmployeeProfile_Call) Run(run func(ctx context.Context, userID uint, updates map[string]interface{})) *MockIUserRepository_UpdateEmployeeProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_UpdateEmployeeProfile_Call) Return(_a0 error) *MockIUserRepository_UpdateEmployeeProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_UpdateEmployeeProfile_Call) RunAndReturn(run func(context.Context, uint, map[string]interface{}) error) *MockIUserRepository_UpdateEmployeeProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function with given fields: ctx, id, updates
+func (_m *MockIUserRepository) UpdateUser(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ret := _m.Called(ctx, id, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, map[string]interface{}) error); ok {
+		r0 = rf(ctx, id, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIUserRepository_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockIUserRepository_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - updates map[string]interface{}
+func (_e *MockIUserRepository_Expecter) UpdateUser(ctx interface{}, id interface{}, updates interface{}) *MockIUserRepository_UpdateUser_Call {
+	return &MockIUserRepository_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, id, updates)}
+}
+
+func (_c *MockIUserRepository_UpdateUser_Call) Run(run func(ctx context.Context, id uint, updates map[string]interface{})) *MockIUserRepository_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_UpdateUser_Call) Return(_a0 error) *MockIUserRepository_UpdateUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIUserRepository_UpdateUser_Call) RunAndReturn(run func(context.Context, uint, map[string]interface{}) error) *MockIUserRepository_UpdateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseOIDCLoginState provides a mock function with given fields: ctx, id
+func (_m *MockIUserRepository) UseOIDCLoginState(ctx context.Context, id uint) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseOIDCLoginState")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_UseOIDCLoginState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseOIDCLoginState'
+type MockIUserRepository_UseOIDCLoginState_Call struct {
+	*mock.Call
+}
+
+// UseOIDCLoginState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserRepository_Expecter) UseOIDCLoginState(ctx interface{}, id interface{}) *MockIUserRepository_UseOIDCLoginState_Call {
+	return &MockIUserRepository_UseOIDCLoginState_Call{Call: _e.mock.On("UseOIDCLoginState", ctx, id)}
+}
+
+func (_c *MockIUserRepository_UseOIDCLoginState_Call) Run(run func(ctx context.Context, id uint)) *MockIUserRepository_UseOIDCLoginState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_UseOIDCLoginState_Call) Return(_a0 bool, _a1 error) *MockIUserRepository_UseOIDCLoginState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_UseOIDCLoginState_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *MockIUserRepository_UseOIDCLoginState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UsePasswordResetToken provides a mock function with given fields: ctx, id
+func (_m *MockIUserRepository) UsePasswordResetToken(ctx context.Context, id uint) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UsePasswordResetToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_UsePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UsePasswordResetToken'
+type MockIUserRepository_UsePasswordResetToken_Call struct {
+	*mock.Call
+}
+
+// UsePasswordResetToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserRepository_Expecter) UsePasswordResetToken(ctx interface{}, id interface{}) *MockIUserRepository_UsePasswordResetToken_Call {
+	return &MockIUserRepository_UsePasswordResetToken_Call{Call: _e.mock.On("UsePasswordResetToken", ctx, id)}
+}
+
+func (_c *MockIUserRepository_UsePasswordResetToken_Call) Run(run func(ctx context.Context, id uint)) *MockIUserRepository_UsePasswordResetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_UsePasswordResetToken_Call) Return(_a0 bool, _a1 error) *MockIUserRepository_UsePasswordResetToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_UsePasswordResetToken_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *MockIUserRepository_UsePasswordResetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseRecoveryCode provides a mock function with given fields: ctx, userID, codeHash
+func (_m *MockIUserRepository) UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error) {
+	ret := _m.Called(ctx, userID, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseRecoveryCode")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) (bool, error)); ok {
+		return rf(ctx, userID, codeHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) bool); ok {
+		r0 = rf(ctx, userID, codeHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, userID, codeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_UseRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseRecoveryCode'
+type MockIUserRepository_UseRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// UseRecoveryCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - codeHash string
+func (_e *MockIUserRepository_Expecter) UseRecoveryCode(ctx interface{}, userID interface{}, codeHash interface{}) *MockIUserRepository_UseRecoveryCode_Call {
+	return &MockIUserRepository_UseRecoveryCode_Call{Call: _e.mock.On("UseRecoveryCode", ctx, userID, codeHash)}
+}
+
+func (_c *MockIUserRepository_UseRecoveryCode_Call) Run(run func(ctx context.Context, userID uint, codeHash string)) *MockIUserRepository_UseRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_UseRecoveryCode_Call) Return(_a0 bool, _a1 error) *MockIUserRepository_UseRecoveryCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_UseRecoveryCode_Call) RunAndReturn(run func(context.Context, uint, string) (bool, error)) *MockIUserRepository_UseRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseTwoFactorChallenge provides a mock function with given fields: ctx, id
+func (_m *MockIUserRepository) UseTwoFactorChallenge(ctx context.Context, id uint) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseTwoFactorChallenge")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_UseTwoFactorChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseTwoFactorChallenge'
+type MockIUserRepository_UseTwoFactorChallenge_Call struct {
+	*mock.Call
+}
+
+// UseTwoFactorChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockIUserRepository_Expecter) UseTwoFactorChallenge(ctx interface{}, id interface{}) *MockIUserRepository_UseTwoFactorChallenge_Call {
+	return &MockIUserRepository_UseTwoFactorChallenge_Call{Call: _e.mock.On("UseTwoFactorChallenge", ctx, id)}
+}
+
+func (_c *MockIUserRepository_UseTwoFactorChallenge_Call) Run(run func(ctx context.Context, id uint)) *MockIUserRepository_UseTwoFactorChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_UseTwoFactorChallenge_Call) Return(_a0 bool, _a1 error) *MockIUserRepository_UseTwoFactorChallenge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_UseTwoFactorChallenge_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *MockIUserRepository_UseTwoFactorChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseTwoFactorStep provides a mock function with given fields: ctx, id, step
+func (_m *MockIUserRepository) UseTwoFactorStep(ctx context.Context, id uint, step int64) (bool, error) {
+	ret := _m.Called(ctx, id, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseTwoFactorStep")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int64) (bool, error)); ok {
+		return rf(ctx, id, step)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int64) bool); ok {
+		r0 = rf(ctx, id, step)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, int64) error); ok {
+		r1 = rf(ctx, id, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIUserRepository_UseTwoFactorStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseTwoFactorStep'
+type MockIUserRepository_UseTwoFactorStep_Call struct {
+	*mock.Call
+}
+
+// UseTwoFactorStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - step int64
+func (_e *MockIUserRepository_Expecter) UseTwoFactorStep(ctx interface{}, id interface{}, step interface{}) *MockIUserRepository_UseTwoFactorStep_Call {
+	return &MockIUserRepository_UseTwoFactorStep_Call{Call: _e.mock.On("UseTwoFactorStep", ctx, id, step)}
+}
+
+func (_c *MockIUserRepository_UseTwoFactorStep_Call) Run(run func(ctx context.Context, id uint, step int64)) *MockIUserRepository_UseTwoFactorStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_UseTwoFactorStep_Call) Return(_a0 bool, _a1 error) *MockIUserRepository_UseTwoFactorStep_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIUserRepository_UseTwoFactorStep_Call) RunAndReturn(run func(context.Context, uint, int64) (bool, error)) *MockIUserRepository_UseTwoFactorStep_Call {
 	_c.Call.Return(run)
 	return _c
 }
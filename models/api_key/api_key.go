@@ -0,0 +1,61 @@
+package api_key
+
+import "time"
+
+type (
+	// APIKey lets an integration call the API without a user session, only the hash of the key is stored
+	APIKey struct {
+		ID         uint       `json:"id" gorm:"primaryKey"`
+		Name       string     `json:"name" gorm:"not null"`
+		Prefix     string     `json:"prefix" gorm:"uniqueIndex;not null"`
+		KeyHash    string     `json:"-" gorm:"uniqueIndex;not null"`
+		Scopes     []string   `json:"scopes" gorm:"-"`
+		ExpiresAt  *time.Time `json:"expires_at" gorm:"default:null"`
+		LastUsedAt *time.Time `json:"last_used_at" gorm:"default:null"`
+		LastUsedIP *string    `json:"last_used_ip" gorm:"default:null"`
+		RevokedAt  *time.Time `json:"revoked_at" gorm:"default:null"`
+		RevokedBy  *uint      `json:"revoked_by" gorm:"default:null"`
+		CreatedBy  uint       `json:"created_by" gorm:"not null"`
+		CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	}
+
+	// APIKeyPermission links a key to one of its scopes
+	APIKeyPermission struct {
+		APIKeyID     uint `json:"api_key_id" gorm:"column:api_key_id;primaryKey"`
+		PermissionID uint `json:"permission_id" gorm:"primaryKey"`
+	}
+
+	// CreateAPIKeyRequest for issuing a key, scopes are permission codes
+	CreateAPIKeyRequest struct {
+		Name      string     `json:"name" validate:"required,min=2,max=100"`
+		Scopes    []string   `json:"scopes" validate:"required,min=1,dive,required"`
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+
+	// CreateAPIKeyResponse holds the key itself, it is only shown once
+	CreateAPIKeyResponse struct {
+		APIKey
+		Key string `json:"key"`
+	}
+
+	// Identity is what an authenticated key acts as: the user who issued it, limited to the key's scopes
+	Identity struct {
+		APIKeyID    uint
+		Prefix      string
+		UserID      uint
+		Permissions []string
+	}
+)
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (APIKeyPermission) TableName() string {
+	return "api_key_permissions"
+}
+
+// IsActiveAt reports whether the key has not been revoked and has not expired at t
+func (k APIKey) IsActiveAt(t time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || t.Before(*k.ExpiresAt))
+}
@@ -1,6 +1,7 @@
 package attendance
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/riskykurniawan15/payrolls/utils/data_tipes"
@@ -9,17 +10,33 @@ import (
 type (
 	// AttendanceRequest represents the request for check-in
 	AttendanceRequest struct {
-		Date *data_tipes.CustomDateTime `json:"date,omitempty"` // Optional, if not provided use current time
+		Date      *data_tipes.CustomDateTime `json:"date,omitempty"` // Optional, if not provided use current time
+		Latitude  *float64                   `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+		Longitude *float64                   `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+		Accuracy  *float64                   `json:"accuracy,omitempty" validate:"omitempty,min=0"` // GPS accuracy radius in meters
+	}
+
+	// LocationInfo represents the device location captured on check-in or check-out
+	LocationInfo struct {
+		Latitude         *float64 `json:"latitude"`
+		Longitude        *float64 `json:"longitude"`
+		Accuracy         *float64 `json:"accuracy"`
+		OfficeLocationID *uint    `json:"office_location_id"`
+		OutsideGeofence  bool     `json:"outside_geofence"`
 	}
 
 	// AttendanceResponse represents the attendance response
 	AttendanceResponse struct {
-		ID           uint       `json:"id"`
-		UserID       uint       `json:"user_id"`
-		CheckInDate  time.Time  `json:"check_in_date"`
-		CheckOutDate *time.Time `json:"check_out_date"`
-		CreatedAt    time.Time  `json:"created_at"`
-		UpdatedAt    *time.Time `json:"updated_at"`
+		ID           uint          `json:"id"`
+		UserID       uint          `json:"user_id"`
+		CheckInDate  time.Time     `json:"check_in_date"`
+		CheckOutDate *time.Time    `json:"check_out_date"`
+		CheckIn      LocationInfo  `json:"check_in_location"`
+		CheckOut     *LocationInfo `json:"check_out_location"`
+		Source       string        `json:"source"`
+		AutoClosed   bool          `json:"auto_closed"`
+		CreatedAt    time.Time     `json:"created_at"`
+		UpdatedAt    *time.Time    `json:"updated_at"`
 	}
 
 	// AttendanceListResponse represents the list of attendances
@@ -28,6 +45,47 @@ type (
 		Pagination Pagination           `json:"pagination"`
 	}
 
+	// AdminAttendanceListRequest for listing attendances across employees
+	AdminAttendanceListRequest struct {
+		Page            int
+		Limit           int
+		UserID          uint
+		StartDate       *time.Time
+		EndDate         *time.Time
+		MissingCheckOut bool
+		Late            bool
+		WorkStartTime   string // default scheduled start of day, used when the employee has none
+		UserIDs         []uint // set for team reviews, nil lists every employee
+	}
+
+	// AdminAttendanceRequest for recording attendance on an employee's behalf
+	AdminAttendanceRequest struct {
+		UserID       uint                       `json:"user_id" validate:"required"`
+		CheckInDate  *data_tipes.CustomDateTime `json:"check_in_date" validate:"required"`
+		CheckOutDate *data_tipes.CustomDateTime `json:"check_out_date"`
+	}
+
+	// AdminUpdateAttendanceRequest for editing an employee's attendance
+	AdminUpdateAttendanceRequest struct {
+		CheckInDate  *data_tipes.CustomDateTime `json:"check_in_date" validate:"required"`
+		CheckOutDate *data_tipes.CustomDateTime `json:"check_out_date"`
+	}
+
+	// AdminAttendanceResponse represents an attendance with its employee for admins
+	AdminAttendanceResponse struct {
+		AttendanceResponse
+		Username  string `json:"username"`
+		Late      bool   `json:"late"`
+		CreatedBy uint   `json:"created_by"`
+		UpdatedBy *uint  `json:"updated_by"`
+	}
+
+	// AdminAttendanceListResponse represents the list of attendances across employees
+	AdminAttendanceListResponse struct {
+		Data       []AdminAttendanceResponse `json:"data"`
+		Pagination Pagination                `json:"pagination"`
+	}
+
 	// Pagination info
 	Pagination struct {
 		Page       int `json:"page"`
@@ -38,14 +96,34 @@ type (
 
 	// Attendance represents the attendance model
 	Attendance struct {
-		ID           uint       `json:"id" gorm:"primaryKey;column:id"`
-		UserID       uint       `json:"user_id" gorm:"column:user_id;not null"`
-		CheckInDate  time.Time  `json:"check_in_date" gorm:"column:check_in_date;not null"`
-		CheckOutDate *time.Time `json:"check_out_date,omitempty" gorm:"column:check_out_date"`
-		CreatedBy    uint       `json:"created_by" gorm:"column:created_by;not null"`
-		CreatedAt    time.Time  `json:"created_at" gorm:"column:created_at;autoCreateTime"`
-		UpdatedBy    *uint      `json:"updated_by,omitempty" gorm:"column:updated_by"`
-		UpdatedAt    *time.Time `json:"updated_at,omitempty" gorm:"autoUpdateTime:false"`
+		ID                       uint       `json:"id" gorm:"primaryKey;column:id"`
+		UserID                   uint       `json:"user_id" gorm:"column:user_id;not null"`
+		CheckInDate              time.Time  `json:"check_in_date" gorm:"column:check_in_date;not null"`
+		CheckOutDate             *time.Time `json:"check_out_date,omitempty" gorm:"column:check_out_date"`
+		CheckInLatitude          *float64   `json:"check_in_latitude,omitempty" gorm:"column:check_in_latitude"`
+		CheckInLongitude         *float64   `json:"check_in_longitude,omitempty" gorm:"column:check_in_longitude"`
+		CheckInAccuracy          *float64   `json:"check_in_accuracy,omitempty" gorm:"column:check_in_accuracy"`
+		CheckInOfficeLocationID  *uint      `json:"check_in_office_location_id,omitempty" gorm:"column:check_in_office_location_id"`
+		CheckInOutsideGeofence   bool       `json:"check_in_outside_geofence" gorm:"column:check_in_outside_geofence;not null;default:false"`
+		CheckOutLatitude         *float64   `json:"check_out_latitude,omitempty" gorm:"column:check_out_latitude"`
+		CheckOutLongitude        *float64   `json:"check_out_longitude,omitempty" gorm:"column:check_out_longitude"`
+		CheckOutAccuracy         *float64   `json:"check_out_accuracy,omitempty" gorm:"column:check_out_accuracy"`
+		CheckOutOfficeLocationID *uint      `json:"check_out_office_location_id,omitempty" gorm:"column:check_out_office_location_id"`
+		CheckOutOutsideGeofence  bool       `json:"check_out_outside_geofence" gorm:"column:check_out_outside_geofence;not null;default:false"`
+		Source                   string     `json:"source" gorm:"column:source;not null;default:manual"`
+		ImportID                 *uint      `json:"import_id,omitempty" gorm:"column:import_id"`
+		AutoClosed               bool       `json:"auto_closed" gorm:"column:auto_closed;not null;default:false"`
+		CreatedBy                uint       `json:"created_by" gorm:"column:created_by;not null"`
+		CreatedAt                time.Time  `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+		UpdatedBy                *uint      `json:"updated_by,omitempty" gorm:"column:updated_by"`
+		UpdatedAt                *time.Time `json:"updated_at,omitempty" gorm:"autoUpdateTime:false"`
+	}
+
+	// AttendanceWithUser is an attendance joined with its employee
+	AttendanceWithUser struct {
+		Attendance `gorm:"embedded"`
+		Username   string `gorm:"column:username"`
+		Late       bool   `gorm:"column:late"`
 	}
 )
 
@@ -66,3 +144,13 @@ func IsValidCheckOutTime(checkInDate, checkOutDate time.Time) bool {
 
 	return checkOutDate.After(checkInDate)
 }
+
+// ParseClock parses a time of day such as "17:00" or "17:00:00"
+func ParseClock(value string) (int, int, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Hour(), t.Minute(), nil
+		}
+	}
+	return 0, 0, fmt.Errorf("unsupported time of day %q", value)
+}
@@ -0,0 +1,90 @@
+package attendance_correction
+
+import (
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/utils/data_tipes"
+)
+
+type (
+	// AttendanceCorrection model, keeps the original attendance values for audit
+	AttendanceCorrection struct {
+		ID                    uint       `json:"id" gorm:"primaryKey"`
+		UserID                uint       `json:"user_id" gorm:"not null"`
+		AttendanceID          *uint      `json:"attendance_id" gorm:"default:null"`
+		OriginalCheckInDate   *time.Time `json:"original_check_in_date" gorm:"default:null"`
+		OriginalCheckOutDate  *time.Time `json:"original_check_out_date" gorm:"default:null"`
+		RequestedCheckInDate  time.Time  `json:"requested_check_in_date" gorm:"not null"`
+		RequestedCheckOutDate *time.Time `json:"requested_check_out_date" gorm:"default:null"`
+		Reason                string     `json:"reason" gorm:"not null"`
+		Status                string     `json:"status" gorm:"not null;default:pending"`
+		ReviewedBy            *uint      `json:"reviewed_by" gorm:"default:null"`
+		ReviewedAt            *time.Time `json:"reviewed_at" gorm:"default:null"`
+		ReviewComment         *string    `json:"review_comment" gorm:"default:null"`
+		CreatedBy             uint       `json:"created_by" gorm:"not null"`
+		CreatedAt             time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy             *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt             *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// CreateAttendanceCorrectionRequest for proposing new attendance times.
+	// Without attendance_id the correction applies to the day of check_in_date.
+	CreateAttendanceCorrectionRequest struct {
+		AttendanceID *uint                      `json:"attendance_id"`
+		CheckInDate  *data_tipes.CustomDateTime `json:"check_in_date" validate:"required"`
+		CheckOutDate *data_tipes.CustomDateTime `json:"check_out_date"`
+		Reason       string                     `json:"reason" validate:"required,max=500"`
+	}
+
+	// ReviewAttendanceCorrectionRequest for approving or rejecting a correction
+	ReviewAttendanceCorrectionRequest struct {
+		Comment string `json:"comment" validate:"max=500"`
+		UserIDs []uint `json:"-"` // set for team reviews, only corrections of these users can be reviewed
+	}
+
+	// AttendanceCorrectionResponse for API responses
+	AttendanceCorrectionResponse struct {
+		ID                    uint       `json:"id"`
+		UserID                uint       `json:"user_id"`
+		Username              string     `json:"username,omitempty"`
+		AttendanceID          *uint      `json:"attendance_id"`
+		OriginalCheckInDate   *time.Time `json:"original_check_in_date"`
+		OriginalCheckOutDate  *time.Time `json:"original_check_out_date"`
+		RequestedCheckInDate  time.Time  `json:"requested_check_in_date"`
+		RequestedCheckOutDate *time.Time `json:"requested_check_out_date"`
+		Reason                string     `json:"reason"`
+		Status                string     `json:"status"`
+		ReviewedBy            *uint      `json:"reviewed_by"`
+		ReviewedAt            *time.Time `json:"reviewed_at"`
+		ReviewComment         *string    `json:"review_comment"`
+		CreatedAt             time.Time  `json:"created_at"`
+		UpdatedAt             *time.Time `json:"updated_at"`
+	}
+
+	// ListAttendanceCorrectionsRequest for listing corrections with filters
+	ListAttendanceCorrectionsRequest struct {
+		Page    int    `json:"page" validate:"min=1"`
+		Limit   int    `json:"limit" validate:"min=1,max=100"`
+		UserID  uint   `json:"user_id"`
+		Status  string `json:"status" validate:"omitempty,oneof=pending approved rejected"`
+		UserIDs []uint `json:"-"` // set for team reviews, nil lists every employee
+	}
+
+	// ListAttendanceCorrectionsResponse for paginated response
+	ListAttendanceCorrectionsResponse struct {
+		Data       []AttendanceCorrectionResponse `json:"data"`
+		Pagination Pagination                     `json:"pagination"`
+	}
+
+	// Pagination info
+	Pagination struct {
+		Page       int `json:"page"`
+		Limit      int `json:"limit"`
+		Total      int `json:"total"`
+		TotalPages int `json:"total_pages"`
+	}
+)
+
+func (AttendanceCorrection) TableName() string {
+	return "attendance_corrections"
+}
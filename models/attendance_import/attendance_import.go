@@ -0,0 +1,116 @@
+package attendance_import
+
+import (
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/utils/punch_log"
+)
+
+type (
+	// DeviceUser maps a fingerprint terminal user ID to an application user
+	DeviceUser struct {
+		ID           uint       `json:"id" gorm:"primaryKey"`
+		DeviceUserID string     `json:"device_user_id" gorm:"not null"`
+		UserID       uint       `json:"user_id" gorm:"not null"`
+		CreatedBy    uint       `json:"created_by" gorm:"not null"`
+		CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy    *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt    *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// AttendanceImport is the history record of one punch log import
+	AttendanceImport struct {
+		ID            uint       `json:"id" gorm:"primaryKey"`
+		FileName      string     `json:"file_name" gorm:"not null"`
+		FileHash      string     `json:"file_hash" gorm:"not null"`
+		TotalPunches  int        `json:"total_punches"`
+		TotalDays     int        `json:"total_days"`
+		CreatedCount  int        `json:"created_count"`
+		UpdatedCount  int        `json:"updated_count"`
+		SkippedCount  int        `json:"skipped_count"`
+		ConflictCount int        `json:"conflict_count"`
+		ErrorCount    int        `json:"error_count"`
+		CreatedBy     uint       `json:"created_by" gorm:"not null"`
+		CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy     *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt     *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// ImportAttendanceRequest holds an uploaded punch log
+	ImportAttendanceRequest struct {
+		FileName string
+		Content  []byte
+		DryRun   bool
+	}
+
+	// ImportConflict describes a day that could not be imported as-is
+	ImportConflict struct {
+		DeviceUserID         string     `json:"device_user_id"`
+		UserID               uint       `json:"user_id"`
+		Date                 string     `json:"date"`
+		CheckIn              time.Time  `json:"check_in"`
+		CheckOut             *time.Time `json:"check_out"`
+		ExistingAttendanceID *uint      `json:"existing_attendance_id"`
+		Reason               string     `json:"reason"`
+	}
+
+	// ImportResult summarizes a punch log import
+	ImportResult struct {
+		ImportID            *uint                  `json:"import_id"`
+		PreviousImportID    *uint                  `json:"previous_import_id"`
+		DryRun              bool                   `json:"dry_run"`
+		FileName            string                 `json:"file_name"`
+		TotalPunches        int                    `json:"total_punches"`
+		TotalDays           int                    `json:"total_days"`
+		Created             int                    `json:"created"`
+		Updated             int                    `json:"updated"`
+		Skipped             int                    `json:"skipped"`
+		Conflicts           []ImportConflict       `json:"conflicts"`
+		UnmappedDeviceUsers []string               `json:"unmapped_device_users"`
+		ParseErrors         []punch_log.ParseError `json:"parse_errors"`
+	}
+
+	// ListAttendanceImportsResponse for paginated response
+	ListAttendanceImportsResponse struct {
+		Data       []AttendanceImport `json:"data"`
+		Pagination Pagination         `json:"pagination"`
+	}
+
+	// CreateDeviceUserRequest for mapping a device user ID
+	CreateDeviceUserRequest struct {
+		DeviceUserID string `json:"device_user_id" validate:"required,max=50"`
+		UserID       uint   `json:"user_id" validate:"required"`
+	}
+
+	// DeviceUserResponse for API responses
+	DeviceUserResponse struct {
+		ID           uint      `json:"id"`
+		DeviceUserID string    `json:"device_user_id"`
+		UserID       uint      `json:"user_id"`
+		Username     string    `json:"username"`
+		CreatedBy    uint      `json:"created_by"`
+		CreatedAt    time.Time `json:"created_at"`
+	}
+
+	// ListDeviceUsersResponse for paginated response
+	ListDeviceUsersResponse struct {
+		Data       []DeviceUserResponse `json:"data"`
+		Pagination Pagination           `json:"pagination"`
+	}
+
+	// Pagination info
+	Pagination struct {
+		Page       int `json:"page"`
+		Limit      int `json:"limit"`
+		Total      int `json:"total"`
+		TotalPages int `json:"total_pages"`
+	}
+)
+
+func (DeviceUser) TableName() string {
+	return "device_users"
+}
+
+func (AttendanceImport) TableName() string {
+	return "attendance_imports"
+}
@@ -10,6 +10,7 @@ type AuditTrail struct {
 	Method         string    `json:"method" db:"method"`
 	Path           string    `json:"path" db:"path"`
 	UserID         *uint     `json:"user_id" db:"user_id"`
+	APIKeyID       *uint     `json:"api_key_id" db:"api_key_id" gorm:"column:api_key_id"`
 	Payload        *string   `json:"payload" db:"payload"`
 	ResponseCode   int       `json:"response_code" db:"response_code"`
 	ErrorResponse  *string   `json:"error_response" db:"error_response"`
@@ -23,6 +24,7 @@ type CreateAuditTrailRequest struct {
 	Method         string  `json:"method" validate:"required"`
 	Path           string  `json:"path" validate:"required"`
 	UserID         *uint   `json:"user_id"`
+	APIKeyID       *uint   `json:"api_key_id"`
 	Payload        *string `json:"payload"`
 	ResponseCode   int     `json:"response_code" validate:"required"`
 	ErrorResponse  *string `json:"error_response"`
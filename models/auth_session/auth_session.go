@@ -0,0 +1,29 @@
+package auth_session
+
+import "time"
+
+type (
+	// AuthSession is one issued access/refresh token pair
+	AuthSession struct {
+		ID               uint       `json:"id" gorm:"primaryKey"`
+		UserID           uint       `json:"user_id" gorm:"not null"`
+		FamilyID         string     `json:"family_id" gorm:"not null"`
+		TokenID          string     `json:"token_id" gorm:"not null"`
+		RefreshTokenHash string     `json:"-" gorm:"not null"`
+		ExpiresAt        time.Time  `json:"expires_at" gorm:"not null"`
+		RevokedAt        *time.Time `json:"revoked_at" gorm:"default:null"`
+		RevokedReason    *string    `json:"revoked_reason" gorm:"default:null"`
+		IP               *string    `json:"ip" gorm:"default:null"`
+		UserAgent        *string    `json:"user_agent" gorm:"default:null"`
+		CreatedAt        time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	}
+)
+
+func (AuthSession) TableName() string {
+	return "auth_sessions"
+}
+
+// IsActiveAt checks if the session was not revoked and its refresh token has not expired
+func (s AuthSession) IsActiveAt(t time.Time) bool {
+	return s.RevokedAt == nil && t.Before(s.ExpiresAt)
+}
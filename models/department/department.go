@@ -0,0 +1,102 @@
+package department
+
+import "time"
+
+type (
+	// Department groups employees, users point to it through department_id
+	Department struct {
+		ID          uint       `json:"id" gorm:"primaryKey"`
+		Name        string     `json:"name" gorm:"not null"`
+		Description *string    `json:"description" gorm:"default:null"`
+		MemberCount int64      `json:"member_count" gorm:"->;-:migration"`
+		CreatedBy   *uint      `json:"created_by" gorm:"default:null"`
+		CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy   *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt   *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// CreateDepartmentRequest for creating a new department
+	CreateDepartmentRequest struct {
+		Name        string  `json:"name" validate:"required,min=2,max=100"`
+		Description *string `json:"description" validate:"omitempty,max=255"`
+	}
+
+	// UpdateDepartmentRequest changes a department, nil fields are left untouched
+	UpdateDepartmentRequest struct {
+		Name        *string `json:"name" validate:"omitempty,min=2,max=100"`
+		Description *string `json:"description" validate:"omitempty,max=255"`
+	}
+
+	// TeamMember is a user reporting to a manager, level 1 are the direct reports
+	TeamMember struct {
+		ID             uint    `json:"id"`
+		Username       string  `json:"username"`
+		FullName       *string `json:"full_name"`
+		DepartmentID   *uint   `json:"department_id"`
+		DepartmentName *string `json:"department_name"`
+		ManagerID      uint    `json:"manager_id"`
+		Level          int     `json:"level"`
+		Status         int8    `json:"status"`
+	}
+
+	// ListTeamMembersRequest lists the reports of the signed in manager
+	ListTeamMembersRequest struct {
+		DirectOnly bool `json:"direct_only"`
+	}
+
+	// TeamSummaryRequest selects the dates a team summary covers, defaults to the current month
+	TeamSummaryRequest struct {
+		StartDate string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+		EndDate   string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+	}
+
+	// TeamMemberSummary is the activity of one report between the summary dates,
+	// pending requests are counted regardless of their date
+	TeamMemberSummary struct {
+		UserID                uint    `json:"user_id"`
+		Username              string  `json:"username"`
+		AttendanceDays        int64   `json:"attendance_days"`
+		MissingCheckOuts      int64   `json:"missing_check_outs"`
+		ApprovedOvertimeHours float64 `json:"approved_overtime_hours"`
+		PendingOvertimes      int64   `json:"pending_overtimes"`
+		ApprovedReimbursement float64 `json:"approved_reimbursement"`
+		PendingReimbursements int64   `json:"pending_reimbursements"`
+		PendingCorrections    int64   `json:"pending_corrections"`
+	}
+
+	// TeamSummaryTotals adds up the member summaries
+	TeamSummaryTotals struct {
+		MemberCount           int     `json:"member_count"`
+		DirectReportCount     int     `json:"direct_report_count"`
+		AttendanceDays        int64   `json:"attendance_days"`
+		MissingCheckOuts      int64   `json:"missing_check_outs"`
+		ApprovedOvertimeHours float64 `json:"approved_overtime_hours"`
+		PendingOvertimes      int64   `json:"pending_overtimes"`
+		ApprovedReimbursement float64 `json:"approved_reimbursement"`
+		PendingReimbursements int64   `json:"pending_reimbursements"`
+		PendingCorrections    int64   `json:"pending_corrections"`
+	}
+
+	// TeamSummaryResponse for the team summary endpoint
+	TeamSummaryResponse struct {
+		StartDate string              `json:"start_date"`
+		EndDate   string              `json:"end_date"`
+		Totals    TeamSummaryTotals   `json:"totals"`
+		Members   []TeamMemberSummary `json:"members"`
+	}
+)
+
+func (Department) TableName() string {
+	return "departments"
+}
+
+// Add accumulates a member summary into the totals
+func (t *TeamSummaryTotals) Add(member TeamMemberSummary) {
+	t.AttendanceDays += member.AttendanceDays
+	t.MissingCheckOuts += member.MissingCheckOuts
+	t.ApprovedOvertimeHours += member.ApprovedOvertimeHours
+	t.PendingOvertimes += member.PendingOvertimes
+	t.ApprovedReimbursement += member.ApprovedReimbursement
+	t.PendingReimbursements += member.PendingReimbursements
+	t.PendingCorrections += member.PendingCorrections
+}
@@ -0,0 +1,45 @@
+package employee_import
+
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+)
+
+type (
+	// ImportEmployeesRequest holds an uploaded CSV or XLSX employee sheet
+	ImportEmployeesRequest struct {
+		FileName string
+		Content  []byte
+		DryRun   bool
+		// CanAssignRoles is set when the importer holds role:manage, without it the role column may not change a role
+		CanAssignRoles bool
+	}
+
+	// RowError explains why a row of the sheet cannot be imported, Row is the line number in the sheet
+	RowError struct {
+		Row     int    `json:"row"`
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+
+	// ImportedEmployee is the outcome of one row, the temporary password is only returned once for new users
+	ImportedEmployee struct {
+		Row               int    `json:"row"`
+		EmployeeNumber    string `json:"employee_number"`
+		Username          string `json:"username"`
+		UserID            *uint  `json:"user_id"`
+		Action            string `json:"action"`
+		TemporaryPassword string `json:"temporary_password,omitempty"`
+	}
+
+	// ImportResult summarizes an employee import, nothing is written when a row has errors
+	ImportResult struct {
+		DryRun    bool               `json:"dry_run"`
+		FileName  string             `json:"file_name"`
+		TotalRows int                `json:"total_rows"`
+		Created   int                `json:"created"`
+		Updated   int                `json:"updated"`
+		Employees []ImportedEmployee `json:"employees"`
+		Errors    []RowError         `json:"errors"`
+	}
+)
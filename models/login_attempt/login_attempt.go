@@ -0,0 +1,28 @@
+package login_attempt
+
+import "time"
+
+type (
+	// LoginAttempt is one call to /auth/login, successful or not
+	LoginAttempt struct {
+		ID        uint      `json:"id" gorm:"primaryKey"`
+		Username  string    `json:"username" gorm:"not null"`
+		UserID    *uint     `json:"user_id" gorm:"default:null"`
+		IP        string    `json:"ip" gorm:"not null"`
+		Success   bool      `json:"success"`
+		Reason    string    `json:"reason" gorm:"not null"`
+		UserAgent *string   `json:"user_agent" gorm:"default:null"`
+		CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	}
+
+	// FailureStats summarizes the failed attempts of an IP within a window
+	FailureStats struct {
+		Count int64      `json:"count"`
+		First *time.Time `json:"first"`
+		Last  *time.Time `json:"last"`
+	}
+)
+
+func (LoginAttempt) TableName() string {
+	return "login_attempts"
+}
@@ -0,0 +1,42 @@
+package notification
+
+import "time"
+
+type (
+	// Notification model
+	Notification struct {
+		ID        uint       `json:"id" gorm:"primaryKey"`
+		UserID    uint       `json:"user_id" gorm:"not null"`
+		Type      string     `json:"type" gorm:"not null"`
+		Title     string     `json:"title" gorm:"not null"`
+		Message   string     `json:"message" gorm:"not null"`
+		ReadAt    *time.Time `json:"read_at" gorm:"default:null"`
+		CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	}
+
+	// ListNotificationsRequest for listing notifications of a user
+	ListNotificationsRequest struct {
+		UserID     uint `json:"user_id"`
+		Page       int  `json:"page" validate:"min=1"`
+		Limit      int  `json:"limit" validate:"min=1,max=100"`
+		UnreadOnly bool `json:"unread_only"`
+	}
+
+	// ListNotificationsResponse for paginated response
+	ListNotificationsResponse struct {
+		Data       []Notification `json:"data"`
+		Pagination Pagination     `json:"pagination"`
+	}
+
+	// Pagination info
+	Pagination struct {
+		Page       int `json:"page"`
+		Limit      int `json:"limit"`
+		Total      int `json:"total"`
+		TotalPages int `json:"total_pages"`
+	}
+)
+
+func (Notification) TableName() string {
+	return "notifications"
+}
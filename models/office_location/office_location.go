@@ -0,0 +1,131 @@
+package office_location
+
+import (
+	"time"
+)
+
+type (
+	// OfficeLocation model
+	OfficeLocation struct {
+		ID           uint       `json:"id" gorm:"primaryKey"`
+		Name         string     `json:"name" gorm:"not null"`
+		Address      *string    `json:"address" gorm:"default:null"`
+		Latitude     float64    `json:"latitude" gorm:"type:decimal(10,7);not null"`
+		Longitude    float64    `json:"longitude" gorm:"type:decimal(10,7);not null"`
+		RadiusMeters int        `json:"radius_meters" gorm:"not null;default:100"`
+		Status       int8       `json:"status" gorm:"default:1"`
+		CreatedBy    uint       `json:"created_by" gorm:"not null"`
+		CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy    *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt    *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// GeofenceOverride allows a user to check in outside of every office geofence
+	GeofenceOverride struct {
+		ID         uint       `json:"id" gorm:"primaryKey"`
+		UserID     uint       `json:"user_id" gorm:"not null"`
+		Reason     *string    `json:"reason" gorm:"default:null"`
+		ValidUntil *time.Time `json:"valid_until" gorm:"default:null"`
+		CreatedBy  uint       `json:"created_by" gorm:"not null"`
+		CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy  *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt  *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// CreateOfficeLocationRequest for creating new office location
+	CreateOfficeLocationRequest struct {
+		Name         string   `json:"name" validate:"required,min=3,max=100"`
+		Address      *string  `json:"address" validate:"omitempty,max=500"`
+		Latitude     *float64 `json:"latitude" validate:"required,min=-90,max=90"`
+		Longitude    *float64 `json:"longitude" validate:"required,min=-180,max=180"`
+		RadiusMeters int      `json:"radius_meters" validate:"required,min=10,max=10000"`
+	}
+
+	// UpdateOfficeLocationRequest for updating office location
+	UpdateOfficeLocationRequest struct {
+		Name         *string  `json:"name" validate:"omitempty,min=3,max=100"`
+		Address      *string  `json:"address" validate:"omitempty,max=500"`
+		Latitude     *float64 `json:"latitude" validate:"omitempty,min=-90,max=90"`
+		Longitude    *float64 `json:"longitude" validate:"omitempty,min=-180,max=180"`
+		RadiusMeters *int     `json:"radius_meters" validate:"omitempty,min=10,max=10000"`
+		Status       *int8    `json:"status" validate:"omitempty,oneof=1 9"`
+	}
+
+	// OfficeLocationResponse for API responses
+	OfficeLocationResponse struct {
+		ID           uint       `json:"id"`
+		Name         string     `json:"name"`
+		Address      *string    `json:"address"`
+		Latitude     float64    `json:"latitude"`
+		Longitude    float64    `json:"longitude"`
+		RadiusMeters int        `json:"radius_meters"`
+		Status       int8       `json:"status"`
+		CreatedBy    uint       `json:"created_by"`
+		CreatedAt    time.Time  `json:"created_at"`
+		UpdatedBy    *uint      `json:"updated_by"`
+		UpdatedAt    *time.Time `json:"updated_at"`
+	}
+
+	// ListOfficeLocationsRequest for listing office locations with filters
+	ListOfficeLocationsRequest struct {
+		Page     int    `json:"page" validate:"min=1"`
+		Limit    int    `json:"limit" validate:"min=1,max=100"`
+		Search   string `json:"search"`
+		SortBy   string `json:"sort_by" validate:"omitempty,oneof=id name radius_meters created_at"`
+		SortDesc bool   `json:"sort_desc"`
+	}
+
+	// ListOfficeLocationsResponse for paginated response
+	ListOfficeLocationsResponse struct {
+		Data       []OfficeLocationResponse `json:"data"`
+		Pagination Pagination               `json:"pagination"`
+	}
+
+	// CreateGeofenceOverrideRequest for adding a user to the override list
+	CreateGeofenceOverrideRequest struct {
+		UserID     uint    `json:"user_id" validate:"required"`
+		Reason     *string `json:"reason" validate:"omitempty,max=255"`
+		ValidUntil *string `json:"valid_until" validate:"omitempty,datetime=2006-01-02"`
+	}
+
+	// GeofenceOverrideResponse for API responses
+	GeofenceOverrideResponse struct {
+		ID         uint       `json:"id"`
+		UserID     uint       `json:"user_id"`
+		Username   string     `json:"username"`
+		Reason     *string    `json:"reason"`
+		ValidUntil *time.Time `json:"valid_until"`
+		CreatedBy  uint       `json:"created_by"`
+		CreatedAt  time.Time  `json:"created_at"`
+	}
+
+	// ListGeofenceOverridesResponse for paginated response
+	ListGeofenceOverridesResponse struct {
+		Data       []GeofenceOverrideResponse `json:"data"`
+		Pagination Pagination                 `json:"pagination"`
+	}
+
+	// Pagination info
+	Pagination struct {
+		Page       int `json:"page"`
+		Limit      int `json:"limit"`
+		Total      int `json:"total"`
+		TotalPages int `json:"total_pages"`
+	}
+)
+
+func (OfficeLocation) TableName() string {
+	return "office_locations"
+}
+
+func (GeofenceOverride) TableName() string {
+	return "geofence_overrides"
+}
+
+// IsActiveAt checks if the override is still valid at the given time
+func (o GeofenceOverride) IsActiveAt(t time.Time) bool {
+	if o.ValidUntil == nil {
+		return true
+	}
+	return !t.After(*o.ValidUntil)
+}
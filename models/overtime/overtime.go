@@ -13,6 +13,14 @@ type (
 		UserID         uint       `json:"user_id" gorm:"not null"`
 		OvertimesDate  time.Time  `json:"overtimes_date" gorm:"not null"`
 		TotalHoursTime float64    `json:"total_hours_time" gorm:"type:decimal(5,2);not null;default:0.00"`
+		ActualHours    *float64   `json:"actual_hours" gorm:"type:decimal(5,2);default:null"`
+		Source         string     `json:"source" gorm:"not null;default:declared"`
+		Status         string     `json:"status" gorm:"not null;default:pending"`
+		ReviewedBy     *uint      `json:"reviewed_by" gorm:"default:null"`
+		ReviewedAt     *time.Time `json:"reviewed_at" gorm:"default:null"`
+		ReviewComment  *string    `json:"review_comment" gorm:"default:null"`
+		PeriodDetailID *uint      `json:"period_detail_id" gorm:"default:null"`
+		PaidAt         *time.Time `json:"paid_at" gorm:"default:null"`
 		CreatedBy      uint       `json:"created_by" gorm:"not null"`
 		CreatedAt      time.Time  `json:"created_at" gorm:"autoCreateTime"`
 		UpdatedBy      *uint      `json:"updated_by" gorm:"default:null"`
@@ -31,12 +39,45 @@ type (
 		TotalHoursTime *float64               `json:"total_hours_time" validate:"omitempty,min=0.01,max=3.00"`
 	}
 
+	// ConfirmOvertimeProposalRequest for accepting a proposal, total_hours_time adjusts the proposed hours
+	ConfirmOvertimeProposalRequest struct {
+		OvertimesDate  *data_tipes.CustomDate `json:"overtimes_date" validate:"required"`
+		TotalHoursTime *float64               `json:"total_hours_time" validate:"omitempty,min=0.01,max=3.00"`
+	}
+
+	// ReviewOvertimeRequest for approving or rejecting an overtime
+	ReviewOvertimeRequest struct {
+		Comment string `json:"comment" validate:"max=500"`
+		UserIDs []uint `json:"-"` // set for team reviews, only overtimes of these users can be reviewed
+	}
+
+	// OvertimeProposal is overtime derived from time worked beyond the scheduled end
+	OvertimeProposal struct {
+		OvertimesDate string     `json:"overtimes_date"`
+		AttendanceID  uint       `json:"attendance_id"`
+		CheckInDate   time.Time  `json:"check_in_date"`
+		CheckOutDate  *time.Time `json:"check_out_date"`
+		ScheduledEnd  *time.Time `json:"scheduled_end"`
+		ActualHours   float64    `json:"actual_hours"`
+		ProposedHours float64    `json:"proposed_hours"`
+	}
+
 	// OvertimeResponse for API responses
 	OvertimeResponse struct {
 		ID             uint       `json:"id"`
 		UserID         uint       `json:"user_id"`
+		Username       string     `json:"username,omitempty"`
 		OvertimesDate  time.Time  `json:"overtimes_date"`
 		TotalHoursTime float64    `json:"total_hours_time"`
+		ActualHours    *float64   `json:"actual_hours"`
+		HoursGap       *float64   `json:"hours_gap,omitempty"`
+		Source         string     `json:"source"`
+		Status         string     `json:"status"`
+		ReviewedBy     *uint      `json:"reviewed_by"`
+		ReviewedAt     *time.Time `json:"reviewed_at"`
+		ReviewComment  *string    `json:"review_comment"`
+		PeriodDetailID *uint      `json:"period_detail_id"`
+		PaidAt         *time.Time `json:"paid_at"`
 		CreatedBy      uint       `json:"created_by"`
 		CreatedAt      time.Time  `json:"created_at"`
 		UpdatedBy      *uint      `json:"updated_by"`
@@ -53,10 +94,33 @@ type (
 		SortDesc  bool    `json:"sort_desc"`
 	}
 
+	// ListAllOvertimesRequest for reviewing overtimes of every employee
+	ListAllOvertimesRequest struct {
+		Page      int     `json:"page" validate:"min=1"`
+		Limit     int     `json:"limit" validate:"min=1,max=100"`
+		UserID    uint    `json:"user_id"`
+		StartDate *string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+		EndDate   *string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+		Status    string  `json:"status" validate:"omitempty,oneof=pending approved rejected"`
+		GapOnly   bool    `json:"gap_only"`
+		UserIDs   []uint  `json:"-"` // set for team reviews, nil lists every employee
+	}
+
 	// ListOvertimesResponse for paginated response
 	ListOvertimesResponse struct {
 		Data       []OvertimeResponse `json:"data"`
 		Pagination Pagination         `json:"pagination"`
+		Allowance  *OvertimeAllowance `json:"allowance,omitempty"`
+	}
+
+	// OvertimeAllowance is the remaining overtime of the rolling windows ending today, a nil cap is not enforced
+	OvertimeAllowance struct {
+		WeeklyCap        *float64 `json:"weekly_cap"`
+		WeeklyUsed       float64  `json:"weekly_used"`
+		WeeklyRemaining  *float64 `json:"weekly_remaining"`
+		MonthlyCap       *float64 `json:"monthly_cap"`
+		MonthlyUsed      float64  `json:"monthly_used"`
+		MonthlyRemaining *float64 `json:"monthly_remaining"`
 	}
 
 	// Pagination info
@@ -46,6 +46,7 @@ type (
 	PayslipData struct {
 		CompanyName        string              `json:"company_name"`
 		EmployeeName       string              `json:"employee_name"`
+		EmployeeNumber     string              `json:"employee_number"`
 		PeriodName         string              `json:"period_name"`
 		StartDate          time.Time           `json:"start_date"`
 		EndDate            time.Time           `json:"end_date"`
@@ -95,10 +96,44 @@ type (
 		GeneratedAt      time.Time                `json:"generated_at"`
 	}
 
+	// SettlementPayslipData for the final settlement HTML template
+	SettlementPayslipData struct {
+		CompanyName        string              `json:"company_name"`
+		EmployeeName       string              `json:"employee_name"`
+		EmployeeNumber     string              `json:"employee_number"`
+		Reason             string              `json:"reason"`
+		JoinDate           *time.Time          `json:"join_date"`
+		LastWorkingDay     time.Time           `json:"last_working_day"`
+		ServiceMonths      int                 `json:"service_months"`
+		StartDate          *time.Time          `json:"start_date"`
+		TotalWorking       int                 `json:"total_working"`
+		DailyRate          float64             `json:"daily_rate"`
+		MonthlyWage        float64             `json:"monthly_wage"`
+		ProratedSalary     float64             `json:"prorated_salary"`
+		OvertimeDetails    []OvertimeData      `json:"overtime_details"`
+		TotalOvertime      float64             `json:"total_overtime"`
+		Reimbursements     []ReimbursementData `json:"reimbursements"`
+		TotalReimbursement float64             `json:"total_reimbursement"`
+		UnusedLeaveDays    float64             `json:"unused_leave_days"`
+		LeaveEncashment    float64             `json:"leave_encashment"`
+		SeveranceMonths    float64             `json:"severance_months"`
+		SeverancePay       float64             `json:"severance_pay"`
+		ServiceAwardMonths float64             `json:"service_award_months"`
+		ServiceAwardPay    float64             `json:"service_award_pay"`
+		CompensationMonths float64             `json:"compensation_months"`
+		CompensationPay    float64             `json:"compensation_pay"`
+		SeparationPay      float64             `json:"separation_pay"`
+		LoanDeduction      float64             `json:"loan_deduction"`
+		TakeHomePay        float64             `json:"take_home_pay"`
+		SettledAt          *time.Time          `json:"settled_at"`
+		GeneratedAt        time.Time           `json:"generated_at"`
+	}
+
 	// PayslipSummaryEmployee for summary
 	PayslipSummaryEmployee struct {
-		No           int     `json:"no"`
-		EmployeeName string  `json:"employee_name"`
-		TakeHomePay  float64 `json:"take_home_pay"`
+		No             int     `json:"no"`
+		EmployeeName   string  `json:"employee_name"`
+		EmployeeNumber string  `json:"employee_number"`
+		TakeHomePay    float64 `json:"take_home_pay"`
 	}
 )
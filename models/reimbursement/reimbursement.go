@@ -9,20 +9,132 @@ import (
 type (
 	// Reimbursement model
 	Reimbursement struct {
-		ID          uint       `json:"id" gorm:"primaryKey"`
-		UserID      uint       `json:"user_id" gorm:"not null"`
-		Title       string     `json:"title" gorm:"not null"`
-		Date        time.Time  `json:"date" gorm:"not null"`
-		Amount      float64    `json:"amount" gorm:"type:decimal(10,2);not null"`
-		Description *string    `json:"description" gorm:"default:null"`
-		CreatedBy   uint       `json:"created_by" gorm:"not null"`
-		CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
-		UpdatedBy   *uint      `json:"updated_by" gorm:"default:null"`
-		UpdatedAt   *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+		ID                 uint       `json:"id" gorm:"primaryKey"`
+		UserID             uint       `json:"user_id" gorm:"not null"`
+		CategoryID         *uint      `json:"category_id" gorm:"default:null"`
+		Title              string     `json:"title" gorm:"not null"`
+		Date               time.Time  `json:"date" gorm:"not null"`
+		Amount             float64    `json:"amount" gorm:"type:decimal(10,2);not null"`
+		Description        *string    `json:"description" gorm:"default:null"`
+		Status             string     `json:"status" gorm:"not null;default:submitted"`
+		ApprovedAmount     *float64   `json:"approved_amount" gorm:"type:decimal(10,2);default:null"`
+		ReviewedBy         *uint      `json:"reviewed_by" gorm:"default:null"`
+		ReviewedAt         *time.Time `json:"reviewed_at" gorm:"default:null"`
+		ReviewComment      *string    `json:"review_comment" gorm:"default:null"`
+		PeriodDetailID     *uint      `json:"period_detail_id" gorm:"default:null"`
+		TerminationID      *uint      `json:"termination_id" gorm:"default:null"`
+		PaidAt             *time.Time `json:"paid_at" gorm:"default:null"`
+		SuspectedDuplicate bool       `json:"suspected_duplicate" gorm:"not null;default:false"`
+		CreatedBy          uint       `json:"created_by" gorm:"not null"`
+		CreatedAt          time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy          *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt          *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// ReimbursementCategory sets the policy limits of a claim type, a nil limit is unlimited.
+	// Eligible grades and employment types are comma separated, empty allows everyone.
+	ReimbursementCategory struct {
+		ID                      uint       `json:"id" gorm:"primaryKey"`
+		Code                    string     `json:"code" gorm:"uniqueIndex;not null"`
+		Name                    string     `json:"name" gorm:"not null"`
+		Description             *string    `json:"description" gorm:"default:null"`
+		PerClaimLimit           *float64   `json:"per_claim_limit" gorm:"type:decimal(15,2);default:null"`
+		MonthlyLimit            *float64   `json:"monthly_limit" gorm:"type:decimal(15,2);default:null"`
+		YearlyLimit             *float64   `json:"yearly_limit" gorm:"type:decimal(15,2);default:null"`
+		EligibleGrades          string     `json:"-" gorm:"not null;default:''"`
+		EligibleEmploymentTypes string     `json:"-" gorm:"not null;default:''"`
+		Status                  int8       `json:"status" gorm:"default:1"`
+		CreatedBy               uint       `json:"created_by" gorm:"not null"`
+		CreatedAt               time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy               *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt               *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// CreateCategoryRequest for creating a reimbursement category
+	CreateCategoryRequest struct {
+		Code                    string   `json:"code" validate:"required,min=2,max=50"`
+		Name                    string   `json:"name" validate:"required,min=3,max=100"`
+		Description             *string  `json:"description" validate:"omitempty,max=500"`
+		PerClaimLimit           *float64 `json:"per_claim_limit" validate:"omitempty,min=0.01"`
+		MonthlyLimit            *float64 `json:"monthly_limit" validate:"omitempty,min=0.01"`
+		YearlyLimit             *float64 `json:"yearly_limit" validate:"omitempty,min=0.01"`
+		EligibleGrades          []string `json:"eligible_grades" validate:"omitempty,dive,required,max=20"`
+		EligibleEmploymentTypes []string `json:"eligible_employment_types" validate:"omitempty,dive,oneof=permanent contract probation intern"`
+	}
+
+	// UpdateCategoryRequest for updating a reimbursement category, a limit of 0 removes it
+	UpdateCategoryRequest struct {
+		Name                    *string   `json:"name" validate:"omitempty,min=3,max=100"`
+		Description             *string   `json:"description" validate:"omitempty,max=500"`
+		PerClaimLimit           *float64  `json:"per_claim_limit" validate:"omitempty,min=0"`
+		MonthlyLimit            *float64  `json:"monthly_limit" validate:"omitempty,min=0"`
+		YearlyLimit             *float64  `json:"yearly_limit" validate:"omitempty,min=0"`
+		EligibleGrades          *[]string `json:"eligible_grades" validate:"omitempty,dive,required,max=20"`
+		EligibleEmploymentTypes *[]string `json:"eligible_employment_types" validate:"omitempty,dive,oneof=permanent contract probation intern"`
+		Status                  *int8     `json:"status" validate:"omitempty,oneof=1 9"`
+	}
+
+	// CategoryResponse for API responses
+	CategoryResponse struct {
+		ID                      uint       `json:"id"`
+		Code                    string     `json:"code"`
+		Name                    string     `json:"name"`
+		Description             *string    `json:"description"`
+		PerClaimLimit           *float64   `json:"per_claim_limit"`
+		MonthlyLimit            *float64   `json:"monthly_limit"`
+		YearlyLimit             *float64   `json:"yearly_limit"`
+		EligibleGrades          []string   `json:"eligible_grades"`
+		EligibleEmploymentTypes []string   `json:"eligible_employment_types"`
+		Status                  int8       `json:"status"`
+		CreatedBy               uint       `json:"created_by"`
+		CreatedAt               time.Time  `json:"created_at"`
+		UpdatedBy               *uint      `json:"updated_by"`
+		UpdatedAt               *time.Time `json:"updated_at"`
+	}
+
+	// CategoryBalance is what an employee can still claim in a category, nil means unlimited
+	CategoryBalance struct {
+		CategoryID       uint     `json:"category_id"`
+		Code             string   `json:"code"`
+		Name             string   `json:"name"`
+		PerClaimLimit    *float64 `json:"per_claim_limit"`
+		MonthlyLimit     *float64 `json:"monthly_limit"`
+		MonthlyUsed      float64  `json:"monthly_used"`
+		MonthlyRemaining *float64 `json:"monthly_remaining"`
+		YearlyLimit      *float64 `json:"yearly_limit"`
+		YearlyUsed       float64  `json:"yearly_used"`
+		YearlyRemaining  *float64 `json:"yearly_remaining"`
+	}
+
+	// ReimbursementAttachment is a receipt uploaded for a reimbursement
+	ReimbursementAttachment struct {
+		ID              uint      `json:"id" gorm:"primaryKey"`
+		ReimbursementID uint      `json:"reimbursement_id" gorm:"not null"`
+		FileName        string    `json:"file_name" gorm:"not null"`
+		ContentType     string    `json:"content_type" gorm:"not null"`
+		Size            int64     `json:"size" gorm:"not null"`
+		StorageKey      string    `json:"-" gorm:"not null"`
+		Checksum        string    `json:"checksum" gorm:"not null"`
+		CreatedBy       uint      `json:"created_by" gorm:"not null"`
+		CreatedAt       time.Time `json:"created_at" gorm:"autoCreateTime"`
+	}
+
+	// UploadAttachmentRequest holds an uploaded receipt
+	UploadAttachmentRequest struct {
+		FileName string
+		Content  []byte
+	}
+
+	// AttachmentFile is a receipt ready to be downloaded
+	AttachmentFile struct {
+		FileName    string
+		ContentType string
+		Content     []byte
 	}
 
 	// CreateReimbursementRequest for creating new reimbursement
 	CreateReimbursementRequest struct {
+		CategoryID  uint                   `json:"category_id" validate:"required"`
 		Title       string                 `json:"title" validate:"required,min=3,max=100"`
 		Date        *data_tipes.CustomDate `json:"date"`
 		Amount      float64                `json:"amount" validate:"required,min=0.01"`
@@ -31,24 +143,47 @@ type (
 
 	// UpdateReimbursementRequest for updating reimbursement
 	UpdateReimbursementRequest struct {
+		CategoryID  *uint                  `json:"category_id" validate:"omitempty,min=1"`
 		Title       *string                `json:"title" validate:"omitempty,min=3,max=100"`
 		Date        *data_tipes.CustomDate `json:"date"`
 		Amount      *float64               `json:"amount" validate:"omitempty,min=0.01"`
 		Description *string                `json:"description" validate:"omitempty,max=500"`
 	}
 
+	// ReviewReimbursementRequest for approving or rejecting a reimbursement,
+	// an approved amount below the claimed amount partially approves it
+	ReviewReimbursementRequest struct {
+		ApprovedAmount *float64 `json:"approved_amount" validate:"omitempty,min=0.01"`
+		Comment        string   `json:"comment" validate:"max=500"`
+		UserIDs        []uint   `json:"-"` // set for team reviews, only claims of these users can be reviewed
+	}
+
 	// ReimbursementResponse for API responses
 	ReimbursementResponse struct {
-		ID          uint       `json:"id"`
-		UserID      uint       `json:"user_id"`
-		Title       string     `json:"title"`
-		Date        time.Time  `json:"date"`
-		Amount      float64    `json:"amount"`
-		Description *string    `json:"description"`
-		CreatedBy   uint       `json:"created_by"`
-		CreatedAt   time.Time  `json:"created_at"`
-		UpdatedBy   *uint      `json:"updated_by"`
-		UpdatedAt   *time.Time `json:"updated_at"`
+		ID                 uint       `json:"id"`
+		UserID             uint       `json:"user_id"`
+		Username           string     `json:"username,omitempty"`
+		CategoryID         *uint      `json:"category_id"`
+		Title              string     `json:"title"`
+		Date               time.Time  `json:"date"`
+		Amount             float64    `json:"amount"`
+		Description        *string    `json:"description"`
+		Status             string     `json:"status"`
+		ApprovedAmount     *float64   `json:"approved_amount"`
+		ReviewedBy         *uint      `json:"reviewed_by"`
+		ReviewedAt         *time.Time `json:"reviewed_at"`
+		ReviewComment      *string    `json:"review_comment"`
+		PeriodDetailID     *uint      `json:"period_detail_id"`
+		TerminationID      *uint      `json:"termination_id"`
+		PaidAt             *time.Time `json:"paid_at"`
+		SuspectedDuplicate bool       `json:"suspected_duplicate"`
+		CreatedBy          uint       `json:"created_by"`
+		CreatedAt          time.Time  `json:"created_at"`
+		UpdatedBy          *uint      `json:"updated_by"`
+		UpdatedAt          *time.Time `json:"updated_at"`
+
+		Attachments []ReimbursementAttachment `json:"attachments,omitempty"`
+		Duplicates  []ReimbursementDuplicate  `json:"duplicates,omitempty"`
 	}
 
 	// ListReimbursementsRequest for listing reimbursements with filters
@@ -57,10 +192,69 @@ type (
 		Limit     int     `json:"limit" validate:"min=1,max=100"`
 		StartDate *string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
 		EndDate   *string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
-		SortBy    string  `json:"sort_by" validate:"omitempty,oneof=id user_id title date amount created_at"`
+		Status    string  `json:"status" validate:"omitempty,oneof=submitted approved partially_approved rejected paid"`
+		SortBy    string  `json:"sort_by" validate:"omitempty,oneof=id user_id title date amount status created_at"`
 		SortDesc  bool    `json:"sort_desc"`
 	}
 
+	// ListAllReimbursementsRequest for reviewing reimbursements of every employee
+	ListAllReimbursementsRequest struct {
+		Page               int     `json:"page" validate:"min=1"`
+		Limit              int     `json:"limit" validate:"min=1,max=100"`
+		UserID             uint    `json:"user_id"`
+		StartDate          *string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+		EndDate            *string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+		Status             string  `json:"status" validate:"omitempty,oneof=submitted approved partially_approved rejected paid"`
+		SuspectedDuplicate bool    `json:"suspected_duplicate"`
+		UserIDs            []uint  `json:"-"` // set for team reviews, nil lists every employee
+	}
+
+	// ReimbursementDuplicate links a claim to an earlier claim it may duplicate
+	ReimbursementDuplicate struct {
+		ID                     uint      `json:"id" gorm:"primaryKey"`
+		ReimbursementID        uint      `json:"reimbursement_id" gorm:"not null"`
+		MatchedReimbursementID uint      `json:"matched_reimbursement_id" gorm:"not null"`
+		Reason                 string    `json:"reason" gorm:"not null"`
+		TitleSimilarity        *float64  `json:"title_similarity" gorm:"type:decimal(5,4);default:null"`
+		CreatedAt              time.Time `json:"created_at" gorm:"autoCreateTime"`
+	}
+
+	// DuplicateReportRequest for listing suspected duplicates across employees
+	DuplicateReportRequest struct {
+		Page   int    `json:"page" validate:"min=1"`
+		Limit  int    `json:"limit" validate:"min=1,max=100"`
+		UserID uint   `json:"user_id"`
+		Reason string `json:"reason" validate:"omitempty,oneof=similar_claim same_receipt"`
+	}
+
+	// DuplicateReportItem is a suspected duplicate with both claims side by side
+	DuplicateReportItem struct {
+		ID                     uint      `json:"id"`
+		Reason                 string    `json:"reason"`
+		TitleSimilarity        *float64  `json:"title_similarity"`
+		CreatedAt              time.Time `json:"created_at"`
+		ReimbursementID        uint      `json:"reimbursement_id"`
+		UserID                 uint      `json:"user_id"`
+		Username               string    `json:"username"`
+		Title                  string    `json:"title"`
+		Date                   time.Time `json:"date"`
+		Amount                 float64   `json:"amount"`
+		Status                 string    `json:"status"`
+		MatchedReimbursementID uint      `json:"matched_reimbursement_id"`
+		MatchedUserID          uint      `json:"matched_user_id"`
+		MatchedUsername        string    `json:"matched_username"`
+		MatchedTitle           string    `json:"matched_title"`
+		MatchedDate            time.Time `json:"matched_date"`
+		MatchedAmount          float64   `json:"matched_amount"`
+		MatchedStatus          string    `json:"matched_status"`
+	}
+
+	// DuplicateReportResponse for paginated response
+	DuplicateReportResponse struct {
+		Data       []DuplicateReportItem `json:"data"`
+		Pagination Pagination            `json:"pagination"`
+	}
+
 	// ListReimbursementsResponse for paginated response
 	ListReimbursementsResponse struct {
 		Data       []ReimbursementResponse `json:"data"`
@@ -79,3 +273,15 @@ type (
 func (Reimbursement) TableName() string {
 	return "reimbursements"
 }
+
+func (ReimbursementAttachment) TableName() string {
+	return "reimbursement_attachments"
+}
+
+func (ReimbursementCategory) TableName() string {
+	return "reimbursement_categories"
+}
+
+func (ReimbursementDuplicate) TableName() string {
+	return "reimbursement_duplicates"
+}
@@ -0,0 +1,92 @@
+package role
+
+import (
+	"time"
+)
+
+type (
+	// Permission model, the code is what routes are protected by
+	Permission struct {
+		ID          uint      `json:"id" gorm:"primaryKey"`
+		Code        string    `json:"code" gorm:"uniqueIndex;not null"`
+		Description *string   `json:"description" gorm:"default:null"`
+		CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+	}
+
+	// Role model
+	Role struct {
+		ID          uint    `json:"id" gorm:"primaryKey"`
+		Name        string  `json:"name" gorm:"uniqueIndex;not null"`
+		Description *string `json:"description" gorm:"default:null"`
+		IsSystem    bool    `json:"is_system" gorm:"not null;default:false"`
+		// RequiresTwoFactor makes two-factor authentication mandatory for every holder of the role
+		RequiresTwoFactor bool       `json:"requires_two_factor" gorm:"not null;default:false"`
+		Permissions       []string   `json:"permissions" gorm:"-"`
+		CreatedBy         *uint      `json:"created_by" gorm:"default:null"`
+		CreatedAt         time.Time  `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy         *uint      `json:"updated_by" gorm:"default:null"`
+		UpdatedAt         *time.Time `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// RolePermission links a role to one of its permissions
+	RolePermission struct {
+		RoleID       uint `json:"role_id" gorm:"primaryKey"`
+		PermissionID uint `json:"permission_id" gorm:"primaryKey"`
+	}
+
+	// UserRole links a user to one of the roles they hold
+	UserRole struct {
+		UserID    uint      `json:"user_id" gorm:"primaryKey"`
+		RoleID    uint      `json:"role_id" gorm:"primaryKey"`
+		CreatedBy *uint     `json:"created_by" gorm:"default:null"`
+		CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	}
+
+	// CreateRoleRequest for creating a new role
+	CreateRoleRequest struct {
+		Name              string   `json:"name" validate:"required,min=2,max=50"`
+		Description       *string  `json:"description" validate:"omitempty,max=255"`
+		RequiresTwoFactor bool     `json:"requires_two_factor"`
+		Permissions       []string `json:"permissions" validate:"required,min=1,dive,required"`
+	}
+
+	// UpdateRoleRequest for updating a role, permissions replace the current set when given
+	UpdateRoleRequest struct {
+		Name              *string  `json:"name" validate:"omitempty,min=2,max=50"`
+		Description       *string  `json:"description" validate:"omitempty,max=255"`
+		RequiresTwoFactor *bool    `json:"requires_two_factor"`
+		Permissions       []string `json:"permissions" validate:"omitempty,min=1,dive,required"`
+	}
+
+	// SetUserRolesRequest replaces the additional roles a user holds, the system role is kept
+	SetUserRolesRequest struct {
+		RoleIDs []uint `json:"role_ids" validate:"required,dive,required"`
+	}
+
+	// UserRolesResponse lists the roles a user holds and the permissions they grant
+	UserRolesResponse struct {
+		UserID      uint     `json:"user_id"`
+		Roles       []Role   `json:"roles"`
+		Permissions []string `json:"permissions"`
+	}
+)
+
+// TableName specifies the table name for Permission
+func (Permission) TableName() string {
+	return "permissions"
+}
+
+// TableName specifies the table name for Role
+func (Role) TableName() string {
+	return "roles"
+}
+
+// TableName specifies the table name for RolePermission
+func (RolePermission) TableName() string {
+	return "role_permissions"
+}
+
+// TableName specifies the table name for UserRole
+func (UserRole) TableName() string {
+	return "user_roles"
+}
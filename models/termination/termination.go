@@ -0,0 +1,101 @@
+package termination
+
+import (
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/models/period_detail"
+)
+
+type (
+	// Termination records an employee leaving, the settlement columns are filled once the final pay is settled
+	Termination struct {
+		ID                  uint                `json:"id" gorm:"primaryKey"`
+		UserID              uint                `json:"user_id" gorm:"not null"`
+		Username            string              `json:"username" gorm:"->;-:migration"`
+		LastWorkingDay      time.Time           `json:"last_working_day" gorm:"type:date;not null"`
+		Reason              string              `json:"reason" gorm:"not null"`
+		Notes               *string             `json:"notes" gorm:"default:null"`
+		UnusedLeaveDays     float64             `json:"unused_leave_days" gorm:"type:decimal(5,1);not null;default:0"`
+		OutstandingLoan     float64             `json:"outstanding_loan" gorm:"type:decimal(15,2);not null;default:0.00"`
+		SeparationPay       float64             `json:"separation_pay" gorm:"type:decimal(15,2);not null;default:0.00"`
+		Status              string              `json:"status" gorm:"not null;default:scheduled"`
+		DeactivatedAt       *time.Time          `json:"deactivated_at" gorm:"default:null"`
+		SettlementStartDate *time.Time          `json:"settlement_start_date" gorm:"type:date;default:null"`
+		ServiceMonths       int                 `json:"service_months" gorm:"not null;default:0"`
+		MonthlyWage         float64             `json:"monthly_wage" gorm:"type:decimal(15,2);not null;default:0.00"`
+		DailyRate           float64             `json:"daily_rate" gorm:"type:decimal(15,2);not null;default:0.00"`
+		TotalWorking        int                 `json:"total_working" gorm:"not null;default:0"`
+		ProratedSalary      float64             `json:"prorated_salary" gorm:"type:decimal(15,2);not null;default:0.00"`
+		Overtime            *period_detail.JSON `json:"overtime" gorm:"type:jsonb"`
+		AmountOvertime      float64             `json:"amount_overtime" gorm:"type:decimal(15,2);not null;default:0.00"`
+		Reimbursement       *period_detail.JSON `json:"reimbursement" gorm:"type:jsonb"`
+		AmountReimbursement float64             `json:"amount_reimbursement" gorm:"type:decimal(15,2);not null;default:0.00"`
+		LeaveEncashment     float64             `json:"leave_encashment" gorm:"type:decimal(15,2);not null;default:0.00"`
+		SeveranceMonths     float64             `json:"severance_months" gorm:"type:decimal(5,2);not null;default:0"`
+		SeverancePay        float64             `json:"severance_pay" gorm:"type:decimal(15,2);not null;default:0.00"`
+		ServiceAwardMonths  float64             `json:"service_award_months" gorm:"type:decimal(5,2);not null;default:0"`
+		ServiceAwardPay     float64             `json:"service_award_pay" gorm:"type:decimal(15,2);not null;default:0.00"`
+		CompensationMonths  float64             `json:"compensation_months" gorm:"type:decimal(5,2);not null;default:0"`
+		CompensationPay     float64             `json:"compensation_pay" gorm:"type:decimal(15,2);not null;default:0.00"`
+		LoanDeduction       float64             `json:"loan_deduction" gorm:"type:decimal(15,2);not null;default:0.00"`
+		TakeHomePay         float64             `json:"take_home_pay" gorm:"type:decimal(15,2);not null;default:0.00"`
+		SettledBy           *uint               `json:"settled_by" gorm:"default:null"`
+		SettledAt           *time.Time          `json:"settled_at" gorm:"default:null"`
+		CreatedBy           uint                `json:"created_by" gorm:"not null"`
+		CreatedAt           time.Time           `json:"created_at" gorm:"autoCreateTime"`
+		UpdatedBy           *uint               `json:"updated_by" gorm:"default:null"`
+		UpdatedAt           *time.Time          `json:"updated_at" gorm:"autoUpdateTime:false"`
+	}
+
+	// CreateTerminationRequest schedules the departure of an employee. Leave days and the loan balance
+	// are entered by HR because leave and loans are tracked outside the payroll.
+	CreateTerminationRequest struct {
+		UserID          uint    `json:"user_id" validate:"required"`
+		LastWorkingDay  string  `json:"last_working_day" validate:"required,datetime=2006-01-02"`
+		Reason          string  `json:"reason" validate:"required,oneof=resignation contract_end merger takeover efficiency_loss efficiency closure_loss closure bankruptcy violation serious_violation absence detention long_illness retirement death"`
+		Notes           *string `json:"notes" validate:"omitempty,max=500"`
+		UnusedLeaveDays float64 `json:"unused_leave_days" validate:"min=0,max=365"`
+		OutstandingLoan float64 `json:"outstanding_loan" validate:"min=0"`
+		SeparationPay   float64 `json:"separation_pay" validate:"min=0"`
+	}
+
+	// UpdateTerminationRequest changes a scheduled termination, nil fields are left untouched
+	UpdateTerminationRequest struct {
+		LastWorkingDay  *string  `json:"last_working_day" validate:"omitempty,datetime=2006-01-02"`
+		Reason          *string  `json:"reason" validate:"omitempty,oneof=resignation contract_end merger takeover efficiency_loss efficiency closure_loss closure bankruptcy violation serious_violation absence detention long_illness retirement death"`
+		Notes           *string  `json:"notes" validate:"omitempty,max=500"`
+		UnusedLeaveDays *float64 `json:"unused_leave_days" validate:"omitempty,min=0,max=365"`
+		OutstandingLoan *float64 `json:"outstanding_loan" validate:"omitempty,min=0"`
+		SeparationPay   *float64 `json:"separation_pay" validate:"omitempty,min=0"`
+	}
+
+	// SettleTerminationRequest calculates the final settlement, a dry run returns it without saving
+	SettleTerminationRequest struct {
+		DryRun bool `json:"dry_run"`
+	}
+
+	// ListTerminationsRequest for listing terminations with filters
+	ListTerminationsRequest struct {
+		Page   int    `json:"page" validate:"min=1"`
+		Limit  int    `json:"limit" validate:"min=1,max=100"`
+		Status string `json:"status" validate:"omitempty,oneof=scheduled settled"`
+	}
+
+	// ListTerminationsResponse for paginated response
+	ListTerminationsResponse struct {
+		Data       []Termination `json:"data"`
+		Pagination Pagination    `json:"pagination"`
+	}
+
+	// Pagination info
+	Pagination struct {
+		Page       int `json:"page"`
+		Limit      int `json:"limit"`
+		Total      int `json:"total"`
+		TotalPages int `json:"total_pages"`
+	}
+)
+
+func (Termination) TableName() string {
+	return "terminations"
+}
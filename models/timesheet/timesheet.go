@@ -0,0 +1,50 @@
+package timesheet
+
+import "time"
+
+type (
+	// TimesheetRequest for requesting a monthly timesheet
+	TimesheetRequest struct {
+		Month  string `json:"month" validate:"required,datetime=2006-01"`
+		UserID uint   `json:"user_id"`
+		Format string `json:"format" validate:"omitempty,oneof=json csv xlsx"`
+	}
+
+	// TimesheetDay is a single calendar day of an employee
+	TimesheetDay struct {
+		Date          string     `json:"date"`
+		Weekday       string     `json:"weekday"`
+		Status        string     `json:"status"`
+		CheckInDate   *time.Time `json:"check_in_date"`
+		CheckOutDate  *time.Time `json:"check_out_date"`
+		HoursWorked   float64    `json:"hours_worked"`
+		OvertimeHours float64    `json:"overtime_hours"`
+	}
+
+	// TimesheetSummary totals of a monthly timesheet
+	TimesheetSummary struct {
+		PresentDays   int     `json:"present_days"`
+		AbsentDays    int     `json:"absent_days"`
+		LeaveDays     int     `json:"leave_days"`
+		HolidayDays   int     `json:"holiday_days"`
+		WeekendDays   int     `json:"weekend_days"`
+		HoursWorked   float64 `json:"hours_worked"`
+		OvertimeHours float64 `json:"overtime_hours"`
+	}
+
+	// Timesheet of one employee for one month
+	Timesheet struct {
+		UserID   uint             `json:"user_id"`
+		Username string           `json:"username"`
+		Month    string           `json:"month"`
+		Days     []TimesheetDay   `json:"days"`
+		Summary  TimesheetSummary `json:"summary"`
+	}
+
+	// TimesheetExport is a rendered timesheet file
+	TimesheetExport struct {
+		FileName    string
+		ContentType string
+		Content     []byte
+	}
+)
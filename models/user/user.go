@@ -4,20 +4,301 @@ import "time"
 
 type (
 	LoginRequest struct {
-		Username string `json:"username" validate:"required"`
-		Password string `json:"password" validate:"required"`
+		Username  string `json:"username" validate:"required"`
+		Password  string `json:"password" validate:"required"`
+		IP        string `json:"-"`
+		UserAgent string `json:"-"`
 	}
 
 	LoginResponse struct {
-		Token     string    `json:"token"`
-		ExpiresAt time.Time `json:"expires_at"`
-		User      UserInfo  `json:"user"`
+		Token            string    `json:"token"`
+		ExpiresAt        time.Time `json:"expires_at"`
+		RefreshToken     string    `json:"refresh_token"`
+		RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+		// MustChangePassword limits the token to POST /user/password until a new password is chosen
+		MustChangePassword bool `json:"must_change_password"`
+		// TwoFactorSetupRequired limits the token to the two-factor enrolment endpoints until it is enabled
+		TwoFactorSetupRequired bool     `json:"two_factor_setup_required"`
+		User                   UserInfo `json:"user"`
+		// TwoFactorChallenge is set instead of the tokens when the password was right but a second factor is needed
+		TwoFactorChallenge *TwoFactorChallengeResponse `json:"-"`
+	}
+
+	// TwoFactorChallengeResponse is exchanged for a session at POST /auth/2fa/verify together with a code
+	TwoFactorChallengeResponse struct {
+		TwoFactorRequired bool      `json:"two_factor_required"`
+		ChallengeToken    string    `json:"challenge_token"`
+		ExpiresAt         time.Time `json:"expires_at"`
+	}
+
+	// VerifyTwoFactorRequest completes a login with an authenticator or recovery code
+	VerifyTwoFactorRequest struct {
+		ChallengeToken string `json:"challenge_token" validate:"required"`
+		Code           string `json:"code" validate:"required"`
+		IP             string `json:"-"`
+		UserAgent      string `json:"-"`
+	}
+
+	// SetupTwoFactorRequest starts enrolment, the password is asked again before a new secret is issued
+	SetupTwoFactorRequest struct {
+		Password string `json:"password" validate:"required"`
+	}
+
+	// TwoFactorSetupResponse holds the secret to add to an authenticator app, provisioning_uri is meant for a QR code
+	TwoFactorSetupResponse struct {
+		Secret          string `json:"secret"`
+		ProvisioningURI string `json:"provisioning_uri"`
+	}
+
+	// TwoFactorCodeRequest carries a code from the authenticator app
+	TwoFactorCodeRequest struct {
+		Code string `json:"code" validate:"required,len=6,numeric"`
+	}
+
+	// DisableTwoFactorRequest turns two-factor authentication off, both factors are required
+	DisableTwoFactorRequest struct {
+		Password string `json:"password" validate:"required"`
+		Code     string `json:"code" validate:"required"`
+	}
+
+	// TwoFactorRecoveryCodesResponse lists recovery codes, they are only shown once
+	TwoFactorRecoveryCodesResponse struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+
+	// TwoFactorStatusResponse describes the two-factor state of the signed in user
+	TwoFactorStatusResponse struct {
+		Enabled                bool       `json:"enabled"`
+		EnabledAt              *time.Time `json:"enabled_at"`
+		Required               bool       `json:"required"`
+		RecoveryCodesRemaining int64      `json:"recovery_codes_remaining"`
+	}
+
+	// TwoFactorChallenge is issued once the password is verified, only its hash is stored
+	TwoFactorChallenge struct {
+		ID        uint       `json:"id" gorm:"column:id;primaryKey"`
+		UserID    uint       `json:"user_id" gorm:"column:user_id"`
+		TokenHash string     `json:"-" gorm:"column:token_hash"`
+		ExpiresAt time.Time  `json:"expires_at" gorm:"column:expires_at"`
+		Attempts  int        `json:"attempts" gorm:"column:attempts"`
+		UsedAt    *time.Time `json:"used_at" gorm:"column:used_at"`
+		IP        *string    `json:"ip" gorm:"column:ip"`
+		UserAgent *string    `json:"user_agent" gorm:"column:user_agent"`
+		CreatedAt time.Time  `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+	}
+
+	// TwoFactorRecoveryCode is a one-time code for when the authenticator is lost, only its hash is stored
+	TwoFactorRecoveryCode struct {
+		ID        uint       `json:"id" gorm:"column:id;primaryKey"`
+		UserID    uint       `json:"user_id" gorm:"column:user_id"`
+		CodeHash  string     `json:"-" gorm:"column:code_hash"`
+		UsedAt    *time.Time `json:"used_at" gorm:"column:used_at"`
+		CreatedAt time.Time  `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+	}
+
+	// OIDCLoginResponse points the browser to the provider, it is returned instead of a redirect to JSON clients
+	OIDCLoginResponse struct {
+		AuthorizationURL string    `json:"authorization_url"`
+		ExpiresAt        time.Time `json:"expires_at"`
+	}
+
+	// OIDCCallbackRequest carries the parameters the provider appends to the redirect URL
+	OIDCCallbackRequest struct {
+		Code             string `query:"code"`
+		State            string `query:"state" validate:"required"`
+		Error            string `query:"error"`
+		ErrorDescription string `query:"error_description"`
+		IP               string `json:"-"`
+		UserAgent        string `json:"-"`
+	}
+
+	// OIDCLoginState remembers a sign-in started at the provider until its callback arrives
+	OIDCLoginState struct {
+		ID           uint       `json:"id" gorm:"column:id;primaryKey"`
+		StateHash    string     `json:"-" gorm:"column:state_hash"`
+		Nonce        string     `json:"-" gorm:"column:nonce"`
+		CodeVerifier string     `json:"-" gorm:"column:code_verifier"`
+		ExpiresAt    time.Time  `json:"expires_at" gorm:"column:expires_at"`
+		UsedAt       *time.Time `json:"used_at" gorm:"column:used_at"`
+		CreatedAt    time.Time  `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+	}
+
+	// RefreshTokenRequest exchanges a refresh token for a new access/refresh token pair
+	RefreshTokenRequest struct {
+		RefreshToken string `json:"refresh_token" validate:"required"`
+		IP           string `json:"-"`
+		UserAgent    string `json:"-"`
+	}
+
+	// RevokeSessionsResponse reports how many sessions were revoked
+	RevokeSessionsResponse struct {
+		UserID  uint  `json:"user_id"`
+		Revoked int64 `json:"revoked"`
 	}
 
 	CreateUserRequest struct {
-		Username string `json:"username" validate:"required,min=3,max=50"`
-		Password string `json:"password" validate:"required,min=6"`
-		Role     string `json:"role" validate:"required,oneof=admin employee"`
+		Username       string  `json:"username" validate:"required,min=3,max=50"`
+		Password       string  `json:"password" validate:"required,min=6"`
+		Email          *string `json:"email" validate:"omitempty,email,max=150"`
+		Role           string  `json:"role" validate:"required,oneof=admin employee"`
+		Salary         float64 `json:"salary" validate:"min=0"`
+		Grade          *string `json:"grade" validate:"omitempty,max=20"`
+		EmploymentType *string `json:"employment_type" validate:"omitempty,oneof=permanent contract probation intern"`
+	}
+
+	// UpdateUserRequest changes role, salary and employment data, nil fields are left untouched
+	UpdateUserRequest struct {
+		Role           *string  `json:"role" validate:"omitempty,oneof=admin employee"`
+		Salary         *float64 `json:"salary" validate:"omitempty,min=0"`
+		Grade          *string  `json:"grade" validate:"omitempty,max=20"`
+		EmploymentType *string  `json:"employment_type" validate:"omitempty,oneof=permanent contract probation intern"`
+		WorkStartTime  *string  `json:"work_start_time" validate:"omitempty,datetime=15:04"`
+		WorkEndTime    *string  `json:"work_end_time" validate:"omitempty,datetime=15:04"`
+		// Email and OIDCSubject link the single sign-on account, an empty string unlinks it
+		Email       *string `json:"email" validate:"omitempty,max=150,email|eq="`
+		OIDCSubject *string `json:"oidc_subject" validate:"omitempty,max=255"`
+		// DepartmentID and ManagerID place the user in the organisation, 0 clears them
+		DepartmentID *uint `json:"department_id"`
+		ManagerID    *uint `json:"manager_id"`
+	}
+
+	// ChangePasswordRequest lets a signed in user replace their own password
+	ChangePasswordRequest struct {
+		CurrentPassword string `json:"current_password" validate:"required"`
+		NewPassword     string `json:"new_password" validate:"required"`
+		IP              string `json:"-"`
+		UserAgent       string `json:"-"`
+	}
+
+	// ResetPasswordRequest redeems a one-time reset token issued by an admin
+	ResetPasswordRequest struct {
+		Token       string `json:"token" validate:"required"`
+		NewPassword string `json:"new_password" validate:"required"`
+	}
+
+	// PasswordResetTokenResponse is handed to the admin, who passes the token on to the user
+	PasswordResetTokenResponse struct {
+		UserID     uint      `json:"user_id"`
+		ResetToken string    `json:"reset_token"`
+		ExpiresAt  time.Time `json:"expires_at"`
+	}
+
+	// PasswordResetToken is a one-time token to set a new password, only its hash is stored
+	PasswordResetToken struct {
+		ID        uint       `json:"id" gorm:"column:id;primaryKey"`
+		UserID    uint       `json:"user_id" gorm:"column:user_id"`
+		TokenHash string     `json:"-" gorm:"column:token_hash"`
+		ExpiresAt time.Time  `json:"expires_at" gorm:"column:expires_at"`
+		UsedAt    *time.Time `json:"used_at" gorm:"column:used_at"`
+		CreatedBy *uint      `json:"created_by" gorm:"column:created_by"`
+		CreatedAt time.Time  `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+	}
+
+	// ListUsersRequest for listing users with filters
+	ListUsersRequest struct {
+		Page   int    `json:"page" validate:"min=1"`
+		Limit  int    `json:"limit" validate:"min=1,max=100"`
+		Search string `json:"search"`
+		Role   string `json:"role" validate:"omitempty,oneof=admin employee"`
+		Status *int8  `json:"status" validate:"omitempty,oneof=1 2"`
+	}
+
+	// ListUsersResponse for paginated response
+	ListUsersResponse struct {
+		Data       []UserResponse `json:"data"`
+		Pagination Pagination     `json:"pagination"`
+	}
+
+	// UserResponse is the admin view of a user
+	UserResponse struct {
+		ID                 uint       `json:"id"`
+		Username           string     `json:"username"`
+		Email              *string    `json:"email"`
+		OIDCSubject        *string    `json:"oidc_subject"`
+		Role               string     `json:"role"`
+		DepartmentID       *uint      `json:"department_id"`
+		ManagerID          *uint      `json:"manager_id"`
+		Salary             float64    `json:"salary"`
+		Grade              *string    `json:"grade"`
+		EmploymentType     *string    `json:"employment_type"`
+		WorkStartTime      *string    `json:"work_start_time"`
+		WorkEndTime        *string    `json:"work_end_time"`
+		Status             int8       `json:"status"`
+		MustChangePassword bool       `json:"must_change_password"`
+		FailedLoginCount   int        `json:"failed_login_count"`
+		LockedUntil        *time.Time `json:"locked_until"`
+		TwoFactorEnabled   bool       `json:"two_factor_enabled"`
+		CreatedBy          *uint      `json:"created_by"`
+		CreatedAt          time.Time  `json:"created_at"`
+		UpdatedBy          *uint      `json:"updated_by"`
+		UpdatedAt          time.Time  `json:"updated_at"`
+	}
+
+	// EmployeeProfile holds the HR master data of a user
+	EmployeeProfile struct {
+		ID                    uint       `json:"id" gorm:"column:id;primaryKey"`
+		UserID                uint       `json:"user_id" gorm:"column:user_id"`
+		FullName              string     `json:"full_name" gorm:"column:full_name"`
+		EmployeeNumber        string     `json:"employee_number" gorm:"column:employee_number"`
+		NIK                   *string    `json:"nik" gorm:"column:nik"`
+		NPWP                  *string    `json:"npwp" gorm:"column:npwp"`
+		BankName              *string    `json:"bank_name" gorm:"column:bank_name"`
+		BankAccountNumber     *string    `json:"bank_account_number" gorm:"column:bank_account_number"`
+		BankAccountName       *string    `json:"bank_account_name" gorm:"column:bank_account_name"`
+		Department            *string    `json:"department" gorm:"column:department"`
+		Position              *string    `json:"position" gorm:"column:position"`
+		JoinDate              *time.Time `json:"join_date" gorm:"column:join_date;type:date"`
+		Phone                 *string    `json:"phone" gorm:"column:phone"`
+		PersonalEmail         *string    `json:"personal_email" gorm:"column:personal_email"`
+		Address               *string    `json:"address" gorm:"column:address"`
+		EmergencyContactName  *string    `json:"emergency_contact_name" gorm:"column:emergency_contact_name"`
+		EmergencyContactPhone *string    `json:"emergency_contact_phone" gorm:"column:emergency_contact_phone"`
+		CreatedBy             *uint      `json:"created_by" gorm:"column:created_by"`
+		CreatedAt             time.Time  `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+		UpdatedBy             *uint      `json:"updated_by" gorm:"column:updated_by"`
+		UpdatedAt             *time.Time `json:"updated_at" gorm:"column:updated_at;autoUpdateTime:false"`
+	}
+
+	// EmployeeProfileResponse is the profile together with the login name of the user
+	EmployeeProfileResponse struct {
+		EmployeeProfile
+		Username string `json:"username"`
+	}
+
+	// SaveEmployeeProfileRequest creates or replaces the profile of a user, admin only
+	SaveEmployeeProfileRequest struct {
+		FullName              string  `json:"full_name" validate:"required,min=3,max=150"`
+		EmployeeNumber        string  `json:"employee_number" validate:"required,max=30"`
+		NIK                   *string `json:"nik" validate:"omitempty,nik"`
+		NPWP                  *string `json:"npwp" validate:"omitempty,npwp"`
+		BankName              *string `json:"bank_name" validate:"omitempty,max=50"`
+		BankAccountNumber     *string `json:"bank_account_number" validate:"omitempty,numeric,max=30"`
+		BankAccountName       *string `json:"bank_account_name" validate:"omitempty,max=150"`
+		Department            *string `json:"department" validate:"omitempty,max=100"`
+		Position              *string `json:"position" validate:"omitempty,max=100"`
+		JoinDate              *string `json:"join_date" validate:"omitempty,datetime=2006-01-02"`
+		Phone                 *string `json:"phone" validate:"omitempty,max=20"`
+		PersonalEmail         *string `json:"personal_email" validate:"omitempty,email,max=150"`
+		Address               *string `json:"address" validate:"omitempty,max=500"`
+		EmergencyContactName  *string `json:"emergency_contact_name" validate:"omitempty,max=150"`
+		EmergencyContactPhone *string `json:"emergency_contact_phone" validate:"omitempty,max=20"`
+	}
+
+	// UpdateOwnProfileRequest lists the contact fields an employee may change on their own profile
+	UpdateOwnProfileRequest struct {
+		Phone                 *string `json:"phone" validate:"omitempty,max=20"`
+		PersonalEmail         *string `json:"personal_email" validate:"omitempty,email,max=150"`
+		Address               *string `json:"address" validate:"omitempty,max=500"`
+		EmergencyContactName  *string `json:"emergency_contact_name" validate:"omitempty,max=150"`
+		EmergencyContactPhone *string `json:"emergency_contact_phone" validate:"omitempty,max=20"`
+	}
+
+	// Pagination info
+	Pagination struct {
+		Page       int `json:"page"`
+		Limit      int `json:"limit"`
+		Total      int `json:"total"`
+		TotalPages int `json:"total_pages"`
 	}
 
 	CreateUserResponse struct {
@@ -42,12 +323,98 @@ type (
 	}
 
 	User struct {
-		ID        uint      `json:"id" gorm:"column:id"`
-		Username  string    `json:"username" gorm:"column:username"`
-		Password  string    `json:"-" gorm:"column:password"`
-		Role      string    `json:"role" gorm:"column:roles"`
-		Salary    float64   `json:"salary" gorm:"column:salary;type:decimal(15,2);default:0.00"`
-		CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
-		UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+		ID                 uint       `json:"id" gorm:"column:id"`
+		Username           string     `json:"username" gorm:"column:username"`
+		Password           string     `json:"-" gorm:"column:password"`
+		Email              *string    `json:"email" gorm:"column:email"`
+		OIDCSubject        *string    `json:"oidc_subject" gorm:"column:oidc_subject"`
+		Role               string     `json:"role" gorm:"column:roles"`
+		DepartmentID       *uint      `json:"department_id" gorm:"column:department_id"`
+		ManagerID          *uint      `json:"manager_id" gorm:"column:manager_id"`
+		Salary             float64    `json:"salary" gorm:"column:salary;type:decimal(15,2);default:0.00"`
+		WorkStartTime      *string    `json:"work_start_time" gorm:"column:work_start_time"`
+		WorkEndTime        *string    `json:"work_end_time" gorm:"column:work_end_time"`
+		Grade              *string    `json:"grade" gorm:"column:grade"`
+		EmploymentType     *string    `json:"employment_type" gorm:"column:employment_type"`
+		Status             int8       `json:"status" gorm:"column:status;default:1"`
+		MustChangePassword bool       `json:"must_change_password" gorm:"column:must_change_password"`
+		FailedLoginCount   int        `json:"failed_login_count" gorm:"column:failed_login_count"`
+		LastFailedLoginAt  *time.Time `json:"last_failed_login_at" gorm:"column:last_failed_login_at"`
+		LockedUntil        *time.Time `json:"locked_until" gorm:"column:locked_until"`
+		TwoFactorSecret    *string    `json:"-" gorm:"column:two_factor_secret"`
+		TwoFactorEnabled   bool       `json:"two_factor_enabled" gorm:"column:two_factor_enabled"`
+		TwoFactorEnabledAt *time.Time `json:"two_factor_enabled_at" gorm:"column:two_factor_enabled_at"`
+		TwoFactorLastStep  int64      `json:"-" gorm:"column:two_factor_last_step"`
+		CreatedBy          *uint      `json:"created_by" gorm:"column:created_by"`
+		CreatedAt          time.Time  `json:"created_at" gorm:"column:created_at"`
+		UpdatedBy          *uint      `json:"updated_by" gorm:"column:updated_by"`
+		UpdatedAt          time.Time  `json:"updated_at" gorm:"column:updated_at"`
 	}
 )
+
+// ToResponse converts the user to its admin view without the password hash
+func (u User) ToResponse() UserResponse {
+	return UserResponse{
+		ID:                 u.ID,
+		Username:           u.Username,
+		Email:              u.Email,
+		OIDCSubject:        u.OIDCSubject,
+		Role:               u.Role,
+		DepartmentID:       u.DepartmentID,
+		ManagerID:          u.ManagerID,
+		Salary:             u.Salary,
+		Grade:              u.Grade,
+		EmploymentType:     u.EmploymentType,
+		WorkStartTime:      u.WorkStartTime,
+		WorkEndTime:        u.WorkEndTime,
+		Status:             u.Status,
+		MustChangePassword: u.MustChangePassword,
+		FailedLoginCount:   u.FailedLoginCount,
+		LockedUntil:        u.LockedUntil,
+		TwoFactorEnabled:   u.TwoFactorEnabled,
+		CreatedBy:          u.CreatedBy,
+		CreatedAt:          u.CreatedAt,
+		UpdatedBy:          u.UpdatedBy,
+		UpdatedAt:          u.UpdatedAt,
+	}
+}
+
+func (EmployeeProfile) TableName() string {
+	return "employee_profiles"
+}
+
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
+
+func (TwoFactorChallenge) TableName() string {
+	return "two_factor_challenges"
+}
+
+func (TwoFactorRecoveryCode) TableName() string {
+	return "two_factor_recovery_codes"
+}
+
+func (OIDCLoginState) TableName() string {
+	return "oidc_login_states"
+}
+
+// IsLockedAt reports whether the account is locked after too many failed logins at t
+func (u User) IsLockedAt(t time.Time) bool {
+	return u.LockedUntil != nil && t.Before(*u.LockedUntil)
+}
+
+// IsUsableAt reports whether the token has not been redeemed and has not expired at t
+func (t PasswordResetToken) IsUsableAt(at time.Time) bool {
+	return t.UsedAt == nil && at.Before(t.ExpiresAt)
+}
+
+// IsUsableAt reports whether the sign-in has not been completed and has not expired at t
+func (s OIDCLoginState) IsUsableAt(at time.Time) bool {
+	return s.UsedAt == nil && at.Before(s.ExpiresAt)
+}
+
+// IsUsableAt reports whether the challenge has not been completed, has not expired and has attempts left at t
+func (c TwoFactorChallenge) IsUsableAt(at time.Time, maxAttempts int) bool {
+	return c.UsedAt == nil && at.Before(c.ExpiresAt) && (maxAttempts <= 0 || c.Attempts < maxAttempts)
+}
@@ -0,0 +1,164 @@
+package api_key
+
+import (
+	"context"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/api_key"
+	"gorm.io/gorm"
+)
+
+type (
+	IAPIKeyRepository interface {
+		List(ctx context.Context) ([]api_key.APIKey, error)
+		GetByID(ctx context.Context, id uint) (*api_key.APIKey, error)
+		GetByHash(ctx context.Context, keyHash string) (*api_key.APIKey, error)
+		Create(ctx context.Context, key *api_key.APIKey) error
+		SetScopes(ctx context.Context, apiKeyID uint, permissionIDs []uint) error
+		Revoke(ctx context.Context, id uint, revokedBy uint) (bool, error)
+		TouchLastUsed(ctx context.Context, id uint, ip string, usedAt time.Time) error
+	}
+
+	APIKeyRepository struct {
+		db *gorm.DB
+	}
+
+	apiKeyScope struct {
+		APIKeyID uint
+		Code     string
+	}
+)
+
+func NewAPIKeyRepository(db *gorm.DB) IAPIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+func (repo APIKeyRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo APIKeyRepository) List(ctx context.Context) ([]api_key.APIKey, error) {
+	var keys []api_key.APIKey
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Order("created_at DESC").Find(&keys).Error; err != nil {
+		return nil, err
+	}
+	if err := repo.loadScopes(ctx, keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (repo APIKeyRepository) GetByID(ctx context.Context, id uint) (*api_key.APIKey, error) {
+	return repo.getOne(ctx, "id = ?", id)
+}
+
+func (repo APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*api_key.APIKey, error) {
+	return repo.getOne(ctx, "key_hash = ?", keyHash)
+}
+
+func (repo APIKeyRepository) Create(ctx context.Context, key *api_key.APIKey) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(key).Error
+}
+
+func (repo APIKeyRepository) SetScopes(ctx context.Context, apiKeyID uint, permissionIDs []uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	db := repo.getInstanceDB(ctx).WithContext(ctxWT)
+	if err := db.Where("api_key_id = ?", apiKeyID).Delete(&api_key.APIKeyPermission{}).Error; err != nil {
+		return err
+	}
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+	rows := make([]api_key.APIKeyPermission, 0, len(permissionIDs))
+	for _, permissionID := range permissionIDs {
+		rows = append(rows, api_key.APIKeyPermission{APIKeyID: apiKeyID, PermissionID: permissionID})
+	}
+	return db.Create(&rows).Error
+}
+
+// Revoke marks the key as revoked, it reports false when the key was already revoked
+func (repo APIKeyRepository) Revoke(ctx context.Context, id uint, revokedBy uint) (bool, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&api_key.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at": time.Now(),
+			"revoked_by": revokedBy,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+func (repo APIKeyRepository) TouchLastUsed(ctx context.Context, id uint, ip string, usedAt time.Time) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&api_key.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"last_used_at": usedAt,
+			"last_used_ip": ip,
+		}).Error
+}
+
+func (repo APIKeyRepository) getOne(ctx context.Context, query string, arg interface{}) (*api_key.APIKey, error) {
+	var key api_key.APIKey
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where(query, arg).First(&key).Error; err != nil {
+		return nil, err
+	}
+	keys := []api_key.APIKey{key}
+	if err := repo.loadScopes(ctx, keys); err != nil {
+		return nil, err
+	}
+	return &keys[0], nil
+}
+
+// loadScopes fills the permission codes of every key in a single query
+func (repo APIKeyRepository) loadScopes(ctx context.Context, keys []api_key.APIKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	ids := make([]uint, 0, len(keys))
+	for _, k := range keys {
+		ids = append(ids, k.ID)
+	}
+
+	var rows []apiKeyScope
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("api_key_permissions").
+		Select("api_key_permissions.api_key_id, permissions.code").
+		Joins("JOIN permissions ON permissions.id = api_key_permissions.permission_id").
+		Where("api_key_permissions.api_key_id IN ?", ids).
+		Order("permissions.code ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	scopes := make(map[uint][]string, len(keys))
+	for _, row := range rows {
+		scopes[row.APIKeyID] = append(scopes[row.APIKeyID], row.Code)
+	}
+	for i := range keys {
+		keys[i].Scopes = scopes[keys[i].ID]
+		if keys[i].Scopes == nil {
+			keys[i].Scopes = []string{}
+		}
+	}
+	return nil
+}
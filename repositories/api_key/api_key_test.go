@@ -0,0 +1,171 @@
+package api_key
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/api_key"
+)
+
+func TestAPIKeyRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAPIKeyRepository{}
+
+		// Test data
+		key := &api_key.APIKey{Name: "ERP", Prefix: "pk_1a2b3c4d", KeyHash: "hash", CreatedBy: 1}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, key).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).(*api_key.APIKey).ID = 1
+		})
+		mockRepo.On("SetScopes", mock.Anything, uint(1), []uint{9, 10}).Return(nil)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), key)
+		scopeErr := mockRepo.SetScopes(context.Background(), key.ID, []uint{9, 10})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, scopeErr)
+		assert.Equal(t, uint(1), key.ID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAPIKeyRepository_GetByHash(t *testing.T) {
+	t.Run("found with scopes", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAPIKeyRepository{}
+
+		// Test data
+		expected := &api_key.APIKey{
+			ID:     1,
+			Prefix: "pk_1a2b3c4d",
+			Scopes: []string{constant.PermissionPayrollReport},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetByHash", mock.Anything, "hash").Return(expected, nil)
+
+		// Execute
+		key, err := mockRepo.GetByHash(context.Background(), "hash")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{constant.PermissionPayrollReport}, key.Scopes)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAPIKeyRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetByHash", mock.Anything, "unknown").Return(nil, gorm.ErrRecordNotFound)
+
+		// Execute
+		key, err := mockRepo.GetByHash(context.Background(), "unknown")
+
+		// Assert
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.Nil(t, key)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAPIKeyRepository_Revoke(t *testing.T) {
+	t.Run("first revoke", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAPIKeyRepository{}
+
+		// Setup expectations
+		mockRepo.On("Revoke", mock.Anything, uint(1), uint(2)).Return(true, nil)
+
+		// Execute
+		revoked, err := mockRepo.Revoke(context.Background(), 1, 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already revoked", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAPIKeyRepository{}
+
+		// Setup expectations
+		mockRepo.On("Revoke", mock.Anything, uint(1), uint(2)).Return(false, nil)
+
+		// Execute
+		revoked, err := mockRepo.Revoke(context.Background(), 1, 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAPIKeyRepository_TouchLastUsed(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAPIKeyRepository{}
+
+		// Test data
+		usedAt := time.Now()
+
+		// Setup expectations
+		mockRepo.On("TouchLastUsed", mock.Anything, uint(1), "10.0.0.5", usedAt).Return(nil)
+
+		// Execute
+		err := mockRepo.TouchLastUsed(context.Background(), 1, "10.0.0.5", usedAt)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAPIKey_IsActiveAt(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		key      api_key.APIKey
+		expected bool
+	}{
+		{"no expiry", api_key.APIKey{}, true},
+		{"expires later", api_key.APIKey{ExpiresAt: &future}, true},
+		{"expired", api_key.APIKey{ExpiresAt: &past}, false},
+		{"revoked", api_key.APIKey{RevokedAt: &past}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.key.IsActiveAt(now))
+		})
+	}
+}
@@ -19,6 +19,11 @@ type (
 		CreateAttendance(ctx context.Context, attendance attendance.Attendance) (attendance.Attendance, error)
 		UpdateAttendance(ctx context.Context, attendance attendance.Attendance) (attendance.Attendance, error)
 		GetAttendanceByIDForUpdate(ctx context.Context, id, userID uint) (attendance.Attendance, error)
+		GetOpenAttendancesBefore(ctx context.Context, before time.Time, limit int) ([]attendance.Attendance, error)
+		AutoClose(ctx context.Context, id uint, checkOut, closedAt time.Time) (bool, error)
+		GetByUserAndDateRange(ctx context.Context, userID uint, startDate, endDate time.Time) ([]attendance.Attendance, error)
+		GetAllAttendances(ctx context.Context, req attendance.AdminAttendanceListRequest) ([]attendance.AttendanceWithUser, int64, error)
+		GetAttendanceWithUserByID(ctx context.Context, id uint, workStartTime string) (attendance.AttendanceWithUser, error)
 	}
 
 	AttendanceRepository struct {
@@ -26,6 +31,10 @@ type (
 	}
 )
 
+// lateCondition compares the check-in time of day with the employee's scheduled start,
+// falling back to the default start time given as argument
+const lateCondition = "attendances.check_in_date::time > COALESCE(users.work_start_time, CAST(? AS TIME))"
+
 func NewAttendanceRepository(db *gorm.DB) IAttendanceRepository {
 	return &AttendanceRepository{
 		db: db,
@@ -171,6 +180,22 @@ func (repo AttendanceRepository) UpdateAttendance(ctx context.Context, attendanc
 	return attendance, nil
 }
 
+// AutoClose sets the check-out of an attendance that is still open, it reports false when the employee
+// checked out in the meantime so their own check-out is kept
+func (repo AttendanceRepository) AutoClose(ctx context.Context, id uint, checkOut, closedAt time.Time) (bool, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&attendance.Attendance{}).
+		Where("id = ? AND check_out_date IS NULL", id).
+		Updates(map[string]interface{}{
+			"check_out_date": checkOut,
+			"auto_closed":    true,
+			"updated_at":     closedAt,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
 func (repo AttendanceRepository) GetAttendanceByIDForUpdate(ctx context.Context, id, userID uint) (attendance.Attendance, error) {
 	var attendance attendance.Attendance
 
@@ -188,3 +213,105 @@ func (repo AttendanceRepository) GetAttendanceByIDForUpdate(ctx context.Context,
 
 	return attendance, nil
 }
+
+// GetOpenAttendancesBefore returns attendances without check-out that started before the given time
+func (repo AttendanceRepository) GetOpenAttendancesBefore(ctx context.Context, before time.Time, limit int) ([]attendance.Attendance, error) {
+	var attendances []attendance.Attendance
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("check_out_date IS NULL AND check_in_date < ?", before).
+		Order("check_in_date ASC").
+		Limit(limit).
+		Find(&attendances).Error
+
+	return attendances, err
+}
+
+// GetAllAttendances returns attendances across employees joined with their username
+func (repo AttendanceRepository) GetAllAttendances(ctx context.Context, req attendance.AdminAttendanceListRequest) ([]attendance.AttendanceWithUser, int64, error) {
+	var attendances []attendance.AttendanceWithUser
+	var total int64
+
+	offset := (req.Page - 1) * req.Limit
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	// Build query
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("attendances").
+		Joins("JOIN users ON attendances.user_id = users.id")
+
+	// Apply filters
+	if req.UserID != 0 {
+		query = query.Where("attendances.user_id = ?", req.UserID)
+	}
+	if req.UserIDs != nil {
+		query = query.Where("attendances.user_id IN ?", req.UserIDs)
+	}
+	if req.StartDate != nil {
+		query = query.Where("DATE(attendances.check_in_date) >= DATE(?)", req.StartDate)
+	}
+	if req.EndDate != nil {
+		query = query.Where("DATE(attendances.check_in_date) <= DATE(?)", req.EndDate)
+	}
+	if req.MissingCheckOut {
+		query = query.Where("attendances.check_out_date IS NULL")
+	}
+	if req.Late {
+		query = query.Where(lateCondition, req.WorkStartTime)
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get attendances with pagination
+	if err := query.
+		Select("attendances.*, users.username, "+lateCondition+" AS late", req.WorkStartTime).
+		Order("attendances.check_in_date DESC").
+		Offset(offset).
+		Limit(req.Limit).
+		Find(&attendances).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return attendances, total, nil
+}
+
+// GetAttendanceWithUserByID returns an attendance of any employee joined with its username
+func (repo AttendanceRepository) GetAttendanceWithUserByID(ctx context.Context, id uint, workStartTime string) (attendance.AttendanceWithUser, error) {
+	var result attendance.AttendanceWithUser
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("attendances").
+		Select("attendances.*, users.username, "+lateCondition+" AS late", workStartTime).
+		Joins("JOIN users ON attendances.user_id = users.id").
+		Where("attendances.id = ?", id).
+		Take(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return result, errors.New("attendance not found")
+		}
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (repo AttendanceRepository) GetByUserAndDateRange(ctx context.Context, userID uint, startDate, endDate time.Time) ([]attendance.Attendance, error) {
+	var attendances []attendance.Attendance
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("user_id = ? AND check_in_date BETWEEN ? AND ?", userID, startDate, endDate).
+		Order("check_in_date ASC").
+		Find(&attendances).Error
+	return attendances, err
+}
@@ -2,6 +2,7 @@ package attendance
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -304,6 +305,284 @@ func TestAttendanceRepository_GetByUserAndDate(t *testing.T) {
 	})
 }
 
+func TestAttendanceRepository_GetOpenAttendancesBefore(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		before := time.Date(2024, 1, 15, 21, 0, 0, 0, time.UTC)
+		expectedAttendances := []attendance.Attendance{
+			{
+				ID:          1,
+				UserID:      1,
+				CheckInDate: time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC),
+				CreatedBy:   1,
+			},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetOpenAttendancesBefore", mock.Anything, before, 500).Return(expectedAttendances, nil)
+
+		// Execute
+		result, err := mockRepo.GetOpenAttendancesBefore(context.Background(), before, 500)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Nil(t, result[0].CheckOutDate)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		before := time.Date(2024, 1, 15, 21, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("GetOpenAttendancesBefore", mock.Anything, before, 500).Return(nil, assert.AnError)
+
+		// Execute
+		result, err := mockRepo.GetOpenAttendancesBefore(context.Background(), before, 500)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceRepository_AutoClose(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		checkOut := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+		closedAt := time.Date(2024, 1, 16, 1, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("AutoClose", mock.Anything, uint(1), checkOut, closedAt).Return(true, nil)
+
+		// Execute
+		updated, err := mockRepo.AutoClose(context.Background(), 1, checkOut, closedAt)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, updated)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already checked out", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		checkOut := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+		closedAt := time.Date(2024, 1, 16, 1, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("AutoClose", mock.Anything, uint(1), checkOut, closedAt).Return(false, nil)
+
+		// Execute
+		updated, err := mockRepo.AutoClose(context.Background(), 1, checkOut, closedAt)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, updated)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceRepository_GetByUserAndDateRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		userID := uint(1)
+		startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC)
+		checkOut := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+		expectedAttendances := []attendance.Attendance{
+			{
+				ID:           1,
+				UserID:       userID,
+				CheckInDate:  time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC),
+				CheckOutDate: &checkOut,
+				CreatedBy:    userID,
+			},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetByUserAndDateRange", mock.Anything, userID, startDate, endDate).Return(expectedAttendances, nil)
+
+		// Execute
+		result, err := mockRepo.GetByUserAndDateRange(context.Background(), userID, startDate, endDate)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, expectedAttendances[0].ID, result[0].ID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("no attendances found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		userID := uint(1)
+		startDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("GetByUserAndDateRange", mock.Anything, userID, startDate, endDate).Return([]attendance.Attendance{}, nil)
+
+		// Execute
+		result, err := mockRepo.GetByUserAndDateRange(context.Background(), userID, startDate, endDate)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceRepository_GetAllAttendances(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC)
+		req := attendance.AdminAttendanceListRequest{
+			Page:            1,
+			Limit:           10,
+			StartDate:       &startDate,
+			EndDate:         &endDate,
+			MissingCheckOut: true,
+			Late:            true,
+			WorkStartTime:   "09:00",
+		}
+		expectedAttendances := []attendance.AttendanceWithUser{
+			{
+				Attendance: attendance.Attendance{
+					ID:          1,
+					UserID:      2,
+					CheckInDate: time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC),
+					CreatedBy:   2,
+				},
+				Username: "employee1",
+				Late:     true,
+			},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetAllAttendances", mock.Anything, req).Return(expectedAttendances, int64(1), nil)
+
+		// Execute
+		result, total, err := mockRepo.GetAllAttendances(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "employee1", result[0].Username)
+		assert.True(t, result[0].Late)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		req := attendance.AdminAttendanceListRequest{Page: 1, Limit: 10, WorkStartTime: "09:00"}
+
+		// Setup expectations
+		mockRepo.On("GetAllAttendances", mock.Anything, req).Return(nil, int64(0), assert.AnError)
+
+		// Execute
+		result, total, err := mockRepo.GetAllAttendances(context.Background(), req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, int64(0), total)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceRepository_GetAttendanceWithUserByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Test data
+		expected := attendance.AttendanceWithUser{
+			Attendance: attendance.Attendance{
+				ID:          1,
+				UserID:      2,
+				CheckInDate: time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC),
+				CreatedBy:   1,
+			},
+			Username: "employee1",
+		}
+
+		// Setup expectations
+		mockRepo.On("GetAttendanceWithUserByID", mock.Anything, uint(1), "09:00").Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.GetAttendanceWithUserByID(context.Background(), uint(1), "09:00")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+		assert.False(t, result.Late)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("attendance not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetAttendanceWithUserByID", mock.Anything, uint(999), "09:00").Return(attendance.AttendanceWithUser{}, errors.New("attendance not found"))
+
+		// Execute
+		_, err := mockRepo.GetAttendanceWithUserByID(context.Background(), uint(999), "09:00")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, "attendance not found", err.Error())
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 // Test untuk memastikan interface berfungsi dengan benar
 func TestAttendanceRepository_Interface(t *testing.T) {
 	t.Run("interface implementation", func(t *testing.T) {
@@ -0,0 +1,156 @@
+package attendance_correction
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/attendance_correction"
+	"gorm.io/gorm"
+)
+
+type (
+	IAttendanceCorrectionRepository interface {
+		Create(ctx context.Context, correction *attendance_correction.AttendanceCorrection) error
+		GetByID(ctx context.Context, id uint) (*attendance_correction.AttendanceCorrection, error)
+		Review(ctx context.Context, id uint, updates map[string]interface{}) error
+		List(ctx context.Context, req attendance_correction.ListAttendanceCorrectionsRequest) (*attendance_correction.ListAttendanceCorrectionsResponse, error)
+		HasPendingByUserAndDate(ctx context.Context, userID uint, date time.Time) (bool, error)
+	}
+
+	AttendanceCorrectionRepository struct {
+		db *gorm.DB
+	}
+)
+
+func NewAttendanceCorrectionRepository(db *gorm.DB) IAttendanceCorrectionRepository {
+	return &AttendanceCorrectionRepository{db: db}
+}
+
+func (repo AttendanceCorrectionRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo AttendanceCorrectionRepository) Create(ctx context.Context, correction *attendance_correction.AttendanceCorrection) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(correction).Error
+}
+
+func (repo AttendanceCorrectionRepository) GetByID(ctx context.Context, id uint) (*attendance_correction.AttendanceCorrection, error) {
+	var correction attendance_correction.AttendanceCorrection
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ?", id).First(&correction).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("record not found")
+		}
+		return nil, err
+	}
+	return &correction, nil
+}
+
+// Review updates a correction that is still pending, so a request can only be reviewed once
+func (repo AttendanceCorrectionRepository) Review(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&attendance_correction.AttendanceCorrection{}).
+		Where("id = ? AND status = ?", id, constant.ApprovalStatusPending).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("correction is no longer pending")
+	}
+	return nil
+}
+
+func (repo AttendanceCorrectionRepository) List(ctx context.Context, req attendance_correction.ListAttendanceCorrectionsRequest) (*attendance_correction.ListAttendanceCorrectionsResponse, error) {
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	// Build query
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("attendance_corrections").
+		Select(`
+			attendance_corrections.id,
+			attendance_corrections.user_id,
+			users.username,
+			attendance_corrections.attendance_id,
+			attendance_corrections.original_check_in_date,
+			attendance_corrections.original_check_out_date,
+			attendance_corrections.requested_check_in_date,
+			attendance_corrections.requested_check_out_date,
+			attendance_corrections.reason,
+			attendance_corrections.status,
+			attendance_corrections.reviewed_by,
+			attendance_corrections.reviewed_at,
+			attendance_corrections.review_comment,
+			attendance_corrections.created_at,
+			attendance_corrections.updated_at
+		`).
+		Joins("JOIN users ON attendance_corrections.user_id = users.id")
+
+	// Apply filters
+	if req.UserID != 0 {
+		query = query.Where("attendance_corrections.user_id = ?", req.UserID)
+	}
+	if req.UserIDs != nil {
+		query = query.Where("attendance_corrections.user_id IN ?", req.UserIDs)
+	}
+	if req.Status != "" {
+		query = query.Where("attendance_corrections.status = ?", req.Status)
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (req.Page - 1) * req.Limit
+	var responses []attendance_correction.AttendanceCorrectionResponse
+	if err := query.Order("attendance_corrections.created_at DESC").Offset(offset).Limit(req.Limit).Find(&responses).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return &attendance_correction.ListAttendanceCorrectionsResponse{
+		Data: responses,
+		Pagination: attendance_correction.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (repo AttendanceCorrectionRepository) HasPendingByUserAndDate(ctx context.Context, userID uint, date time.Time) (bool, error) {
+	var count int64
+
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	endOfDay := startOfDay.Add(24 * time.Hour)
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&attendance_correction.AttendanceCorrection{}).
+		Where("user_id = ? AND status = ?", userID, constant.ApprovalStatusPending).
+		Where("requested_check_in_date >= ? AND requested_check_in_date < ?", startOfDay, endOfDay).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,262 @@
+package attendance_correction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/attendance_correction"
+)
+
+func TestAttendanceCorrectionRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		checkOut := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+		correction := &attendance_correction.AttendanceCorrection{
+			UserID:                1,
+			RequestedCheckInDate:  time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC),
+			RequestedCheckOutDate: &checkOut,
+			Reason:                "Forgot to check out",
+			Status:                constant.ApprovalStatusPending,
+			CreatedBy:             1,
+		}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, correction).Return(nil)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), correction)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		correction := &attendance_correction.AttendanceCorrection{
+			UserID:    1,
+			CreatedBy: 1,
+		}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, correction).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), correction)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceCorrectionRepository_GetByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		attendanceID := uint(10)
+		expected := &attendance_correction.AttendanceCorrection{
+			ID:           1,
+			UserID:       1,
+			AttendanceID: &attendanceID,
+			Status:       constant.ApprovalStatusPending,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetByID", mock.Anything, uint(1)).Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.GetByID(context.Background(), uint(1))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("correction not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetByID", mock.Anything, uint(999)).Return(nil, errors.New("record not found"))
+
+		// Execute
+		result, err := mockRepo.GetByID(context.Background(), uint(999))
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, "record not found", err.Error())
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceCorrectionRepository_Review(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		updates := map[string]interface{}{
+			"status":      constant.ApprovalStatusApproved,
+			"reviewed_by": uint(2),
+		}
+
+		// Setup expectations
+		mockRepo.On("Review", mock.Anything, uint(1), updates).Return(nil)
+
+		// Execute
+		err := mockRepo.Review(context.Background(), uint(1), updates)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		updates := map[string]interface{}{
+			"status": constant.ApprovalStatusRejected,
+		}
+
+		// Setup expectations
+		mockRepo.On("Review", mock.Anything, uint(1), updates).Return(errors.New("correction is no longer pending"))
+
+		// Execute
+		err := mockRepo.Review(context.Background(), uint(1), updates)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, "correction is no longer pending", err.Error())
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceCorrectionRepository_List(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		req := attendance_correction.ListAttendanceCorrectionsRequest{
+			Page:   1,
+			Limit:  10,
+			Status: constant.ApprovalStatusPending,
+		}
+		expected := &attendance_correction.ListAttendanceCorrectionsResponse{
+			Data: []attendance_correction.AttendanceCorrectionResponse{
+				{ID: 1, UserID: 1, Username: "employee1", Status: constant.ApprovalStatusPending},
+			},
+			Pagination: attendance_correction.Pagination{Page: 1, Limit: 10, Total: 1, TotalPages: 1},
+		}
+
+		// Setup expectations
+		mockRepo.On("List", mock.Anything, req).Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.List(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		req := attendance_correction.ListAttendanceCorrectionsRequest{Page: 1, Limit: 10}
+
+		// Setup expectations
+		mockRepo.On("List", mock.Anything, req).Return(nil, assert.AnError)
+
+		// Execute
+		result, err := mockRepo.List(context.Background(), req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceCorrectionRepository_HasPendingByUserAndDate(t *testing.T) {
+	t.Run("has pending correction", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		date := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("HasPendingByUserAndDate", mock.Anything, uint(1), date).Return(true, nil)
+
+		// Execute
+		result, err := mockRepo.HasPendingByUserAndDate(context.Background(), uint(1), date)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceCorrectionRepository{}
+
+		// Test data
+		date := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("HasPendingByUserAndDate", mock.Anything, uint(1), date).Return(false, assert.AnError)
+
+		// Execute
+		result, err := mockRepo.HasPendingByUserAndDate(context.Background(), uint(1), date)
+
+		// Assert
+		assert.Error(t, err)
+		assert.False(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,201 @@
+package attendance_import
+
+import (
+	"context"
+	"errors"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/attendance_import"
+	"gorm.io/gorm"
+)
+
+type (
+	IAttendanceImportRepository interface {
+		CreateImport(ctx context.Context, record *attendance_import.AttendanceImport) error
+		UpdateImport(ctx context.Context, id uint, updates map[string]interface{}) error
+		GetImportByFileHash(ctx context.Context, fileHash string) (*attendance_import.AttendanceImport, error)
+		ListImports(ctx context.Context, page, limit int) (*attendance_import.ListAttendanceImportsResponse, error)
+		CreateDeviceUser(ctx context.Context, deviceUser *attendance_import.DeviceUser) error
+		GetDeviceUserByID(ctx context.Context, id uint) (*attendance_import.DeviceUser, error)
+		GetDeviceUserByDeviceID(ctx context.Context, deviceUserID string) (*attendance_import.DeviceUser, error)
+		GetDeviceUsersByDeviceIDs(ctx context.Context, deviceUserIDs []string) ([]attendance_import.DeviceUser, error)
+		ListDeviceUsers(ctx context.Context, page, limit int) (*attendance_import.ListDeviceUsersResponse, error)
+		DeleteDeviceUser(ctx context.Context, id uint) error
+	}
+
+	AttendanceImportRepository struct {
+		db *gorm.DB
+	}
+)
+
+func NewAttendanceImportRepository(db *gorm.DB) IAttendanceImportRepository {
+	return &AttendanceImportRepository{db: db}
+}
+
+func (repo AttendanceImportRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo AttendanceImportRepository) CreateImport(ctx context.Context, record *attendance_import.AttendanceImport) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(record).Error
+}
+
+func (repo AttendanceImportRepository) UpdateImport(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&attendance_import.AttendanceImport{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+func (repo AttendanceImportRepository) GetImportByFileHash(ctx context.Context, fileHash string) (*attendance_import.AttendanceImport, error) {
+	var record attendance_import.AttendanceImport
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("file_hash = ?", fileHash).
+		Order("id DESC").
+		First(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("record not found")
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (repo AttendanceImportRepository) ListImports(ctx context.Context, page, limit int) (*attendance_import.ListAttendanceImportsResponse, error) {
+	var records []attendance_import.AttendanceImport
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&attendance_import.AttendanceImport{})
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+
+	return &attendance_import.ListAttendanceImportsResponse{
+		Data: records,
+		Pagination: attendance_import.Pagination{
+			Page:       page,
+			Limit:      limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (repo AttendanceImportRepository) CreateDeviceUser(ctx context.Context, deviceUser *attendance_import.DeviceUser) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(deviceUser).Error
+}
+
+func (repo AttendanceImportRepository) GetDeviceUserByID(ctx context.Context, id uint) (*attendance_import.DeviceUser, error) {
+	var deviceUser attendance_import.DeviceUser
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ?", id).First(&deviceUser).Error; err != nil {
+		return nil, err
+	}
+	return &deviceUser, nil
+}
+
+func (repo AttendanceImportRepository) GetDeviceUserByDeviceID(ctx context.Context, deviceUserID string) (*attendance_import.DeviceUser, error) {
+	var deviceUser attendance_import.DeviceUser
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("device_user_id = ?", deviceUserID).First(&deviceUser).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("record not found")
+		}
+		return nil, err
+	}
+	return &deviceUser, nil
+}
+
+func (repo AttendanceImportRepository) GetDeviceUsersByDeviceIDs(ctx context.Context, deviceUserIDs []string) ([]attendance_import.DeviceUser, error) {
+	var deviceUsers []attendance_import.DeviceUser
+	if len(deviceUserIDs) == 0 {
+		return deviceUsers, nil
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("device_user_id IN ?", deviceUserIDs).
+		Find(&deviceUsers).Error
+	return deviceUsers, err
+}
+
+func (repo AttendanceImportRepository) ListDeviceUsers(ctx context.Context, page, limit int) (*attendance_import.ListDeviceUsersResponse, error) {
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("device_users").
+		Select(`
+			device_users.id,
+			device_users.device_user_id,
+			device_users.user_id,
+			users.username,
+			device_users.created_by,
+			device_users.created_at
+		`).
+		Joins("JOIN users ON device_users.user_id = users.id")
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	var responses []attendance_import.DeviceUserResponse
+	if err := query.Order("device_users.device_user_id ASC").Offset(offset).Limit(limit).Find(&responses).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+
+	return &attendance_import.ListDeviceUsersResponse{
+		Data: responses,
+		Pagination: attendance_import.Pagination{
+			Page:       page,
+			Limit:      limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (repo AttendanceImportRepository) DeleteDeviceUser(ctx context.Context, id uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Delete(&attendance_import.DeviceUser{}, id).Error
+}
@@ -0,0 +1,287 @@
+package attendance_import
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/attendance_import"
+)
+
+func TestAttendanceImportRepository_CreateImport(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Test data
+		record := &attendance_import.AttendanceImport{
+			FileName:  "attlog.dat",
+			FileHash:  "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			CreatedBy: 1,
+		}
+
+		// Setup expectations
+		mockRepo.On("CreateImport", mock.Anything, record).Return(nil)
+
+		// Execute
+		err := mockRepo.CreateImport(context.Background(), record)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Test data
+		record := &attendance_import.AttendanceImport{
+			FileName:  "attlog.dat",
+			CreatedBy: 1,
+		}
+
+		// Setup expectations
+		mockRepo.On("CreateImport", mock.Anything, record).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.CreateImport(context.Background(), record)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceImportRepository_GetImportByFileHash(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Test data
+		fileHash := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+		expected := &attendance_import.AttendanceImport{
+			ID:       3,
+			FileName: "attlog.dat",
+			FileHash: fileHash,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetImportByFileHash", mock.Anything, fileHash).Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.GetImportByFileHash(context.Background(), fileHash)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("import not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Test data
+		fileHash := "unknown"
+
+		// Setup expectations
+		mockRepo.On("GetImportByFileHash", mock.Anything, fileHash).Return(nil, errors.New("record not found"))
+
+		// Execute
+		result, err := mockRepo.GetImportByFileHash(context.Background(), fileHash)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, "record not found", err.Error())
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceImportRepository_ListImports(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Test data
+		expected := &attendance_import.ListAttendanceImportsResponse{
+			Data: []attendance_import.AttendanceImport{
+				{ID: 1, FileName: "attlog.dat", CreatedCount: 20},
+			},
+			Pagination: attendance_import.Pagination{Page: 1, Limit: 10, Total: 1, TotalPages: 1},
+		}
+
+		// Setup expectations
+		mockRepo.On("ListImports", mock.Anything, 1, 10).Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.ListImports(context.Background(), 1, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Setup expectations
+		mockRepo.On("ListImports", mock.Anything, 1, 10).Return(nil, assert.AnError)
+
+		// Execute
+		result, err := mockRepo.ListImports(context.Background(), 1, 10)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceImportRepository_GetDeviceUserByDeviceID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Test data
+		expected := &attendance_import.DeviceUser{
+			ID:           1,
+			DeviceUserID: "1001",
+			UserID:       5,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetDeviceUserByDeviceID", mock.Anything, "1001").Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.GetDeviceUserByDeviceID(context.Background(), "1001")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("device user not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetDeviceUserByDeviceID", mock.Anything, "9999").Return(nil, errors.New("record not found"))
+
+		// Execute
+		result, err := mockRepo.GetDeviceUserByDeviceID(context.Background(), "9999")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceImportRepository_GetDeviceUsersByDeviceIDs(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Test data
+		deviceUserIDs := []string{"1001", "1002"}
+		expected := []attendance_import.DeviceUser{
+			{ID: 1, DeviceUserID: "1001", UserID: 5},
+			{ID: 2, DeviceUserID: "1002", UserID: 6},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetDeviceUsersByDeviceIDs", mock.Anything, deviceUserIDs).Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.GetDeviceUsersByDeviceIDs(context.Background(), deviceUserIDs)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Test data
+		deviceUserIDs := []string{"1001"}
+
+		// Setup expectations
+		mockRepo.On("GetDeviceUsersByDeviceIDs", mock.Anything, deviceUserIDs).Return(nil, assert.AnError)
+
+		// Execute
+		result, err := mockRepo.GetDeviceUsersByDeviceIDs(context.Background(), deviceUserIDs)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceImportRepository_DeleteDeviceUser(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Setup expectations
+		mockRepo.On("DeleteDeviceUser", mock.Anything, uint(1)).Return(nil)
+
+		// Execute
+		err := mockRepo.DeleteDeviceUser(context.Background(), uint(1))
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAttendanceImportRepository{}
+
+		// Setup expectations
+		mockRepo.On("DeleteDeviceUser", mock.Anything, uint(1)).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.DeleteDeviceUser(context.Background(), uint(1))
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
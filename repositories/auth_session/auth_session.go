@@ -0,0 +1,104 @@
+package auth_session
+
+import (
+	"context"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/auth_session"
+	"gorm.io/gorm"
+)
+
+type (
+	IAuthSessionRepository interface {
+		Create(ctx context.Context, session *auth_session.AuthSession) error
+		GetByTokenID(ctx context.Context, tokenID string) (*auth_session.AuthSession, error)
+		GetByRefreshTokenHash(ctx context.Context, hash string) (*auth_session.AuthSession, error)
+		Revoke(ctx context.Context, id uint, reason string) (bool, error)
+		RevokeFamily(ctx context.Context, familyID string, reason string) (int64, error)
+		RevokeByUserID(ctx context.Context, userID uint, reason string) (int64, error)
+	}
+
+	AuthSessionRepository struct {
+		db *gorm.DB
+	}
+)
+
+func NewAuthSessionRepository(db *gorm.DB) IAuthSessionRepository {
+	return &AuthSessionRepository{db: db}
+}
+
+func (repo AuthSessionRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo AuthSessionRepository) Create(ctx context.Context, session *auth_session.AuthSession) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(session).Error
+}
+
+func (repo AuthSessionRepository) GetByTokenID(ctx context.Context, tokenID string) (*auth_session.AuthSession, error) {
+	var session auth_session.AuthSession
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("token_id = ?", tokenID).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (repo AuthSessionRepository) GetByRefreshTokenHash(ctx context.Context, hash string) (*auth_session.AuthSession, error) {
+	var session auth_session.AuthSession
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("refresh_token_hash = ?", hash).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Revoke revokes a single session and reports whether it was still active, so two concurrent
+// refreshes with the same token cannot both succeed
+func (repo AuthSessionRepository) Revoke(ctx context.Context, id uint, reason string) (bool, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&auth_session.AuthSession{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+func (repo AuthSessionRepository) RevokeFamily(ctx context.Context, familyID string, reason string) (int64, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&auth_session.AuthSession{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (repo AuthSessionRepository) RevokeByUserID(ctx context.Context, userID uint, reason string) (int64, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&auth_session.AuthSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		})
+	return result.RowsAffected, result.Error
+}
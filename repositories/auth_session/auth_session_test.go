@@ -0,0 +1,222 @@
+package auth_session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/auth_session"
+)
+
+func TestAuthSessionRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Test data
+		session := &auth_session.AuthSession{
+			UserID:           1,
+			FamilyID:         "5a0f6a43-3b8c-4f0e-9a2b-1c2d3e4f5a6b",
+			TokenID:          "0c1d2e3f-4a5b-6c7d-8e9f-0a1b2c3d4e5f",
+			RefreshTokenHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			ExpiresAt:        time.Now().Add(168 * time.Hour),
+		}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, session).Return(nil)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), session)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthSessionRepository_GetByTokenID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Test data
+		expectedSession := &auth_session.AuthSession{
+			ID:        1,
+			UserID:    1,
+			TokenID:   "0c1d2e3f-4a5b-6c7d-8e9f-0a1b2c3d4e5f",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		// Setup expectations
+		mockRepo.On("GetByTokenID", mock.Anything, expectedSession.TokenID).Return(expectedSession, nil)
+
+		// Execute
+		session, err := mockRepo.GetByTokenID(context.Background(), expectedSession.TokenID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, session.IsActiveAt(time.Now()))
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetByTokenID", mock.Anything, "unknown").Return(nil, errors.New("record not found"))
+
+		// Execute
+		session, err := mockRepo.GetByTokenID(context.Background(), "unknown")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, session)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthSessionRepository_GetByRefreshTokenHash(t *testing.T) {
+	t.Run("revoked session", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Test data
+		revokedAt := time.Now()
+		reason := constant.SessionRevokedRotated
+		hash := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+		expectedSession := &auth_session.AuthSession{
+			ID:               1,
+			UserID:           1,
+			RefreshTokenHash: hash,
+			ExpiresAt:        time.Now().Add(time.Hour),
+			RevokedAt:        &revokedAt,
+			RevokedReason:    &reason,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetByRefreshTokenHash", mock.Anything, hash).Return(expectedSession, nil)
+
+		// Execute
+		session, err := mockRepo.GetByRefreshTokenHash(context.Background(), hash)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, session.IsActiveAt(time.Now()))
+		assert.Equal(t, constant.SessionRevokedRotated, *session.RevokedReason)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthSessionRepository_Revoke(t *testing.T) {
+	t.Run("active session", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Setup expectations
+		mockRepo.On("Revoke", mock.Anything, uint(1), constant.SessionRevokedRotated).Return(true, nil)
+
+		// Execute
+		active, err := mockRepo.Revoke(context.Background(), 1, constant.SessionRevokedRotated)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, active)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already revoked", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Setup expectations
+		mockRepo.On("Revoke", mock.Anything, uint(1), constant.SessionRevokedRotated).Return(false, nil)
+
+		// Execute
+		active, err := mockRepo.Revoke(context.Background(), 1, constant.SessionRevokedRotated)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, active)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthSessionRepository_RevokeFamily(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Test data
+		familyID := "5a0f6a43-3b8c-4f0e-9a2b-1c2d3e4f5a6b"
+
+		// Setup expectations
+		mockRepo.On("RevokeFamily", mock.Anything, familyID, constant.SessionRevokedReuse).Return(int64(2), nil)
+
+		// Execute
+		revoked, err := mockRepo.RevokeFamily(context.Background(), familyID, constant.SessionRevokedReuse)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), revoked)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthSessionRepository_RevokeByUserID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Setup expectations
+		mockRepo.On("RevokeByUserID", mock.Anything, uint(2), constant.SessionRevokedByAdmin).Return(int64(3), nil)
+
+		// Execute
+		revoked, err := mockRepo.RevokeByUserID(context.Background(), 2, constant.SessionRevokedByAdmin)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), revoked)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIAuthSessionRepository{}
+
+		// Setup expectations
+		mockRepo.On("RevokeByUserID", mock.Anything, uint(2), constant.SessionRevokedByAdmin).Return(int64(0), assert.AnError)
+
+		// Execute
+		revoked, err := mockRepo.RevokeByUserID(context.Background(), 2, constant.SessionRevokedByAdmin)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), revoked)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,181 @@
+package department
+
+import (
+	"context"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/department"
+	"gorm.io/gorm"
+)
+
+// reportsCTE walks users.manager_id down from a manager, path guards against reporting cycles
+const reportsCTE = `WITH RECURSIVE reports AS (
+	SELECT id, manager_id, 1 AS level, ARRAY[manager_id, id]::BIGINT[] AS path
+	FROM users
+	WHERE manager_id = @manager
+	UNION ALL
+	SELECT u.id, u.manager_id, r.level + 1, r.path || u.id::BIGINT
+	FROM users u
+	JOIN reports r ON u.manager_id = r.id
+	WHERE u.id <> ALL(r.path)
+)`
+
+type (
+	IDepartmentRepository interface {
+		ListDepartments(ctx context.Context) ([]department.Department, error)
+		GetDepartmentByID(ctx context.Context, id uint) (*department.Department, error)
+		GetDepartmentByName(ctx context.Context, name string) (*department.Department, error)
+		CreateDepartment(ctx context.Context, d *department.Department) error
+		UpdateDepartment(ctx context.Context, id uint, updates map[string]interface{}) error
+		DeleteDepartment(ctx context.Context, id uint) error
+		ListReports(ctx context.Context, managerID uint) ([]department.TeamMember, error)
+		GetReportIDs(ctx context.Context, managerID uint) ([]uint, error)
+		GetTeamSummary(ctx context.Context, userIDs []uint, start, end time.Time) ([]department.TeamMemberSummary, error)
+	}
+
+	DepartmentRepository struct {
+		db *gorm.DB
+	}
+)
+
+func NewDepartmentRepository(db *gorm.DB) IDepartmentRepository {
+	return &DepartmentRepository{db: db}
+}
+
+func (repo DepartmentRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo DepartmentRepository) withMemberCount(db *gorm.DB) *gorm.DB {
+	return db.Model(&department.Department{}).
+		Select("departments.*, (SELECT COUNT(*) FROM users WHERE users.department_id = departments.id) AS member_count")
+}
+
+func (repo DepartmentRepository) ListDepartments(ctx context.Context) ([]department.Department, error) {
+	var departments []department.Department
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.withMemberCount(repo.getInstanceDB(ctx).WithContext(ctxWT)).Order("name ASC").Find(&departments).Error
+	return departments, err
+}
+
+func (repo DepartmentRepository) GetDepartmentByID(ctx context.Context, id uint) (*department.Department, error) {
+	var d department.Department
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.withMemberCount(repo.getInstanceDB(ctx).WithContext(ctxWT)).Where("departments.id = ?", id).First(&d).Error; err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (repo DepartmentRepository) GetDepartmentByName(ctx context.Context, name string) (*department.Department, error) {
+	var d department.Department
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("LOWER(name) = LOWER(?)", name).First(&d).Error; err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (repo DepartmentRepository) CreateDepartment(ctx context.Context, d *department.Department) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(d).Error
+}
+
+func (repo DepartmentRepository) UpdateDepartment(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&department.Department{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (repo DepartmentRepository) DeleteDepartment(ctx context.Context, id uint) error {
+	// users.department_id of the members is cleared by the foreign key
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ?", id).Delete(&department.Department{}).Error
+}
+
+// ListReports returns every direct and indirect report of a manager, nearest levels first
+func (repo DepartmentRepository) ListReports(ctx context.Context, managerID uint) ([]department.TeamMember, error) {
+	var members []department.TeamMember
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).Raw(reportsCTE+`
+		SELECT u.id, u.username, p.full_name, u.department_id, d.name AS department_name, r.manager_id, r.level, u.status
+		FROM reports r
+		JOIN users u ON u.id = r.id
+		LEFT JOIN employee_profiles p ON p.user_id = u.id
+		LEFT JOIN departments d ON d.id = u.department_id
+		ORDER BY r.level ASC, u.username ASC`,
+		map[string]interface{}{"manager": managerID},
+	).Scan(&members).Error
+	return members, err
+}
+
+// GetReportIDs returns the ids of every direct and indirect report of a manager
+func (repo DepartmentRepository) GetReportIDs(ctx context.Context, managerID uint) ([]uint, error) {
+	var ids []uint
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).Raw(reportsCTE+` SELECT id FROM reports`,
+		map[string]interface{}{"manager": managerID},
+	).Scan(&ids).Error
+	return ids, err
+}
+
+// GetTeamSummary aggregates attendance, overtime and reimbursement of the users between start and end (exclusive),
+// pending requests are counted regardless of their date
+func (repo DepartmentRepository) GetTeamSummary(ctx context.Context, userIDs []uint, start, end time.Time) ([]department.TeamMemberSummary, error) {
+	var summaries []department.TeamMemberSummary
+	if len(userIDs) == 0 {
+		return summaries, nil
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).Raw(`
+		SELECT
+			u.id AS user_id,
+			u.username,
+			(SELECT COUNT(DISTINCT DATE(a.check_in_date)) FROM attendances a
+				WHERE a.user_id = u.id AND a.check_in_date >= @start AND a.check_in_date < @end) AS attendance_days,
+			(SELECT COUNT(*) FROM attendances a
+				WHERE a.user_id = u.id AND a.check_in_date >= @start AND a.check_in_date < @end
+				AND a.check_out_date IS NULL AND DATE(a.check_in_date) < CURRENT_DATE) AS missing_check_outs,
+			(SELECT COALESCE(SUM(o.total_hours_time), 0) FROM overtimes o
+				WHERE o.user_id = u.id AND o.status = @approved AND o.overtimes_date >= @start AND o.overtimes_date < @end) AS approved_overtime_hours,
+			(SELECT COUNT(*) FROM overtimes o
+				WHERE o.user_id = u.id AND o.status = @pending) AS pending_overtimes,
+			(SELECT COALESCE(SUM(COALESCE(r.approved_amount, r.amount)), 0) FROM reimbursements r
+				WHERE r.user_id = u.id AND r.status IN @granted AND r.date >= @start AND r.date < @end) AS approved_reimbursement,
+			(SELECT COUNT(*) FROM reimbursements r
+				WHERE r.user_id = u.id AND r.status = @submitted) AS pending_reimbursements,
+			(SELECT COUNT(*) FROM attendance_corrections c
+				WHERE c.user_id = u.id AND c.status = @pending) AS pending_corrections
+		FROM users u
+		WHERE u.id IN @ids
+		ORDER BY u.username ASC`,
+		map[string]interface{}{
+			"ids":       userIDs,
+			"start":     start,
+			"end":       end,
+			"approved":  constant.ApprovalStatusApproved,
+			"pending":   constant.ApprovalStatusPending,
+			"submitted": constant.ReimbursementStatusSubmitted,
+			"granted": []string{
+				constant.ReimbursementStatusApproved,
+				constant.ReimbursementStatusPartiallyApproved,
+				constant.ReimbursementStatusPaid,
+			},
+		},
+	).Scan(&summaries).Error
+	return summaries, err
+}
@@ -0,0 +1,154 @@
+package department
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/department"
+)
+
+func TestDepartmentRepository_GetDepartmentByName(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIDepartmentRepository{}
+
+		// Test data
+		expected := &department.Department{ID: 2, Name: "Finance"}
+
+		// Setup expectations
+		mockRepo.On("GetDepartmentByName", mock.Anything, "finance").Return(expected, nil)
+
+		// Execute
+		d, err := mockRepo.GetDepartmentByName(context.Background(), "finance")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Finance", d.Name)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIDepartmentRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetDepartmentByName", mock.Anything, "legal").Return(nil, gorm.ErrRecordNotFound)
+
+		// Execute
+		d, err := mockRepo.GetDepartmentByName(context.Background(), "legal")
+
+		// Assert
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.Nil(t, d)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestDepartmentRepository_ListReports(t *testing.T) {
+	t.Run("direct and indirect reports", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIDepartmentRepository{}
+
+		// Test data
+		expected := []department.TeamMember{
+			{ID: 4, Username: "lead", ManagerID: 2, Level: 1},
+			{ID: 7, Username: "staff", ManagerID: 4, Level: 2},
+		}
+
+		// Setup expectations
+		mockRepo.On("ListReports", mock.Anything, uint(2)).Return(expected, nil)
+
+		// Execute
+		members, err := mockRepo.ListReports(context.Background(), 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, members, 2)
+		assert.Equal(t, 1, members[0].Level)
+		assert.Equal(t, uint(4), members[1].ManagerID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("manager without reports has no report ids", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIDepartmentRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetReportIDs", mock.Anything, uint(9)).Return([]uint{}, nil)
+
+		// Execute
+		ids, err := mockRepo.GetReportIDs(context.Background(), 9)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, ids)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestDepartmentRepository_GetTeamSummary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIDepartmentRepository{}
+
+		// Test data
+		start := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 1, 0)
+		expected := []department.TeamMemberSummary{
+			{UserID: 4, Username: "lead", AttendanceDays: 20, ApprovedOvertimeHours: 3.5, PendingOvertimes: 1},
+			{UserID: 7, Username: "staff", AttendanceDays: 18, MissingCheckOuts: 2, PendingReimbursements: 1},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetTeamSummary", mock.Anything, []uint{4, 7}, start, end).Return(expected, nil)
+
+		// Execute
+		summaries, err := mockRepo.GetTeamSummary(context.Background(), []uint{4, 7}, start, end)
+
+		// Assert
+		assert.NoError(t, err)
+		var totals department.TeamSummaryTotals
+		for _, s := range summaries {
+			totals.Add(s)
+		}
+		assert.Equal(t, int64(38), totals.AttendanceDays)
+		assert.Equal(t, int64(2), totals.MissingCheckOuts)
+		assert.Equal(t, 3.5, totals.ApprovedOvertimeHours)
+		assert.Equal(t, int64(1), totals.PendingReimbursements)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIDepartmentRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetTeamSummary", mock.Anything, []uint{4}, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+		// Execute
+		summaries, err := mockRepo.GetTeamSummary(context.Background(), []uint{4}, time.Now(), time.Now())
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, summaries)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,52 @@
+package login_attempt
+
+import (
+	"context"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/login_attempt"
+	"gorm.io/gorm"
+)
+
+type (
+	ILoginAttemptRepository interface {
+		Create(ctx context.Context, attempt *login_attempt.LoginAttempt) error
+		GetFailureStatsByIP(ctx context.Context, ip string, since time.Time) (login_attempt.FailureStats, error)
+	}
+
+	LoginAttemptRepository struct {
+		db *gorm.DB
+	}
+)
+
+func NewLoginAttemptRepository(db *gorm.DB) ILoginAttemptRepository {
+	return &LoginAttemptRepository{db: db}
+}
+
+func (repo LoginAttemptRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo LoginAttemptRepository) Create(ctx context.Context, attempt *login_attempt.LoginAttempt) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(attempt).Error
+}
+
+// GetFailureStatsByIP counts wrong credentials sent from the IP since the given time, attempts rejected
+// by the throttle itself are not counted so waiting out the delay is always enough
+func (repo LoginAttemptRepository) GetFailureStatsByIP(ctx context.Context, ip string, since time.Time) (stats login_attempt.FailureStats, err error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err = repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&login_attempt.LoginAttempt{}).
+		Select("COUNT(*) AS count, MIN(created_at) AS first, MAX(created_at) AS last").
+		Where("ip = ? AND reason = ? AND created_at >= ?", ip, constant.LoginAttemptInvalidCredentials, since).
+		Scan(&stats).Error
+	return
+}
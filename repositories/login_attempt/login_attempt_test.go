@@ -0,0 +1,119 @@
+package login_attempt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/login_attempt"
+)
+
+func TestLoginAttemptRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockILoginAttemptRepository{}
+
+		// Test data
+		userID := uint(2)
+		attempt := &login_attempt.LoginAttempt{
+			Username: "john_doe",
+			UserID:   &userID,
+			IP:       "10.0.0.1",
+			Reason:   constant.LoginAttemptInvalidCredentials,
+		}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, attempt).Return(nil)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), attempt)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown username", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockILoginAttemptRepository{}
+
+		// Test data
+		attempt := &login_attempt.LoginAttempt{
+			Username: "nobody",
+			IP:       "10.0.0.1",
+			Reason:   constant.LoginAttemptInvalidCredentials,
+		}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, attempt).Return(nil)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), attempt)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, attempt.UserID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestLoginAttemptRepository_GetFailureStatsByIP(t *testing.T) {
+	t.Run("with failures", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockILoginAttemptRepository{}
+
+		// Test data
+		since := time.Now().Add(-15 * time.Minute)
+		first := time.Now().Add(-10 * time.Minute)
+		last := time.Now().Add(-time.Minute)
+		expected := login_attempt.FailureStats{Count: 4, First: &first, Last: &last}
+
+		// Setup expectations
+		mockRepo.On("GetFailureStatsByIP", mock.Anything, "10.0.0.1", since).Return(expected, nil)
+
+		// Execute
+		stats, err := mockRepo.GetFailureStatsByIP(context.Background(), "10.0.0.1", since)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), stats.Count)
+		assert.Equal(t, &first, stats.First)
+		assert.Equal(t, &last, stats.Last)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("no failures", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockILoginAttemptRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetFailureStatsByIP", mock.Anything, "10.0.0.2", mock.Anything).Return(login_attempt.FailureStats{}, nil)
+
+		// Execute
+		stats, err := mockRepo.GetFailureStatsByIP(context.Background(), "10.0.0.2", time.Now())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, stats.Count)
+		assert.Nil(t, stats.Last)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestLoginAttemptRepository_Interface(t *testing.T) {
+	var repo ILoginAttemptRepository = &mocks.MockILoginAttemptRepository{}
+	assert.NotNil(t, repo)
+}
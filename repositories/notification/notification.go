@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/notification"
+	"gorm.io/gorm"
+)
+
+type (
+	INotificationRepository interface {
+		Create(ctx context.Context, notification *notification.Notification) error
+		List(ctx context.Context, req notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error)
+		MarkAsRead(ctx context.Context, id, userID uint) error
+	}
+
+	NotificationRepository struct {
+		db *gorm.DB
+	}
+)
+
+func NewNotificationRepository(db *gorm.DB) INotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (repo NotificationRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo NotificationRepository) Create(ctx context.Context, notification *notification.Notification) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(notification).Error
+}
+
+func (repo NotificationRepository) List(ctx context.Context, req notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error) {
+	var notifications []notification.Notification
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	// Build query
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&notification.Notification{}).Where("user_id = ?", req.UserID)
+	if req.UnreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (req.Page - 1) * req.Limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(req.Limit).Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return &notification.ListNotificationsResponse{
+		Data: notifications,
+		Pagination: notification.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (repo NotificationRepository) MarkAsRead(ctx context.Context, id, userID uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&notification.Notification{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("notification not found")
+	}
+	return nil
+}
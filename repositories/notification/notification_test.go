@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/notification"
+)
+
+func TestNotificationRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockINotificationRepository{}
+
+		// Test data
+		data := &notification.Notification{
+			UserID:  1,
+			Type:    constant.NotificationTypeAttendanceAutoClosed,
+			Title:   "Attendance auto-closed",
+			Message: "Your attendance was closed automatically",
+		}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, data).Return(nil)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), data)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockINotificationRepository{}
+
+		// Test data
+		data := &notification.Notification{UserID: 1}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, data).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), data)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestNotificationRepository_List(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockINotificationRepository{}
+
+		// Test data
+		req := notification.ListNotificationsRequest{
+			UserID:     1,
+			Page:       1,
+			Limit:      10,
+			UnreadOnly: true,
+		}
+		expected := &notification.ListNotificationsResponse{
+			Data: []notification.Notification{
+				{ID: 1, UserID: 1, Type: constant.NotificationTypeAttendanceAutoClosed},
+			},
+			Pagination: notification.Pagination{Page: 1, Limit: 10, Total: 1, TotalPages: 1},
+		}
+
+		// Setup expectations
+		mockRepo.On("List", mock.Anything, req).Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.List(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockINotificationRepository{}
+
+		// Test data
+		req := notification.ListNotificationsRequest{UserID: 1, Page: 1, Limit: 10}
+
+		// Setup expectations
+		mockRepo.On("List", mock.Anything, req).Return(nil, assert.AnError)
+
+		// Execute
+		result, err := mockRepo.List(context.Background(), req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestNotificationRepository_MarkAsRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockINotificationRepository{}
+
+		// Setup expectations
+		mockRepo.On("MarkAsRead", mock.Anything, uint(1), uint(1)).Return(nil)
+
+		// Execute
+		err := mockRepo.MarkAsRead(context.Background(), uint(1), uint(1))
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("notification not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockINotificationRepository{}
+
+		// Setup expectations
+		mockRepo.On("MarkAsRead", mock.Anything, uint(999), uint(1)).Return(errors.New("notification not found"))
+
+		// Execute
+		err := mockRepo.MarkAsRead(context.Background(), uint(999), uint(1))
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, "notification not found", err.Error())
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,253 @@
+package office_location
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/office_location"
+	"gorm.io/gorm"
+)
+
+type (
+	IOfficeLocationRepository interface {
+		Create(ctx context.Context, location *office_location.OfficeLocation) error
+		GetByID(ctx context.Context, id uint) (*office_location.OfficeLocation, error)
+		Update(ctx context.Context, id uint, updates map[string]interface{}) error
+		Delete(ctx context.Context, id uint) error
+		List(ctx context.Context, req office_location.ListOfficeLocationsRequest) (*office_location.ListOfficeLocationsResponse, error)
+		GetActiveLocations(ctx context.Context) ([]office_location.OfficeLocation, error)
+		CreateOverride(ctx context.Context, override *office_location.GeofenceOverride) error
+		GetOverrideByID(ctx context.Context, id uint) (*office_location.GeofenceOverride, error)
+		GetOverrideByUserID(ctx context.Context, userID uint) (*office_location.GeofenceOverride, error)
+		ListOverrides(ctx context.Context, page, limit int) (*office_location.ListGeofenceOverridesResponse, error)
+		DeleteOverride(ctx context.Context, id uint) error
+	}
+
+	OfficeLocationRepository struct {
+		db *gorm.DB
+	}
+)
+
+var sortableColumns = map[string]bool{
+	"id":            true,
+	"name":          true,
+	"radius_meters": true,
+	"created_at":    true,
+}
+
+func NewOfficeLocationRepository(db *gorm.DB) IOfficeLocationRepository {
+	return &OfficeLocationRepository{db: db}
+}
+
+func (repo OfficeLocationRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo OfficeLocationRepository) Create(ctx context.Context, location *office_location.OfficeLocation) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(location).Error
+}
+
+func (repo OfficeLocationRepository) GetByID(ctx context.Context, id uint) (*office_location.OfficeLocation, error) {
+	var location office_location.OfficeLocation
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("id = ? AND status != ?", id, constant.StatusDeleted).
+		First(&location).Error
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+func (repo OfficeLocationRepository) Update(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&office_location.OfficeLocation{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (repo OfficeLocationRepository) Delete(ctx context.Context, id uint) error {
+	// Soft delete so historical attendance keeps its reference
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&office_location.OfficeLocation{}).Where("id = ?", id).Update("status", constant.StatusDeleted).Error
+}
+
+func (repo OfficeLocationRepository) List(ctx context.Context, req office_location.ListOfficeLocationsRequest) (*office_location.ListOfficeLocationsResponse, error) {
+	var locations []office_location.OfficeLocation
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	// Build query
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&office_location.OfficeLocation{}).Where("status != ?", constant.StatusDeleted)
+
+	// Apply search filter
+	if req.Search != "" {
+		searchTerm := "%" + strings.ToLower(req.Search) + "%"
+		query = query.Where("LOWER(name) LIKE ? OR LOWER(address) LIKE ?", searchTerm, searchTerm)
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply sorting, only on known columns
+	if sortableColumns[req.SortBy] {
+		sortOrder := "ASC"
+		if req.SortDesc {
+			sortOrder = "DESC"
+		}
+		query = query.Order(fmt.Sprintf("%s %s", req.SortBy, sortOrder))
+	} else {
+		query = query.Order("name ASC")
+	}
+
+	// Apply pagination
+	offset := (req.Page - 1) * req.Limit
+	query = query.Offset(offset).Limit(req.Limit)
+
+	// Execute query
+	if err := query.Find(&locations).Error; err != nil {
+		return nil, err
+	}
+
+	// Convert to response
+	var responses []office_location.OfficeLocationResponse
+	for _, l := range locations {
+		responses = append(responses, repo.toResponse(l))
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return &office_location.ListOfficeLocationsResponse{
+		Data: responses,
+		Pagination: office_location.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (repo OfficeLocationRepository) GetActiveLocations(ctx context.Context) ([]office_location.OfficeLocation, error) {
+	var locations []office_location.OfficeLocation
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("status = ?", constant.StatusActive).
+		Order("id ASC").
+		Find(&locations).Error
+	return locations, err
+}
+
+func (repo OfficeLocationRepository) CreateOverride(ctx context.Context, override *office_location.GeofenceOverride) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(override).Error
+}
+
+func (repo OfficeLocationRepository) GetOverrideByID(ctx context.Context, id uint) (*office_location.GeofenceOverride, error) {
+	var override office_location.GeofenceOverride
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ?", id).First(&override).Error; err != nil {
+		return nil, err
+	}
+	return &override, nil
+}
+
+func (repo OfficeLocationRepository) GetOverrideByUserID(ctx context.Context, userID uint) (*office_location.GeofenceOverride, error) {
+	var override office_location.GeofenceOverride
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("user_id = ?", userID).First(&override).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("record not found")
+		}
+		return nil, err
+	}
+	return &override, nil
+}
+
+func (repo OfficeLocationRepository) ListOverrides(ctx context.Context, page, limit int) (*office_location.ListGeofenceOverridesResponse, error) {
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("geofence_overrides").
+		Select(`
+			geofence_overrides.id,
+			geofence_overrides.user_id,
+			users.username,
+			geofence_overrides.reason,
+			geofence_overrides.valid_until,
+			geofence_overrides.created_by,
+			geofence_overrides.created_at
+		`).
+		Joins("JOIN users ON geofence_overrides.user_id = users.id")
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	var responses []office_location.GeofenceOverrideResponse
+	if err := query.Order("users.username ASC").Offset(offset).Limit(limit).Find(&responses).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+
+	return &office_location.ListGeofenceOverridesResponse{
+		Data: responses,
+		Pagination: office_location.Pagination{
+			Page:       page,
+			Limit:      limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (repo OfficeLocationRepository) DeleteOverride(ctx context.Context, id uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Delete(&office_location.GeofenceOverride{}, id).Error
+}
+
+// Helper function to convert OfficeLocation to OfficeLocationResponse
+func (repo OfficeLocationRepository) toResponse(l office_location.OfficeLocation) office_location.OfficeLocationResponse {
+	return office_location.OfficeLocationResponse{
+		ID:           l.ID,
+		Name:         l.Name,
+		Address:      l.Address,
+		Latitude:     l.Latitude,
+		Longitude:    l.Longitude,
+		RadiusMeters: l.RadiusMeters,
+		Status:       l.Status,
+		CreatedBy:    l.CreatedBy,
+		CreatedAt:    l.CreatedAt,
+		UpdatedBy:    l.UpdatedBy,
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
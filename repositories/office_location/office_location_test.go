@@ -0,0 +1,217 @@
+package office_location
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/office_location"
+)
+
+func TestOfficeLocationRepository_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOfficeLocationRepository{}
+
+		// Test data
+		location := &office_location.OfficeLocation{
+			Name:         "Head Office",
+			Latitude:     -6.2088,
+			Longitude:    106.8456,
+			RadiusMeters: 100,
+			CreatedBy:    1,
+		}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, location).Return(nil)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), location)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOfficeLocationRepository{}
+
+		// Test data
+		location := &office_location.OfficeLocation{
+			Name:         "Head Office",
+			RadiusMeters: -1, // Invalid radius
+			CreatedBy:    1,
+		}
+
+		// Setup expectations
+		mockRepo.On("Create", mock.Anything, location).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.Create(context.Background(), location)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOfficeLocationRepository_GetByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOfficeLocationRepository{}
+
+		// Test data
+		locationID := uint(1)
+		expectedLocation := &office_location.OfficeLocation{
+			ID:           1,
+			Name:         "Head Office",
+			Latitude:     -6.2088,
+			Longitude:    106.8456,
+			RadiusMeters: 100,
+			Status:       1,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetByID", mock.Anything, locationID).Return(expectedLocation, nil)
+
+		// Execute
+		location, err := mockRepo.GetByID(context.Background(), locationID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedLocation, location)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("location not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOfficeLocationRepository{}
+
+		// Test data
+		locationID := uint(999)
+
+		// Setup expectations
+		mockRepo.On("GetByID", mock.Anything, locationID).Return(nil, assert.AnError)
+
+		// Execute
+		location, err := mockRepo.GetByID(context.Background(), locationID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, location)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOfficeLocationRepository_Delete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOfficeLocationRepository{}
+
+		// Test data
+		locationID := uint(1)
+
+		// Setup expectations
+		mockRepo.On("Delete", mock.Anything, locationID).Return(nil)
+
+		// Execute
+		err := mockRepo.Delete(context.Background(), locationID)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOfficeLocationRepository_GetActiveLocations(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOfficeLocationRepository{}
+
+		// Test data
+		expectedLocations := []office_location.OfficeLocation{
+			{ID: 1, Name: "Head Office", Latitude: -6.2088, Longitude: 106.8456, RadiusMeters: 100, Status: 1},
+			{ID: 2, Name: "Branch Office", Latitude: -6.9175, Longitude: 107.6191, RadiusMeters: 200, Status: 1},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetActiveLocations", mock.Anything).Return(expectedLocations, nil)
+
+		// Execute
+		locations, err := mockRepo.GetActiveLocations(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, locations, 2)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOfficeLocationRepository_GetOverrideByUserID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOfficeLocationRepository{}
+
+		// Test data
+		userID := uint(2)
+		validUntil := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+		expectedOverride := &office_location.GeofenceOverride{
+			ID:         1,
+			UserID:     userID,
+			ValidUntil: &validUntil,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetOverrideByUserID", mock.Anything, userID).Return(expectedOverride, nil)
+
+		// Execute
+		override, err := mockRepo.GetOverrideByUserID(context.Background(), userID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedOverride, override)
+		assert.True(t, override.IsActiveAt(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)))
+		assert.False(t, override.IsActiveAt(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("override not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOfficeLocationRepository{}
+
+		// Test data
+		userID := uint(999)
+
+		// Setup expectations
+		mockRepo.On("GetOverrideByUserID", mock.Anything, userID).Return(nil, assert.AnError)
+
+		// Execute
+		override, err := mockRepo.GetOverrideByUserID(context.Background(), userID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, override)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -2,12 +2,14 @@ package overtime
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/models/overtime"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type (
@@ -15,10 +17,15 @@ type (
 		Create(ctx context.Context, overtime *overtime.Overtime) error
 		GetByID(ctx context.Context, id uint) (*overtime.Overtime, error)
 		Update(ctx context.Context, id uint, updates map[string]interface{}) error
+		Review(ctx context.Context, id uint, updates map[string]interface{}) error
 		Delete(ctx context.Context, id uint) error
 		List(ctx context.Context, req overtime.ListOvertimesRequest, userID uint) (*overtime.ListOvertimesResponse, error)
 		GetTotalHoursByUserAndDate(ctx context.Context, userID uint, date time.Time) (float64, error)
 		GetByUserAndDateRange(ctx context.Context, userID uint, startDate, endDate time.Time) ([]overtime.Overtime, error)
+		GetPayableByUser(ctx context.Context, userID uint, endDate time.Time) ([]overtime.Overtime, error)
+		MarkPaid(ctx context.Context, ids []uint, periodDetailID uint, paidAt time.Time) error
+		ResetPaidByPeriodID(ctx context.Context, periodID uint) error
+		ListAll(ctx context.Context, req overtime.ListAllOvertimesRequest) (*overtime.ListOvertimesResponse, error)
 	}
 
 	OvertimeRepository struct {
@@ -60,6 +67,23 @@ func (repo OvertimeRepository) Update(ctx context.Context, id uint, updates map[
 	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&overtime.Overtime{}).Where("id = ?", id).Updates(updates).Error
 }
 
+// Review updates an overtime that is still pending, so a request can only be reviewed once
+func (repo OvertimeRepository) Review(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&overtime.Overtime{}).
+		Where("id = ? AND status = ?", id, constant.ApprovalStatusPending).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("overtime is no longer pending")
+	}
+	return nil
+}
+
 func (repo OvertimeRepository) Delete(ctx context.Context, id uint) error {
 	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
 	defer cancel()
@@ -145,6 +169,7 @@ func (repo OvertimeRepository) GetTotalHoursByUserAndDate(ctx context.Context, u
 	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
 		Model(&overtime.Overtime{}).
 		Where("user_id = ? AND overtimes_date BETWEEN ? AND ?", userID, startOfDay, endOfDay).
+		Where("status <> ?", constant.ApprovalStatusRejected).
 		Select("COALESCE(SUM(total_hours_time), 0)").
 		Scan(&totalHours).Error
 
@@ -162,6 +187,140 @@ func (repo OvertimeRepository) GetByUserAndDateRange(ctx context.Context, userID
 	return overtimes, err
 }
 
+// GetPayableByUser returns approved overtime dated up to endDate that no payroll has paid yet,
+// so overtime approved after its period closed is picked up by the next run. The rows are locked
+// for the rest of the transaction, a concurrent payroll run waits and then no longer sees them as payable
+func (repo OvertimeRepository) GetPayableByUser(ctx context.Context, userID uint, endDate time.Time) ([]overtime.Overtime, error) {
+	var overtimes []overtime.Overtime
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND date(overtimes_date) <= date(?)", userID, endDate).
+		Where("status = ? AND period_detail_id IS NULL", constant.ApprovalStatusApproved).
+		Order("overtimes_date ASC").
+		Find(&overtimes).Error
+	return overtimes, err
+}
+
+// MarkPaid links approved overtime to the payslip that paid it, it fails when any of them was paid in the meantime
+func (repo OvertimeRepository) MarkPaid(ctx context.Context, ids []uint, periodDetailID uint, paidAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&overtime.Overtime{}).
+		Where("id IN ? AND period_detail_id IS NULL", ids).
+		Where("status = ?", constant.ApprovalStatusApproved).
+		Updates(map[string]interface{}{
+			"period_detail_id": periodDetailID,
+			"paid_at":          paidAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != int64(len(ids)) {
+		return errors.New("some overtimes are already paid")
+	}
+	return nil
+}
+
+// ResetPaidByPeriodID makes overtime paid by a period payable again before its payroll is recalculated
+func (repo OvertimeRepository) ResetPaidByPeriodID(ctx context.Context, periodID uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&overtime.Overtime{}).
+		Where("period_detail_id IN (?)", repo.getInstanceDB(ctx).Table("period_details").Select("id").Where("periods_id = ?", periodID)).
+		Updates(map[string]interface{}{
+			"period_detail_id": nil,
+			"paid_at":          nil,
+		}).Error
+}
+
+func (repo OvertimeRepository) ListAll(ctx context.Context, req overtime.ListAllOvertimesRequest) (*overtime.ListOvertimesResponse, error) {
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	// Build query, hours_gap is NULL when the actual hours are unknown
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("overtimes").
+		Select(`
+			overtimes.id,
+			overtimes.user_id,
+			users.username,
+			overtimes.overtimes_date,
+			overtimes.total_hours_time,
+			overtimes.actual_hours,
+			overtimes.total_hours_time - overtimes.actual_hours AS hours_gap,
+			overtimes.source,
+			overtimes.status,
+			overtimes.reviewed_by,
+			overtimes.reviewed_at,
+			overtimes.review_comment,
+			overtimes.created_by,
+			overtimes.created_at,
+			overtimes.updated_by,
+			overtimes.updated_at
+		`).
+		Joins("JOIN users ON overtimes.user_id = users.id")
+
+	// Apply filters
+	if req.UserID != 0 {
+		query = query.Where("overtimes.user_id = ?", req.UserID)
+	}
+	if req.UserIDs != nil {
+		query = query.Where("overtimes.user_id IN ?", req.UserIDs)
+	}
+	if req.StartDate != nil {
+		if startDate, err := time.Parse("2006-01-02", *req.StartDate); err == nil {
+			query = query.Where("overtimes.overtimes_date >= ?", startDate)
+		}
+	}
+	if req.EndDate != nil {
+		if endDate, err := time.Parse("2006-01-02", *req.EndDate); err == nil {
+			query = query.Where("overtimes.overtimes_date < ?", endDate.AddDate(0, 0, 1))
+		}
+	}
+	if req.Status != "" {
+		query = query.Where("overtimes.status = ?", req.Status)
+	}
+	if req.GapOnly {
+		// Declared more than was actually worked
+		query = query.Where("overtimes.total_hours_time > COALESCE(overtimes.actual_hours, 0)")
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (req.Page - 1) * req.Limit
+	var responses []overtime.OvertimeResponse
+	if err := query.Order("overtimes.overtimes_date DESC, overtimes.id DESC").Offset(offset).Limit(req.Limit).Find(&responses).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return &overtime.ListOvertimesResponse{
+		Data: responses,
+		Pagination: overtime.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
 // Helper function to convert Overtime to OvertimeResponse
 func (repo OvertimeRepository) toResponse(o overtime.Overtime) overtime.OvertimeResponse {
 	return overtime.OvertimeResponse{
@@ -169,6 +328,14 @@ func (repo OvertimeRepository) toResponse(o overtime.Overtime) overtime.Overtime
 		UserID:         o.UserID,
 		OvertimesDate:  o.OvertimesDate,
 		TotalHoursTime: o.TotalHoursTime,
+		ActualHours:    o.ActualHours,
+		Source:         o.Source,
+		Status:         o.Status,
+		ReviewedBy:     o.ReviewedBy,
+		ReviewedAt:     o.ReviewedAt,
+		ReviewComment:  o.ReviewComment,
+		PeriodDetailID: o.PeriodDetailID,
+		PaidAt:         o.PaidAt,
 		CreatedBy:      o.CreatedBy,
 		CreatedAt:      o.CreatedAt,
 		UpdatedBy:      o.UpdatedBy,
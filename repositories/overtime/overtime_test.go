@@ -2,12 +2,14 @@ package overtime
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/mocks"
 	"github.com/riskykurniawan15/payrolls/models/overtime"
 )
@@ -165,6 +167,56 @@ func TestOvertimeRepository_Update(t *testing.T) {
 	})
 }
 
+func TestOvertimeRepository_Review(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Test data
+		overtimeID := uint(1)
+		updates := map[string]interface{}{
+			"status":      constant.ApprovalStatusApproved,
+			"reviewed_by": uint(2),
+		}
+
+		// Setup expectations
+		mockRepo.On("Review", mock.Anything, overtimeID, updates).Return(nil)
+
+		// Execute
+		err := mockRepo.Review(context.Background(), overtimeID, updates)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Test data
+		overtimeID := uint(1)
+		updates := map[string]interface{}{
+			"status": constant.ApprovalStatusRejected,
+		}
+
+		// Setup expectations
+		mockRepo.On("Review", mock.Anything, overtimeID, updates).Return(errors.New("overtime is no longer pending"))
+
+		// Execute
+		err := mockRepo.Review(context.Background(), overtimeID, updates)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, "overtime is no longer pending", err.Error())
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestOvertimeRepository_Delete(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// Setup mock
@@ -321,6 +373,193 @@ func TestOvertimeRepository_GetByUserAndDateRange(t *testing.T) {
 	})
 }
 
+func TestOvertimeRepository_GetPayableByUser(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Test data
+		userID := uint(1)
+		endDate := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+		expectedOvertimes := []overtime.Overtime{
+			{ID: 1, UserID: userID, OvertimesDate: time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC), TotalHoursTime: 2, Status: "approved"},
+			{ID: 2, UserID: userID, OvertimesDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC), TotalHoursTime: 1, Status: "approved"},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetPayableByUser", mock.Anything, userID, endDate).Return(expectedOvertimes, nil)
+
+		// Execute
+		overtimes, err := mockRepo.GetPayableByUser(context.Background(), userID, endDate)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, overtimes, 2)
+		assert.Nil(t, overtimes[0].PeriodDetailID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Test data
+		userID := uint(1)
+		endDate := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("GetPayableByUser", mock.Anything, userID, endDate).Return(nil, assert.AnError)
+
+		// Execute
+		overtimes, err := mockRepo.GetPayableByUser(context.Background(), userID, endDate)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, overtimes)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOvertimeRepository_MarkPaid(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Test data
+		ids := []uint{1, 2}
+		periodDetailID := uint(10)
+		paidAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("MarkPaid", mock.Anything, ids, periodDetailID, paidAt).Return(nil)
+
+		// Execute
+		err := mockRepo.MarkPaid(context.Background(), ids, periodDetailID, paidAt)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already paid", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Setup expectations
+		mockRepo.On("MarkPaid", mock.Anything, mock.Anything, uint(10), mock.Anything).Return(errors.New("some overtimes are already paid"))
+
+		// Execute
+		err := mockRepo.MarkPaid(context.Background(), []uint{1}, 10, time.Now())
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOvertimeRepository_ResetPaidByPeriodID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Setup expectations
+		mockRepo.On("ResetPaidByPeriodID", mock.Anything, uint(1)).Return(nil)
+
+		// Execute
+		err := mockRepo.ResetPaidByPeriodID(context.Background(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOvertimeRepository_ListAll(t *testing.T) {
+	t.Run("success with gap only", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Test data
+		actualHours := 1.0
+		hoursGap := 1.5
+		req := overtime.ListAllOvertimesRequest{
+			Page:    1,
+			Limit:   10,
+			GapOnly: true,
+		}
+
+		expectedResponse := &overtime.ListOvertimesResponse{
+			Data: []overtime.OvertimeResponse{
+				{
+					ID:             1,
+					UserID:         2,
+					Username:       "employee",
+					OvertimesDate:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+					TotalHoursTime: 2.5,
+					ActualHours:    &actualHours,
+					HoursGap:       &hoursGap,
+					Source:         "declared",
+				},
+			},
+			Pagination: overtime.Pagination{
+				Page:       1,
+				Limit:      10,
+				Total:      1,
+				TotalPages: 1,
+			},
+		}
+
+		// Setup expectations
+		mockRepo.On("ListAll", mock.Anything, req).Return(expectedResponse, nil)
+
+		// Execute
+		response, err := mockRepo.ListAll(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, "employee", response.Data[0].Username)
+		assert.Equal(t, 1.5, *response.Data[0].HoursGap)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIOvertimeRepository{}
+
+		// Test data
+		req := overtime.ListAllOvertimesRequest{
+			Page:  1,
+			Limit: 10,
+		}
+
+		// Setup expectations
+		mockRepo.On("ListAll", mock.Anything, req).Return(nil, assert.AnError)
+
+		// Execute
+		response, err := mockRepo.ListAll(context.Background(), req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, response)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 // Test untuk memastikan interface berfungsi dengan benar
 func TestOvertimeRepository_Interface(t *testing.T) {
 	t.Run("interface implementation", func(t *testing.T) {
@@ -22,7 +22,7 @@ type (
 		Update(ctx context.Context, id uint, updates map[string]interface{}) error
 		Delete(ctx context.Context, id uint) error
 		DeleteByPeriodID(ctx context.Context, periodID uint) error
-		GetUsersByBatch(ctx context.Context, lastID uint, limit int) ([]uint, error)
+		GetUsersByBatch(ctx context.Context, lastID uint, limit int, periodEnd time.Time) ([]uint, error)
 		CreateBatch(ctx context.Context, periodDetails []period_detail.PeriodDetail) error
 		ListPayslip(ctx context.Context, req payslip.PayslipListRequest, userID uint) (*payslip.PayslipListResponse, error)
 		GetPayslipData(ctx context.Context, periodDetailID, userID uint) (*payslip.PayslipData, error)
@@ -100,11 +100,14 @@ func (repo PeriodDetailRepository) DeleteByPeriodID(ctx context.Context, periodI
 	return repo.getInstanceDB(ctx).WithContext(ctxWT).Delete(&period_detail.PeriodDetail{}, "periods_id = ?", periodID).Error
 }
 
-func (repo PeriodDetailRepository) GetUsersByBatch(ctx context.Context, lastID uint, limit int) ([]uint, error) {
+// GetUsersByBatch returns the employees to pay in a period, those leaving on or before periodEnd are paid by their final settlement
+func (repo PeriodDetailRepository) GetUsersByBatch(ctx context.Context, lastID uint, limit int, periodEnd time.Time) ([]uint, error) {
 	var userIDs []uint
 	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
 	defer cancel()
-	query := repo.getInstanceDB(ctx).WithContext(ctxWT).Table("users").Select("id").Where("roles = ?", constant.EmployeeRole)
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).Table("users").Select("id").
+		Where("roles = ? AND status = ?", constant.EmployeeRole, constant.StatusActive).
+		Where("NOT EXISTS (SELECT 1 FROM terminations WHERE terminations.user_id = users.id AND terminations.last_working_day <= ?)", periodEnd)
 
 	if lastID > 0 {
 		query = query.Where("id > ?", lastID)
@@ -213,6 +216,27 @@ func (repo PeriodDetailRepository) GetPayslipData(ctx context.Context, periodDet
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
+	// Payslips show the full name and employee number from the HR profile, falling back to the username.
+	// Scan leaves the struct empty when there is no profile, only a failed query is an error
+	var profile struct {
+		FullName       string
+		EmployeeNumber string
+	}
+	err = repo.getInstanceDB(ctx).WithContext(ctx).
+		Table("employee_profiles").
+		Select("full_name, employee_number").
+		Where("user_id = ?", userID).
+		Limit(1).
+		Scan(&profile).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get employee profile: %w", err)
+	}
+
+	employeeName := user.Username
+	if profile.FullName != "" {
+		employeeName = profile.FullName
+	}
+
 	// Parse overtime data
 	var overtimeDetails []payslip.OvertimeData
 	if periodDetail.Overtime != nil {
@@ -226,7 +250,8 @@ func (repo PeriodDetailRepository) GetPayslipData(ctx context.Context, periodDet
 	}
 
 	return &payslip.PayslipData{
-		EmployeeName:       user.Username,
+		EmployeeName:       employeeName,
+		EmployeeNumber:     profile.EmployeeNumber,
 		PeriodName:         period.Name,
 		StartDate:          period.StartDate,
 		EndDate:            period.EndDate,
@@ -257,18 +282,21 @@ func (repo PeriodDetailRepository) GetPayslipSummaryData(ctx context.Context, pe
 			period_details.user_id,
 			period_details.total_working,
 			period_details.take_home_pay,
-			users.username as employee_name
+			COALESCE(employee_profiles.full_name, users.username) as employee_name,
+			COALESCE(employee_profiles.employee_number, '') as employee_number
 		`).
 		Joins("JOIN users ON period_details.user_id = users.id").
+		Joins("LEFT JOIN employee_profiles ON employee_profiles.user_id = users.id").
 		Where("period_details.periods_id = ?", periodID).
-		Order("users.username ASC")
+		Order("employee_name ASC")
 
 	var results []struct {
-		ID           uint    `json:"id"`
-		UserID       uint    `json:"user_id"`
-		TotalWorking int     `json:"total_working"`
-		TakeHomePay  float64 `json:"take_home_pay"`
-		EmployeeName string  `json:"employee_name"`
+		ID             uint    `json:"id"`
+		UserID         uint    `json:"user_id"`
+		TotalWorking   int     `json:"total_working"`
+		TakeHomePay    float64 `json:"take_home_pay"`
+		EmployeeName   string  `json:"employee_name"`
+		EmployeeNumber string  `json:"employee_number"`
 	}
 
 	if err := query.Find(&results).Error; err != nil {
@@ -283,9 +311,10 @@ func (repo PeriodDetailRepository) GetPayslipSummaryData(ctx context.Context, pe
 	for i, result := range results {
 		totalTakeHomePay += result.TakeHomePay
 		employeeList = append(employeeList, payslip.PayslipSummaryEmployee{
-			No:           i + 1,
-			EmployeeName: result.EmployeeName,
-			TakeHomePay:  result.TakeHomePay,
+			No:             i + 1,
+			EmployeeName:   result.EmployeeName,
+			EmployeeNumber: result.EmployeeNumber,
+			TakeHomePay:    result.TakeHomePay,
 		})
 	}
 
@@ -330,13 +330,14 @@ func TestPeriodDetailRepository_GetUsersByBatch(t *testing.T) {
 		// Test data
 		lastID := uint(0)
 		limit := 10
+		periodEnd := time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC)
 		expectedUserIDs := []uint{1, 2, 3, 4, 5}
 
 		// Setup expectations
-		mockRepo.On("GetUsersByBatch", mock.Anything, lastID, limit).Return(expectedUserIDs, nil)
+		mockRepo.On("GetUsersByBatch", mock.Anything, lastID, limit, periodEnd).Return(expectedUserIDs, nil)
 
 		// Execute
-		userIDs, err := mockRepo.GetUsersByBatch(context.Background(), lastID, limit)
+		userIDs, err := mockRepo.GetUsersByBatch(context.Background(), lastID, limit, periodEnd)
 
 		// Assert
 		assert.NoError(t, err)
@@ -354,12 +355,13 @@ func TestPeriodDetailRepository_GetUsersByBatch(t *testing.T) {
 		// Test data
 		lastID := uint(100)
 		limit := 10
+		periodEnd := time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC)
 
 		// Setup expectations
-		mockRepo.On("GetUsersByBatch", mock.Anything, lastID, limit).Return([]uint{}, nil)
+		mockRepo.On("GetUsersByBatch", mock.Anything, lastID, limit, periodEnd).Return([]uint{}, nil)
 
 		// Execute
-		userIDs, err := mockRepo.GetUsersByBatch(context.Background(), lastID, limit)
+		userIDs, err := mockRepo.GetUsersByBatch(context.Background(), lastID, limit, periodEnd)
 
 		// Assert
 		assert.NoError(t, err)
@@ -376,12 +378,13 @@ func TestPeriodDetailRepository_GetUsersByBatch(t *testing.T) {
 		// Test data
 		lastID := uint(0)
 		limit := 10
+		periodEnd := time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC)
 
 		// Setup expectations
-		mockRepo.On("GetUsersByBatch", mock.Anything, lastID, limit).Return(nil, assert.AnError)
+		mockRepo.On("GetUsersByBatch", mock.Anything, lastID, limit, periodEnd).Return(nil, assert.AnError)
 
 		// Execute
-		userIDs, err := mockRepo.GetUsersByBatch(context.Background(), lastID, limit)
+		userIDs, err := mockRepo.GetUsersByBatch(context.Background(), lastID, limit, periodEnd)
 
 		// Assert
 		assert.Error(t, err)
@@ -731,7 +734,7 @@ func TestPeriodDetailRepository_Interface(t *testing.T) {
 		mockRepo.On("Update", mock.Anything, uint(1), mock.Anything).Return(nil)
 		mockRepo.On("Delete", mock.Anything, uint(1)).Return(nil)
 		mockRepo.On("DeleteByPeriodID", mock.Anything, uint(1)).Return(nil)
-		mockRepo.On("GetUsersByBatch", mock.Anything, uint(0), 10).Return([]uint{1, 2, 3}, nil)
+		mockRepo.On("GetUsersByBatch", mock.Anything, uint(0), 10, mock.Anything).Return([]uint{1, 2, 3}, nil)
 		mockRepo.On("CreateBatch", mock.Anything, mock.Anything).Return(nil)
 		mockRepo.On("ListPayslip", mock.Anything, mock.Anything, uint(1)).Return(&payslip.PayslipListResponse{}, nil)
 		mockRepo.On("GetPayslipData", mock.Anything, uint(1), uint(1)).Return(&payslip.PayslipData{}, nil)
@@ -758,7 +761,7 @@ func TestPeriodDetailRepository_Interface(t *testing.T) {
 		err = repo.DeleteByPeriodID(context.Background(), uint(1))
 		assert.NoError(t, err)
 
-		userIDs, err := repo.GetUsersByBatch(context.Background(), uint(0), 10)
+		userIDs, err := repo.GetUsersByBatch(context.Background(), uint(0), 10, time.Now())
 		assert.NoError(t, err)
 		assert.Len(t, userIDs, 3)
 
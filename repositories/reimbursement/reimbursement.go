@@ -2,12 +2,14 @@ package reimbursement
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/models/reimbursement"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type (
@@ -15,9 +17,30 @@ type (
 		Create(ctx context.Context, reimbursement *reimbursement.Reimbursement) error
 		GetByID(ctx context.Context, id uint) (*reimbursement.Reimbursement, error)
 		Update(ctx context.Context, id uint, updates map[string]interface{}) error
+		Review(ctx context.Context, id uint, updates map[string]interface{}) error
 		Delete(ctx context.Context, id uint) error
 		List(ctx context.Context, req reimbursement.ListReimbursementsRequest, userID uint) (*reimbursement.ListReimbursementsResponse, error)
 		GetByUserAndDateRange(ctx context.Context, userID uint, startDate, endDate time.Time) ([]reimbursement.Reimbursement, error)
+		CreateAttachment(ctx context.Context, attachment *reimbursement.ReimbursementAttachment) error
+		GetAttachmentByID(ctx context.Context, id uint) (*reimbursement.ReimbursementAttachment, error)
+		GetAttachmentsByReimbursementID(ctx context.Context, reimbursementID uint) ([]reimbursement.ReimbursementAttachment, error)
+		DeleteAttachment(ctx context.Context, id uint) error
+		ListAll(ctx context.Context, req reimbursement.ListAllReimbursementsRequest) (*reimbursement.ListReimbursementsResponse, error)
+		GetPayableByUser(ctx context.Context, userID uint, endDate time.Time) ([]reimbursement.Reimbursement, error)
+		MarkPaid(ctx context.Context, ids []uint, periodDetailID uint, paidAt time.Time) error
+		MarkPaidBySettlement(ctx context.Context, ids []uint, terminationID uint, paidAt time.Time) error
+		ResetPaidByPeriodID(ctx context.Context, periodID uint) error
+		CreateCategory(ctx context.Context, category *reimbursement.ReimbursementCategory) error
+		GetCategoryByID(ctx context.Context, id uint) (*reimbursement.ReimbursementCategory, error)
+		GetCategoryByCode(ctx context.Context, code string) (*reimbursement.ReimbursementCategory, error)
+		UpdateCategory(ctx context.Context, id uint, updates map[string]interface{}) error
+		ListCategories(ctx context.Context, activeOnly bool) ([]reimbursement.ReimbursementCategory, error)
+		GetUsedAmount(ctx context.Context, userID, categoryID uint, startDate, endDate time.Time, excludeID uint) (float64, error)
+		FindSameAmountClaims(ctx context.Context, userID uint, amount float64, startDate, endDate time.Time, excludeID uint) ([]reimbursement.Reimbursement, error)
+		FindAttachmentsByChecksum(ctx context.Context, checksum string, excludeReimbursementID uint) ([]reimbursement.ReimbursementAttachment, error)
+		CreateDuplicates(ctx context.Context, duplicates []reimbursement.ReimbursementDuplicate) error
+		GetDuplicatesByReimbursementID(ctx context.Context, reimbursementID uint) ([]reimbursement.ReimbursementDuplicate, error)
+		ListDuplicates(ctx context.Context, req reimbursement.DuplicateReportRequest) (*reimbursement.DuplicateReportResponse, error)
 	}
 
 	ReimbursementRepository struct {
@@ -60,6 +83,23 @@ func (repo ReimbursementRepository) Update(ctx context.Context, id uint, updates
 	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&reimbursement.Reimbursement{}).Where("id = ?", id).Updates(updates).Error
 }
 
+// Review updates a claim that is still submitted, so a claim can only be reviewed once
+func (repo ReimbursementRepository) Review(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&reimbursement.Reimbursement{}).
+		Where("id = ? AND status = ?", id, constant.ReimbursementStatusSubmitted).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("reimbursement is no longer submitted")
+	}
+	return nil
+}
+
 func (repo ReimbursementRepository) Delete(ctx context.Context, id uint) error {
 	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
 	defer cancel()
@@ -87,6 +127,9 @@ func (repo ReimbursementRepository) List(ctx context.Context, req reimbursement.
 			}
 		}
 	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
 
 	// Count total records
 	if err := query.Count(&total).Error; err != nil {
@@ -145,17 +188,401 @@ func (repo ReimbursementRepository) GetByUserAndDateRange(ctx context.Context, u
 	return reimbursements, err
 }
 
+func (repo ReimbursementRepository) CreateAttachment(ctx context.Context, attachment *reimbursement.ReimbursementAttachment) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(attachment).Error
+}
+
+func (repo ReimbursementRepository) GetAttachmentByID(ctx context.Context, id uint) (*reimbursement.ReimbursementAttachment, error) {
+	var attachment reimbursement.ReimbursementAttachment
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ?", id).First(&attachment).Error; err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (repo ReimbursementRepository) GetAttachmentsByReimbursementID(ctx context.Context, reimbursementID uint) ([]reimbursement.ReimbursementAttachment, error) {
+	var attachments []reimbursement.ReimbursementAttachment
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("reimbursement_id = ?", reimbursementID).
+		Order("created_at ASC").
+		Find(&attachments).Error
+	return attachments, err
+}
+
+func (repo ReimbursementRepository) DeleteAttachment(ctx context.Context, id uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Delete(&reimbursement.ReimbursementAttachment{}, id).Error
+}
+
+func (repo ReimbursementRepository) ListAll(ctx context.Context, req reimbursement.ListAllReimbursementsRequest) (*reimbursement.ListReimbursementsResponse, error) {
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	// Build query
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("reimbursements").
+		Select(`
+			reimbursements.id,
+			reimbursements.user_id,
+			users.username,
+			reimbursements.category_id,
+			reimbursements.title,
+			reimbursements.date,
+			reimbursements.amount,
+			reimbursements.description,
+			reimbursements.status,
+			reimbursements.approved_amount,
+			reimbursements.reviewed_by,
+			reimbursements.reviewed_at,
+			reimbursements.review_comment,
+			reimbursements.period_detail_id,
+			reimbursements.termination_id,
+			reimbursements.paid_at,
+			reimbursements.suspected_duplicate,
+			reimbursements.created_by,
+			reimbursements.created_at,
+			reimbursements.updated_by,
+			reimbursements.updated_at
+		`).
+		Joins("JOIN users ON reimbursements.user_id = users.id")
+
+	// Apply filters
+	if req.UserID != 0 {
+		query = query.Where("reimbursements.user_id = ?", req.UserID)
+	}
+	if req.UserIDs != nil {
+		query = query.Where("reimbursements.user_id IN ?", req.UserIDs)
+	}
+	if req.StartDate != nil {
+		if startDate, err := time.Parse("2006-01-02", *req.StartDate); err == nil {
+			query = query.Where("reimbursements.date >= ?", startDate)
+		}
+	}
+	if req.EndDate != nil {
+		if endDate, err := time.Parse("2006-01-02", *req.EndDate); err == nil {
+			query = query.Where("reimbursements.date < ?", endDate.AddDate(0, 0, 1))
+		}
+	}
+	if req.Status != "" {
+		query = query.Where("reimbursements.status = ?", req.Status)
+	}
+	if req.SuspectedDuplicate {
+		query = query.Where("reimbursements.suspected_duplicate")
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (req.Page - 1) * req.Limit
+	var responses []reimbursement.ReimbursementResponse
+	if err := query.Order("reimbursements.date DESC, reimbursements.id DESC").Offset(offset).Limit(req.Limit).Find(&responses).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return &reimbursement.ListReimbursementsResponse{
+		Data: responses,
+		Pagination: reimbursement.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// GetPayableByUser returns approved claims dated up to endDate that no payroll has paid yet,
+// so claims approved after their period closed are picked up by the next run. The claims are locked
+// for the rest of the transaction, a concurrent payroll run waits and then no longer sees them as payable
+func (repo ReimbursementRepository) GetPayableByUser(ctx context.Context, userID uint, endDate time.Time) ([]reimbursement.Reimbursement, error) {
+	var reimbursements []reimbursement.Reimbursement
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND date(date) <= date(?)", userID, endDate).
+		Where("status IN ? AND period_detail_id IS NULL", []string{constant.ReimbursementStatusApproved, constant.ReimbursementStatusPartiallyApproved}).
+		Order("date ASC").
+		Find(&reimbursements).Error
+	return reimbursements, err
+}
+
+// MarkPaid links approved claims to the payslip that paid them, it fails when any of them was paid in the meantime
+func (repo ReimbursementRepository) MarkPaid(ctx context.Context, ids []uint, periodDetailID uint, paidAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&reimbursement.Reimbursement{}).
+		Where("id IN ? AND period_detail_id IS NULL", ids).
+		Where("status IN ?", []string{constant.ReimbursementStatusApproved, constant.ReimbursementStatusPartiallyApproved}).
+		Updates(map[string]interface{}{
+			"status":           constant.ReimbursementStatusPaid,
+			"period_detail_id": periodDetailID,
+			"paid_at":          paidAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != int64(len(ids)) {
+		return errors.New("some reimbursements are already paid")
+	}
+	return nil
+}
+
+// MarkPaidBySettlement links approved claims to the final settlement of an employee who left,
+// it fails when a payroll run paid any of them in the meantime
+func (repo ReimbursementRepository) MarkPaidBySettlement(ctx context.Context, ids []uint, terminationID uint, paidAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&reimbursement.Reimbursement{}).
+		Where("id IN ? AND period_detail_id IS NULL AND termination_id IS NULL", ids).
+		Where("status IN ?", []string{constant.ReimbursementStatusApproved, constant.ReimbursementStatusPartiallyApproved}).
+		Updates(map[string]interface{}{
+			"status":         constant.ReimbursementStatusPaid,
+			"termination_id": terminationID,
+			"paid_at":        paidAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != int64(len(ids)) {
+		return errors.New("some reimbursements are already paid")
+	}
+	return nil
+}
+
+// ResetPaidByPeriodID returns claims paid by a period to their review status before its payroll is recalculated
+func (repo ReimbursementRepository) ResetPaidByPeriodID(ctx context.Context, periodID uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&reimbursement.Reimbursement{}).
+		Where("period_detail_id IN (?)", repo.getInstanceDB(ctx).Table("period_details").Select("id").Where("periods_id = ?", periodID)).
+		Updates(map[string]interface{}{
+			"status":           gorm.Expr("CASE WHEN approved_amount < amount THEN ? ELSE ? END", constant.ReimbursementStatusPartiallyApproved, constant.ReimbursementStatusApproved),
+			"period_detail_id": nil,
+			"paid_at":          nil,
+		}).Error
+}
+
+func (repo ReimbursementRepository) CreateCategory(ctx context.Context, category *reimbursement.ReimbursementCategory) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(category).Error
+}
+
+func (repo ReimbursementRepository) GetCategoryByID(ctx context.Context, id uint) (*reimbursement.ReimbursementCategory, error) {
+	var category reimbursement.ReimbursementCategory
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ?", id).First(&category).Error; err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (repo ReimbursementRepository) GetCategoryByCode(ctx context.Context, code string) (*reimbursement.ReimbursementCategory, error) {
+	var category reimbursement.ReimbursementCategory
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("code = ?", code).First(&category).Error; err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (repo ReimbursementRepository) UpdateCategory(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&reimbursement.ReimbursementCategory{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (repo ReimbursementRepository) ListCategories(ctx context.Context, activeOnly bool) ([]reimbursement.ReimbursementCategory, error) {
+	var categories []reimbursement.ReimbursementCategory
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT)
+	if activeOnly {
+		query = query.Where("status = ?", constant.StatusActive)
+	}
+	err := query.Order("name ASC").Find(&categories).Error
+	return categories, err
+}
+
+// GetUsedAmount sums the claims of a category dated within the range, rejected claims are ignored
+// and reviewed claims count their approved amount. excludeID skips the claim being updated.
+func (repo ReimbursementRepository) GetUsedAmount(ctx context.Context, userID, categoryID uint, startDate, endDate time.Time, excludeID uint) (float64, error) {
+	var used float64
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&reimbursement.Reimbursement{}).
+		Where("user_id = ? AND category_id = ? AND date(date) BETWEEN date(?) AND date(?)", userID, categoryID, startDate, endDate).
+		Where("status <> ? AND id <> ?", constant.ReimbursementStatusRejected, excludeID).
+		Select("COALESCE(SUM(COALESCE(approved_amount, amount)), 0)").
+		Scan(&used).Error
+	return used, err
+}
+
+// FindSameAmountClaims returns the user's other claims of the same amount dated within the range, ignoring rejected ones
+func (repo ReimbursementRepository) FindSameAmountClaims(ctx context.Context, userID uint, amount float64, startDate, endDate time.Time, excludeID uint) ([]reimbursement.Reimbursement, error) {
+	var reimbursements []reimbursement.Reimbursement
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("user_id = ? AND amount = ? AND date(date) BETWEEN date(?) AND date(?)", userID, amount, startDate, endDate).
+		Where("status <> ? AND id <> ?", constant.ReimbursementStatusRejected, excludeID).
+		Order("date ASC").
+		Find(&reimbursements).Error
+	return reimbursements, err
+}
+
+// FindAttachmentsByChecksum returns identical files attached to other reimbursements of any employee
+func (repo ReimbursementRepository) FindAttachmentsByChecksum(ctx context.Context, checksum string, excludeReimbursementID uint) ([]reimbursement.ReimbursementAttachment, error) {
+	var attachments []reimbursement.ReimbursementAttachment
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("checksum = ? AND reimbursement_id <> ?", checksum, excludeReimbursementID).
+		Order("created_at ASC").
+		Find(&attachments).Error
+	return attachments, err
+}
+
+// CreateDuplicates records suspected duplicates, pairs already flagged for the same reason are skipped
+func (repo ReimbursementRepository) CreateDuplicates(ctx context.Context, duplicates []reimbursement.ReimbursementDuplicate) error {
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Clauses(clause.OnConflict{DoNothing: true}).Create(&duplicates).Error
+}
+
+func (repo ReimbursementRepository) GetDuplicatesByReimbursementID(ctx context.Context, reimbursementID uint) ([]reimbursement.ReimbursementDuplicate, error) {
+	var duplicates []reimbursement.ReimbursementDuplicate
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("reimbursement_id = ?", reimbursementID).
+		Order("created_at ASC").
+		Find(&duplicates).Error
+	return duplicates, err
+}
+
+func (repo ReimbursementRepository) ListDuplicates(ctx context.Context, req reimbursement.DuplicateReportRequest) (*reimbursement.DuplicateReportResponse, error) {
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	// Build query, each row shows the flagged claim next to the claim it matched
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("reimbursement_duplicates d").
+		Select(`
+			d.id,
+			d.reason,
+			d.title_similarity,
+			d.created_at,
+			r.id AS reimbursement_id,
+			r.user_id,
+			u.username,
+			r.title,
+			r.date,
+			r.amount,
+			r.status,
+			m.id AS matched_reimbursement_id,
+			m.user_id AS matched_user_id,
+			mu.username AS matched_username,
+			m.title AS matched_title,
+			m.date AS matched_date,
+			m.amount AS matched_amount,
+			m.status AS matched_status
+		`).
+		Joins("JOIN reimbursements r ON r.id = d.reimbursement_id").
+		Joins("JOIN users u ON u.id = r.user_id").
+		Joins("JOIN reimbursements m ON m.id = d.matched_reimbursement_id").
+		Joins("JOIN users mu ON mu.id = m.user_id")
+
+	// Apply filters
+	if req.UserID != 0 {
+		query = query.Where("r.user_id = ? OR m.user_id = ?", req.UserID, req.UserID)
+	}
+	if req.Reason != "" {
+		query = query.Where("d.reason = ?", req.Reason)
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (req.Page - 1) * req.Limit
+	var items []reimbursement.DuplicateReportItem
+	if err := query.Order("d.created_at DESC, d.id DESC").Offset(offset).Limit(req.Limit).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return &reimbursement.DuplicateReportResponse{
+		Data: items,
+		Pagination: reimbursement.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
 func (repo ReimbursementRepository) toResponse(reimb reimbursement.Reimbursement) reimbursement.ReimbursementResponse {
 	return reimbursement.ReimbursementResponse{
-		ID:          reimb.ID,
-		UserID:      reimb.UserID,
-		Title:       reimb.Title,
-		Date:        reimb.Date,
-		Amount:      reimb.Amount,
-		Description: reimb.Description,
-		CreatedBy:   reimb.CreatedBy,
-		CreatedAt:   reimb.CreatedAt,
-		UpdatedBy:   reimb.UpdatedBy,
-		UpdatedAt:   reimb.UpdatedAt,
+		ID:                 reimb.ID,
+		UserID:             reimb.UserID,
+		CategoryID:         reimb.CategoryID,
+		Title:              reimb.Title,
+		Date:               reimb.Date,
+		Amount:             reimb.Amount,
+		Description:        reimb.Description,
+		Status:             reimb.Status,
+		ApprovedAmount:     reimb.ApprovedAmount,
+		ReviewedBy:         reimb.ReviewedBy,
+		ReviewedAt:         reimb.ReviewedAt,
+		ReviewComment:      reimb.ReviewComment,
+		PeriodDetailID:     reimb.PeriodDetailID,
+		TerminationID:      reimb.TerminationID,
+		PaidAt:             reimb.PaidAt,
+		SuspectedDuplicate: reimb.SuspectedDuplicate,
+		CreatedBy:          reimb.CreatedBy,
+		CreatedAt:          reimb.CreatedAt,
+		UpdatedBy:          reimb.UpdatedBy,
+		UpdatedAt:          reimb.UpdatedAt,
 	}
 }
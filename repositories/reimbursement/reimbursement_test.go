@@ -2,12 +2,14 @@ package reimbursement
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/mocks"
 	"github.com/riskykurniawan15/payrolls/models/reimbursement"
 )
@@ -170,6 +172,56 @@ func TestReimbursementRepository_Update(t *testing.T) {
 	})
 }
 
+func TestReimbursementRepository_Review(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		reimbursementID := uint(1)
+		updates := map[string]interface{}{
+			"status":      constant.ReimbursementStatusApproved,
+			"reviewed_by": uint(2),
+		}
+
+		// Setup expectations
+		mockRepo.On("Review", mock.Anything, reimbursementID, updates).Return(nil)
+
+		// Execute
+		err := mockRepo.Review(context.Background(), reimbursementID, updates)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		reimbursementID := uint(1)
+		updates := map[string]interface{}{
+			"status": constant.ReimbursementStatusRejected,
+		}
+
+		// Setup expectations
+		mockRepo.On("Review", mock.Anything, reimbursementID, updates).Return(errors.New("reimbursement is no longer submitted"))
+
+		// Execute
+		err := mockRepo.Review(context.Background(), reimbursementID, updates)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, "reimbursement is no longer submitted", err.Error())
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestReimbursementRepository_Delete(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// Setup mock
@@ -282,6 +334,604 @@ func TestReimbursementRepository_GetByUserAndDateRange(t *testing.T) {
 }
 
 // Test untuk memastikan interface berfungsi dengan benar
+func TestReimbursementRepository_CreateAttachment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		attachment := &reimbursement.ReimbursementAttachment{
+			ReimbursementID: 1,
+			FileName:        "receipt.pdf",
+			ContentType:     "application/pdf",
+			Size:            1024,
+			StorageKey:      "receipts/1/1/receipt.pdf",
+			Checksum:        "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			CreatedBy:       1,
+		}
+
+		// Setup expectations
+		mockRepo.On("CreateAttachment", mock.Anything, attachment).Return(nil)
+
+		// Execute
+		err := mockRepo.CreateAttachment(context.Background(), attachment)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		attachment := &reimbursement.ReimbursementAttachment{ReimbursementID: 999}
+
+		// Setup expectations
+		mockRepo.On("CreateAttachment", mock.Anything, attachment).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.CreateAttachment(context.Background(), attachment)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_GetAttachmentsByReimbursementID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		reimbursementID := uint(1)
+		expectedAttachments := []reimbursement.ReimbursementAttachment{
+			{ID: 1, ReimbursementID: reimbursementID, FileName: "receipt.pdf", ContentType: "application/pdf"},
+			{ID: 2, ReimbursementID: reimbursementID, FileName: "receipt.png", ContentType: "image/png"},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetAttachmentsByReimbursementID", mock.Anything, reimbursementID).Return(expectedAttachments, nil)
+
+		// Execute
+		attachments, err := mockRepo.GetAttachmentsByReimbursementID(context.Background(), reimbursementID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, attachments, 2)
+		assert.Equal(t, "receipt.png", attachments[1].FileName)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("attachment not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		attachmentID := uint(999)
+
+		// Setup expectations
+		mockRepo.On("GetAttachmentByID", mock.Anything, attachmentID).Return(nil, assert.AnError)
+
+		// Execute
+		attachment, err := mockRepo.GetAttachmentByID(context.Background(), attachmentID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, attachment)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_ListAll(t *testing.T) {
+	t.Run("success with status filter", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		req := reimbursement.ListAllReimbursementsRequest{
+			Page:   1,
+			Limit:  10,
+			Status: "submitted",
+		}
+
+		expectedResponse := &reimbursement.ListReimbursementsResponse{
+			Data: []reimbursement.ReimbursementResponse{
+				{
+					ID:       1,
+					UserID:   2,
+					Username: "employee",
+					Title:    "Transportation",
+					Date:     time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+					Amount:   50000,
+					Status:   "submitted",
+				},
+			},
+			Pagination: reimbursement.Pagination{
+				Page:       1,
+				Limit:      10,
+				Total:      1,
+				TotalPages: 1,
+			},
+		}
+
+		// Setup expectations
+		mockRepo.On("ListAll", mock.Anything, req).Return(expectedResponse, nil)
+
+		// Execute
+		response, err := mockRepo.ListAll(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, "employee", response.Data[0].Username)
+		assert.Equal(t, "submitted", response.Data[0].Status)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		req := reimbursement.ListAllReimbursementsRequest{
+			Page:  1,
+			Limit: 10,
+		}
+
+		// Setup expectations
+		mockRepo.On("ListAll", mock.Anything, req).Return(nil, assert.AnError)
+
+		// Execute
+		response, err := mockRepo.ListAll(context.Background(), req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, response)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_GetPayableByUser(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		userID := uint(1)
+		endDate := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+		fullAmount := 50000.0
+		approvedAmount := 30000.0
+		expectedReimbursements := []reimbursement.Reimbursement{
+			{ID: 1, UserID: userID, Title: "Transportation", Amount: 50000, Status: "approved", ApprovedAmount: &fullAmount},
+			{ID: 2, UserID: userID, Title: "Meal", Amount: 40000, Status: "partially_approved", ApprovedAmount: &approvedAmount},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetPayableByUser", mock.Anything, userID, endDate).Return(expectedReimbursements, nil)
+
+		// Execute
+		reimbursements, err := mockRepo.GetPayableByUser(context.Background(), userID, endDate)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, reimbursements, 2)
+		assert.Equal(t, 30000.0, *reimbursements[1].ApprovedAmount)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		userID := uint(1)
+		endDate := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("GetPayableByUser", mock.Anything, userID, endDate).Return(nil, assert.AnError)
+
+		// Execute
+		reimbursements, err := mockRepo.GetPayableByUser(context.Background(), userID, endDate)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, reimbursements)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_MarkPaid(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		ids := []uint{1, 2}
+		periodDetailID := uint(10)
+		paidAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("MarkPaid", mock.Anything, ids, periodDetailID, paidAt).Return(nil)
+
+		// Execute
+		err := mockRepo.MarkPaid(context.Background(), ids, periodDetailID, paidAt)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Setup expectations
+		mockRepo.On("MarkPaid", mock.Anything, mock.Anything, uint(10), mock.Anything).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.MarkPaid(context.Background(), []uint{1}, 10, time.Now())
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_MarkPaidBySettlement(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		ids := []uint{3, 4}
+		terminationID := uint(7)
+		paidAt := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("MarkPaidBySettlement", mock.Anything, ids, terminationID, paidAt).Return(nil)
+
+		// Execute
+		err := mockRepo.MarkPaidBySettlement(context.Background(), ids, terminationID, paidAt)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Setup expectations
+		mockRepo.On("MarkPaidBySettlement", mock.Anything, mock.Anything, uint(7), mock.Anything).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.MarkPaidBySettlement(context.Background(), []uint{3}, 7, time.Now())
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_ResetPaidByPeriodID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Setup expectations
+		mockRepo.On("ResetPaidByPeriodID", mock.Anything, uint(1)).Return(nil)
+
+		// Execute
+		err := mockRepo.ResetPaidByPeriodID(context.Background(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_GetCategoryByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		yearlyLimit := 5000000.0
+		expectedCategory := &reimbursement.ReimbursementCategory{
+			ID:                      1,
+			Code:                    "MEDICAL",
+			Name:                    "Medical",
+			YearlyLimit:             &yearlyLimit,
+			EligibleEmploymentTypes: "permanent,contract",
+			Status:                  1,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetCategoryByID", mock.Anything, uint(1)).Return(expectedCategory, nil)
+
+		// Execute
+		category, err := mockRepo.GetCategoryByID(context.Background(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "MEDICAL", category.Code)
+		assert.Equal(t, 5000000.0, *category.YearlyLimit)
+		assert.Nil(t, category.MonthlyLimit)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("category not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetCategoryByID", mock.Anything, uint(999)).Return(nil, assert.AnError)
+
+		// Execute
+		category, err := mockRepo.GetCategoryByID(context.Background(), 999)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, category)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_ListCategories(t *testing.T) {
+	t.Run("active only", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		expectedCategories := []reimbursement.ReimbursementCategory{
+			{ID: 1, Code: "MEAL", Name: "Meal", Status: 1},
+			{ID: 2, Code: "MEDICAL", Name: "Medical", Status: 1},
+		}
+
+		// Setup expectations
+		mockRepo.On("ListCategories", mock.Anything, true).Return(expectedCategories, nil)
+
+		// Execute
+		categories, err := mockRepo.ListCategories(context.Background(), true)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, categories, 2)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_GetUsedAmount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("GetUsedAmount", mock.Anything, uint(1), uint(2), startDate, endDate, uint(0)).Return(1250000.0, nil)
+
+		// Execute
+		used, err := mockRepo.GetUsedAmount(context.Background(), 1, 2, startDate, endDate, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1250000.0, used)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetUsedAmount", mock.Anything, uint(1), uint(2), mock.Anything, mock.Anything, uint(5)).Return(0.0, assert.AnError)
+
+		// Execute
+		used, err := mockRepo.GetUsedAmount(context.Background(), 1, 2, time.Now(), time.Now(), 5)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, 0.0, used)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_FindSameAmountClaims(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		startDate := time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2024, 1, 18, 0, 0, 0, 0, time.UTC)
+		expectedReimbursements := []reimbursement.Reimbursement{
+			{ID: 1, UserID: 1, Title: "Taxi to airport", Date: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), Amount: 150000, Status: "submitted"},
+		}
+
+		// Setup expectations
+		mockRepo.On("FindSameAmountClaims", mock.Anything, uint(1), 150000.0, startDate, endDate, uint(2)).Return(expectedReimbursements, nil)
+
+		// Execute
+		reimbursements, err := mockRepo.FindSameAmountClaims(context.Background(), 1, 150000, startDate, endDate, 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, reimbursements, 1)
+		assert.Equal(t, "Taxi to airport", reimbursements[0].Title)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_FindAttachmentsByChecksum(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		checksum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+		expectedAttachments := []reimbursement.ReimbursementAttachment{
+			{ID: 3, ReimbursementID: 7, FileName: "receipt.jpg", Checksum: checksum},
+		}
+
+		// Setup expectations
+		mockRepo.On("FindAttachmentsByChecksum", mock.Anything, checksum, uint(2)).Return(expectedAttachments, nil)
+
+		// Execute
+		attachments, err := mockRepo.FindAttachmentsByChecksum(context.Background(), checksum, 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, attachments, 1)
+		assert.Equal(t, uint(7), attachments[0].ReimbursementID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_CreateDuplicates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		titleSimilarity := 0.9
+		duplicates := []reimbursement.ReimbursementDuplicate{
+			{ReimbursementID: 2, MatchedReimbursementID: 1, Reason: "similar_claim", TitleSimilarity: &titleSimilarity},
+		}
+
+		// Setup expectations
+		mockRepo.On("CreateDuplicates", mock.Anything, duplicates).Return(nil)
+
+		// Execute
+		err := mockRepo.CreateDuplicates(context.Background(), duplicates)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestReimbursementRepository_ListDuplicates(t *testing.T) {
+	t.Run("success across employees", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		req := reimbursement.DuplicateReportRequest{
+			Page:   1,
+			Limit:  10,
+			Reason: "same_receipt",
+		}
+
+		expectedResponse := &reimbursement.DuplicateReportResponse{
+			Data: []reimbursement.DuplicateReportItem{
+				{
+					ID:                     1,
+					Reason:                 "same_receipt",
+					ReimbursementID:        7,
+					UserID:                 2,
+					Username:               "employee2",
+					MatchedReimbursementID: 3,
+					MatchedUserID:          1,
+					MatchedUsername:        "employee1",
+				},
+			},
+			Pagination: reimbursement.Pagination{
+				Page:       1,
+				Limit:      10,
+				Total:      1,
+				TotalPages: 1,
+			},
+		}
+
+		// Setup expectations
+		mockRepo.On("ListDuplicates", mock.Anything, req).Return(expectedResponse, nil)
+
+		// Execute
+		response, err := mockRepo.ListDuplicates(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 1)
+		assert.NotEqual(t, response.Data[0].UserID, response.Data[0].MatchedUserID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIReimbursementRepository{}
+
+		// Test data
+		req := reimbursement.DuplicateReportRequest{
+			Page:  1,
+			Limit: 10,
+		}
+
+		// Setup expectations
+		mockRepo.On("ListDuplicates", mock.Anything, req).Return(nil, assert.AnError)
+
+		// Execute
+		response, err := mockRepo.ListDuplicates(context.Background(), req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, response)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestReimbursementRepository_Interface(t *testing.T) {
 	t.Run("interface implementation", func(t *testing.T) {
 		// Setup mock
@@ -0,0 +1,254 @@
+package role
+
+import (
+	"context"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/role"
+	"gorm.io/gorm"
+)
+
+type (
+	IRoleRepository interface {
+		ListPermissions(ctx context.Context) ([]role.Permission, error)
+		GetPermissionsByCodes(ctx context.Context, codes []string) ([]role.Permission, error)
+		ListRoles(ctx context.Context) ([]role.Role, error)
+		GetRoleByID(ctx context.Context, id uint) (*role.Role, error)
+		GetRoleByName(ctx context.Context, name string) (*role.Role, error)
+		GetRolesByIDs(ctx context.Context, ids []uint) ([]role.Role, error)
+		CreateRole(ctx context.Context, r *role.Role) error
+		UpdateRole(ctx context.Context, id uint, updates map[string]interface{}) error
+		DeleteRole(ctx context.Context, id uint) error
+		SetRolePermissions(ctx context.Context, roleID uint, permissionIDs []uint) error
+		GetUserRoles(ctx context.Context, userID uint) ([]role.Role, error)
+		SetUserRoles(ctx context.Context, userID uint, roleIDs []uint, createdBy uint) error
+		GetUserPermissions(ctx context.Context, userID uint) ([]string, error)
+		UserRequiresTwoFactor(ctx context.Context, userID uint) (bool, error)
+	}
+
+	RoleRepository struct {
+		db *gorm.DB
+	}
+
+	rolePermissionCode struct {
+		RoleID uint
+		Code   string
+	}
+)
+
+func NewRoleRepository(db *gorm.DB) IRoleRepository {
+	return &RoleRepository{db: db}
+}
+
+func (repo RoleRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo RoleRepository) ListPermissions(ctx context.Context) ([]role.Permission, error) {
+	var permissions []role.Permission
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).Order("code ASC").Find(&permissions).Error
+	return permissions, err
+}
+
+func (repo RoleRepository) GetPermissionsByCodes(ctx context.Context, codes []string) ([]role.Permission, error) {
+	var permissions []role.Permission
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("code IN ?", codes).Find(&permissions).Error
+	return permissions, err
+}
+
+func (repo RoleRepository) ListRoles(ctx context.Context) ([]role.Role, error) {
+	var roles []role.Role
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Order("name ASC").Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	if err := repo.loadPermissions(ctx, roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (repo RoleRepository) GetRoleByID(ctx context.Context, id uint) (*role.Role, error) {
+	var r role.Role
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ?", id).First(&r).Error; err != nil {
+		return nil, err
+	}
+	roles := []role.Role{r}
+	if err := repo.loadPermissions(ctx, roles); err != nil {
+		return nil, err
+	}
+	return &roles[0], nil
+}
+
+func (repo RoleRepository) GetRoleByName(ctx context.Context, name string) (*role.Role, error) {
+	var r role.Role
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("LOWER(name) = LOWER(?)", name).First(&r).Error; err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (repo RoleRepository) GetRolesByIDs(ctx context.Context, ids []uint) ([]role.Role, error) {
+	var roles []role.Role
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id IN ?", ids).Order("name ASC").Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	if err := repo.loadPermissions(ctx, roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (repo RoleRepository) CreateRole(ctx context.Context, r *role.Role) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(r).Error
+}
+
+func (repo RoleRepository) UpdateRole(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&role.Role{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (repo RoleRepository) DeleteRole(ctx context.Context, id uint) error {
+	// role_permissions and user_roles rows are removed by cascade
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ? AND is_system = ?", id, false).Delete(&role.Role{}).Error
+}
+
+func (repo RoleRepository) SetRolePermissions(ctx context.Context, roleID uint, permissionIDs []uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	db := repo.getInstanceDB(ctx).WithContext(ctxWT)
+	if err := db.Where("role_id = ?", roleID).Delete(&role.RolePermission{}).Error; err != nil {
+		return err
+	}
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+	rows := make([]role.RolePermission, 0, len(permissionIDs))
+	for _, permissionID := range permissionIDs {
+		rows = append(rows, role.RolePermission{RoleID: roleID, PermissionID: permissionID})
+	}
+	return db.Create(&rows).Error
+}
+
+func (repo RoleRepository) GetUserRoles(ctx context.Context, userID uint) ([]role.Role, error) {
+	var roles []role.Role
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Joins("JOIN user_roles ON user_roles.role_id = roles.id").
+		Where("user_roles.user_id = ?", userID).
+		Order("roles.name ASC").
+		Find(&roles).Error
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.loadPermissions(ctx, roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (repo RoleRepository) SetUserRoles(ctx context.Context, userID uint, roleIDs []uint, createdBy uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	db := repo.getInstanceDB(ctx).WithContext(ctxWT)
+	// The system role follows users.roles and is kept in sync by a trigger
+	err := db.Where("user_id = ? AND role_id IN (?)", userID, db.Model(&role.Role{}).Select("id").Where("is_system = ?", false)).
+		Delete(&role.UserRole{}).Error
+	if err != nil {
+		return err
+	}
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	rows := make([]role.UserRole, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		rows = append(rows, role.UserRole{UserID: userID, RoleID: roleID, CreatedBy: &createdBy})
+	}
+	return db.Create(&rows).Error
+}
+
+func (repo RoleRepository) GetUserPermissions(ctx context.Context, userID uint) ([]string, error) {
+	var codes []string
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("user_roles").
+		Distinct("permissions.code").
+		Joins("JOIN role_permissions ON role_permissions.role_id = user_roles.role_id").
+		Joins("JOIN permissions ON permissions.id = role_permissions.permission_id").
+		Where("user_roles.user_id = ?", userID).
+		Order("permissions.code ASC").
+		Pluck("permissions.code", &codes).Error
+	return codes, err
+}
+
+// UserRequiresTwoFactor reports whether any role held by the user makes two-factor authentication mandatory
+func (repo RoleRepository) UserRequiresTwoFactor(ctx context.Context, userID uint) (bool, error) {
+	var count int64
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("user_roles").
+		Joins("JOIN roles ON roles.id = user_roles.role_id").
+		Where("user_roles.user_id = ? AND roles.requires_two_factor", userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// loadPermissions fills the permission codes of every role in a single query
+func (repo RoleRepository) loadPermissions(ctx context.Context, roles []role.Role) error {
+	if len(roles) == 0 {
+		return nil
+	}
+	ids := make([]uint, 0, len(roles))
+	for _, r := range roles {
+		ids = append(ids, r.ID)
+	}
+
+	var rows []rolePermissionCode
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("role_permissions").
+		Select("role_permissions.role_id, permissions.code").
+		Joins("JOIN permissions ON permissions.id = role_permissions.permission_id").
+		Where("role_permissions.role_id IN ?", ids).
+		Order("permissions.code ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	codes := make(map[uint][]string, len(roles))
+	for _, row := range rows {
+		codes[row.RoleID] = append(codes[row.RoleID], row.Code)
+	}
+	for i := range roles {
+		roles[i].Permissions = codes[roles[i].ID]
+		if roles[i].Permissions == nil {
+			roles[i].Permissions = []string{}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package role
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/role"
+)
+
+func TestRoleRepository_GetPermissionsByCodes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Test data
+		codes := []string{constant.PermissionPayrollRun, constant.PermissionPayrollReport}
+		expected := []role.Permission{
+			{ID: 9, Code: constant.PermissionPayrollRun},
+			{ID: 10, Code: constant.PermissionPayrollReport},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetPermissionsByCodes", mock.Anything, codes).Return(expected, nil)
+
+		// Execute
+		permissions, err := mockRepo.GetPermissionsByCodes(context.Background(), codes)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, permissions, 2)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown code is left out", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Test data
+		codes := []string{constant.PermissionPayrollRun, "payroll:delete"}
+		expected := []role.Permission{
+			{ID: 9, Code: constant.PermissionPayrollRun},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetPermissionsByCodes", mock.Anything, codes).Return(expected, nil)
+
+		// Execute
+		permissions, err := mockRepo.GetPermissionsByCodes(context.Background(), codes)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, permissions, 1)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestRoleRepository_GetRoleByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Test data
+		expected := &role.Role{
+			ID:          3,
+			Name:        "hr",
+			Permissions: []string{constant.PermissionUserManage, constant.PermissionEmployeeReadSensitive},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetRoleByID", mock.Anything, uint(3)).Return(expected, nil)
+
+		// Execute
+		r, err := mockRepo.GetRoleByID(context.Background(), 3)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "hr", r.Name)
+		assert.Contains(t, r.Permissions, constant.PermissionUserManage)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetRoleByID", mock.Anything, uint(999)).Return(nil, gorm.ErrRecordNotFound)
+
+		// Execute
+		r, err := mockRepo.GetRoleByID(context.Background(), 999)
+
+		// Assert
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.Nil(t, r)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestRoleRepository_CreateRole(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Test data
+		createdBy := uint(1)
+		r := &role.Role{Name: "auditor", CreatedBy: &createdBy}
+
+		// Setup expectations
+		mockRepo.On("CreateRole", mock.Anything, r).Return(nil)
+
+		// Execute
+		err := mockRepo.CreateRole(context.Background(), r)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Test data
+		r := &role.Role{Name: "admin"}
+
+		// Setup expectations
+		mockRepo.On("CreateRole", mock.Anything, r).Return(gorm.ErrDuplicatedKey)
+
+		// Execute
+		err := mockRepo.CreateRole(context.Background(), r)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestRoleRepository_SetRolePermissions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Setup expectations
+		mockRepo.On("SetRolePermissions", mock.Anything, uint(6), []uint{1, 2, 3}).Return(nil)
+
+		// Execute
+		err := mockRepo.SetRolePermissions(context.Background(), 6, []uint{1, 2, 3})
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestRoleRepository_SetUserRoles(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Setup expectations
+		mockRepo.On("SetUserRoles", mock.Anything, uint(2), []uint{2, 3}, uint(1)).Return(nil)
+
+		// Execute
+		err := mockRepo.SetUserRoles(context.Background(), 2, []uint{2, 3}, 1)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Setup expectations
+		mockRepo.On("SetUserRoles", mock.Anything, uint(2), []uint{999}, uint(1)).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.SetUserRoles(context.Background(), 2, []uint{999}, 1)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestRoleRepository_GetUserPermissions(t *testing.T) {
+	t.Run("combined from every role", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Test data
+		expected := []string{
+			constant.PermissionAttendanceSelf,
+			constant.PermissionOvertimeReview,
+			constant.PermissionPayslipSelf,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetUserPermissions", mock.Anything, uint(2)).Return(expected, nil)
+
+		// Execute
+		permissions, err := mockRepo.GetUserPermissions(context.Background(), 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, permissions)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("no roles", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIRoleRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetUserPermissions", mock.Anything, uint(5)).Return([]string{}, nil)
+
+		// Execute
+		permissions, err := mockRepo.GetUserPermissions(context.Background(), 5)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, permissions)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestRoleRepository_UserRequiresTwoFactor(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   uint
+		expected bool
+	}{
+		{"admin role requires it", 1, true},
+		{"employee role does not", 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			mockRepo := &mocks.MockIRoleRepository{}
+
+			// Setup expectations
+			mockRepo.On("UserRequiresTwoFactor", mock.Anything, tt.userID).Return(tt.expected, nil)
+
+			// Execute
+			required, err := mockRepo.UserRequiresTwoFactor(context.Background(), tt.userID)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, required)
+
+			// Verify expectations
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package termination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/models/payslip"
+	"github.com/riskykurniawan15/payrolls/models/termination"
+	"gorm.io/gorm"
+)
+
+type (
+	ITerminationRepository interface {
+		Create(ctx context.Context, t *termination.Termination) error
+		GetByID(ctx context.Context, id uint) (*termination.Termination, error)
+		GetByUserID(ctx context.Context, userID uint) (*termination.Termination, error)
+		List(ctx context.Context, req termination.ListTerminationsRequest) (*termination.ListTerminationsResponse, error)
+		Update(ctx context.Context, id uint, updates map[string]interface{}) error
+		Delete(ctx context.Context, id uint) error
+		ListDueForDeactivation(ctx context.Context, today time.Time) ([]termination.Termination, error)
+		GetLastPaidPeriodEnd(ctx context.Context, userID uint) (*time.Time, error)
+		GetSettlementPayslipData(ctx context.Context, id uint) (*payslip.SettlementPayslipData, error)
+	}
+
+	TerminationRepository struct {
+		db *gorm.DB
+	}
+)
+
+func NewTerminationRepository(db *gorm.DB) ITerminationRepository {
+	return &TerminationRepository{db: db}
+}
+
+func (repo TerminationRepository) getInstanceDB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(constant.TransactionKey).(*gorm.DB)
+	if !ok {
+		return repo.db
+	}
+	return tx
+}
+
+func (repo TerminationRepository) withUsername(db *gorm.DB) *gorm.DB {
+	return db.Model(&termination.Termination{}).
+		Select("terminations.*, users.username").
+		Joins("JOIN users ON users.id = terminations.user_id")
+}
+
+func (repo TerminationRepository) Create(ctx context.Context, t *termination.Termination) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(t).Error
+}
+
+func (repo TerminationRepository) GetByID(ctx context.Context, id uint) (*termination.Termination, error) {
+	var t termination.Termination
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.withUsername(repo.getInstanceDB(ctx).WithContext(ctxWT)).Where("terminations.id = ?", id).First(&t).Error; err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (repo TerminationRepository) GetByUserID(ctx context.Context, userID uint) (*termination.Termination, error) {
+	var t termination.Termination
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.withUsername(repo.getInstanceDB(ctx).WithContext(ctxWT)).Where("terminations.user_id = ?", userID).First(&t).Error; err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (repo TerminationRepository) List(ctx context.Context, req termination.ListTerminationsRequest) (*termination.ListTerminationsResponse, error) {
+	var terminations []termination.Termination
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	query := repo.withUsername(repo.getInstanceDB(ctx).WithContext(ctxWT))
+
+	// Apply filters
+	if req.Status != "" {
+		query = query.Where("terminations.status = ?", req.Status)
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (req.Page - 1) * req.Limit
+	if err := query.Order("terminations.last_working_day DESC, terminations.id DESC").Offset(offset).Limit(req.Limit).Find(&terminations).Error; err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return &termination.ListTerminationsResponse{
+		Data: terminations,
+		Pagination: termination.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (repo TerminationRepository) Update(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&termination.Termination{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (repo TerminationRepository) Delete(ctx context.Context, id uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Where("id = ?", id).Delete(&termination.Termination{}).Error
+}
+
+// ListDueForDeactivation returns the terminations whose last working day is before today and whose user is still active
+func (repo TerminationRepository) ListDueForDeactivation(ctx context.Context, today time.Time) ([]termination.Termination, error) {
+	var terminations []termination.Termination
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("last_working_day < ? AND deactivated_at IS NULL", today).
+		Order("last_working_day ASC").
+		Find(&terminations).Error
+	return terminations, err
+}
+
+// GetLastPaidPeriodEnd returns the end date of the latest payroll period the user was paid in, nil when there is none
+func (repo TerminationRepository) GetLastPaidPeriodEnd(ctx context.Context, userID uint) (*time.Time, error) {
+	var endDate *time.Time
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("period_details").
+		Select("MAX(periods.end_date)").
+		Joins("JOIN periods ON periods.id = period_details.periods_id").
+		Where("period_details.user_id = ?", userID).
+		Scan(&endDate).Error
+	return endDate, err
+}
+
+// GetSettlementPayslipData returns the saved final settlement of a termination for its payslip
+func (repo TerminationRepository) GetSettlementPayslipData(ctx context.Context, id uint) (*payslip.SettlementPayslipData, error) {
+	t, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("termination not found: %w", err)
+	}
+	if t.Status != constant.TerminationStatusSettled {
+		return nil, fmt.Errorf("termination is not settled yet")
+	}
+
+	// Payslips show the full name and employee number from the HR profile, falling back to the username.
+	// Scan leaves the struct empty when there is no profile, only a failed query is an error
+	var profile struct {
+		FullName       string
+		EmployeeNumber string
+		JoinDate       *time.Time
+	}
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err = repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Table("employee_profiles").
+		Select("full_name, employee_number, join_date").
+		Where("user_id = ?", t.UserID).
+		Limit(1).
+		Scan(&profile).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get employee profile: %w", err)
+	}
+
+	employeeName := t.Username
+	if profile.FullName != "" {
+		employeeName = profile.FullName
+	}
+
+	// Parse overtime data
+	var overtimeDetails []payslip.OvertimeData
+	if t.Overtime != nil {
+		json.Unmarshal(*t.Overtime, &overtimeDetails)
+	}
+
+	// Parse reimbursement data
+	var reimbursements []payslip.ReimbursementData
+	if t.Reimbursement != nil {
+		json.Unmarshal(*t.Reimbursement, &reimbursements)
+	}
+
+	return &payslip.SettlementPayslipData{
+		EmployeeName:       employeeName,
+		EmployeeNumber:     profile.EmployeeNumber,
+		Reason:             t.Reason,
+		JoinDate:           profile.JoinDate,
+		LastWorkingDay:     t.LastWorkingDay,
+		ServiceMonths:      t.ServiceMonths,
+		StartDate:          t.SettlementStartDate,
+		TotalWorking:       t.TotalWorking,
+		DailyRate:          t.DailyRate,
+		MonthlyWage:        t.MonthlyWage,
+		ProratedSalary:     t.ProratedSalary,
+		OvertimeDetails:    overtimeDetails,
+		TotalOvertime:      t.AmountOvertime,
+		Reimbursements:     reimbursements,
+		TotalReimbursement: t.AmountReimbursement,
+		UnusedLeaveDays:    t.UnusedLeaveDays,
+		LeaveEncashment:    t.LeaveEncashment,
+		SeveranceMonths:    t.SeveranceMonths,
+		SeverancePay:       t.SeverancePay,
+		ServiceAwardMonths: t.ServiceAwardMonths,
+		ServiceAwardPay:    t.ServiceAwardPay,
+		CompensationMonths: t.CompensationMonths,
+		CompensationPay:    t.CompensationPay,
+		SeparationPay:      t.SeparationPay,
+		LoanDeduction:      t.LoanDeduction,
+		TakeHomePay:        t.TakeHomePay,
+		SettledAt:          t.SettledAt,
+		GeneratedAt:        time.Now(),
+	}, nil
+}
@@ -0,0 +1,142 @@
+package termination
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/termination"
+)
+
+func TestTerminationRepository_GetByUserID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockITerminationRepository{}
+
+		// Test data
+		expected := &termination.Termination{ID: 3, UserID: 12, Username: "budi", Reason: "resignation", Status: "scheduled"}
+
+		// Setup expectations
+		mockRepo.On("GetByUserID", mock.Anything, uint(12)).Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.GetByUserID(context.Background(), 12)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), result.ID)
+		assert.Equal(t, "budi", result.Username)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockITerminationRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetByUserID", mock.Anything, uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		// Execute
+		result, err := mockRepo.GetByUserID(context.Background(), 99)
+
+		// Assert
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestTerminationRepository_ListDueForDeactivation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockITerminationRepository{}
+
+		// Test data
+		today := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+		expected := []termination.Termination{
+			{ID: 1, UserID: 5, LastWorkingDay: time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC)},
+		}
+
+		// Setup expectations
+		mockRepo.On("ListDueForDeactivation", mock.Anything, today).Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.ListDueForDeactivation(context.Background(), today)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, uint(5), result[0].UserID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockITerminationRepository{}
+
+		// Setup expectations
+		mockRepo.On("ListDueForDeactivation", mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+		// Execute
+		result, err := mockRepo.ListDueForDeactivation(context.Background(), time.Now())
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestTerminationRepository_GetLastPaidPeriodEnd(t *testing.T) {
+	t.Run("paid before", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockITerminationRepository{}
+
+		// Test data
+		endDate := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+		// Setup expectations
+		mockRepo.On("GetLastPaidPeriodEnd", mock.Anything, uint(12)).Return(&endDate, nil)
+
+		// Execute
+		result, err := mockRepo.GetLastPaidPeriodEnd(context.Background(), 12)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, endDate, *result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("never paid", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockITerminationRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetLastPaidPeriodEnd", mock.Anything, uint(13)).Return(nil, nil)
+
+		// Execute
+		result, err := mockRepo.GetLastPaidPeriodEnd(context.Background(), 13)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
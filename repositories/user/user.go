@@ -2,6 +2,8 @@ package user
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/models/user"
@@ -12,7 +14,33 @@ type (
 	IUserRepository interface {
 		GetUserByUsername(ctx context.Context, username string) (user.User, error)
 		GetUserByID(ctx context.Context, id uint) (user.User, error)
+		GetUserByEmail(ctx context.Context, email string) (user.User, error)
+		GetUserByOIDCSubject(ctx context.Context, subject string) (user.User, error)
 		CreateUser(ctx context.Context, user user.User) (user.User, error)
+		GetEmployees(ctx context.Context) ([]user.User, error)
+		UpdateUser(ctx context.Context, id uint, updates map[string]interface{}) error
+		ListUsers(ctx context.Context, req user.ListUsersRequest) (*user.ListUsersResponse, error)
+		GetEmployeeProfile(ctx context.Context, userID uint) (*user.EmployeeProfile, error)
+		GetEmployeeProfileByNumber(ctx context.Context, employeeNumber string) (*user.EmployeeProfile, error)
+		SaveEmployeeProfile(ctx context.Context, profile *user.EmployeeProfile) error
+		UpdateEmployeeProfile(ctx context.Context, userID uint, updates map[string]interface{}) error
+		CreatePasswordResetToken(ctx context.Context, token *user.PasswordResetToken) error
+		GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*user.PasswordResetToken, error)
+		UsePasswordResetToken(ctx context.Context, id uint) (bool, error)
+		InvalidatePasswordResetTokens(ctx context.Context, userID uint) error
+		RecordFailedLogin(ctx context.Context, id uint, since time.Time) (int, error)
+		UseTwoFactorStep(ctx context.Context, id uint, step int64) (bool, error)
+		CreateTwoFactorChallenge(ctx context.Context, challenge *user.TwoFactorChallenge) error
+		GetTwoFactorChallengeByHash(ctx context.Context, tokenHash string) (*user.TwoFactorChallenge, error)
+		RecordTwoFactorChallengeFailure(ctx context.Context, id uint) error
+		UseTwoFactorChallenge(ctx context.Context, id uint) (bool, error)
+		ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error
+		UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error)
+		CountUnusedRecoveryCodes(ctx context.Context, userID uint) (int64, error)
+		DeleteRecoveryCodes(ctx context.Context, userID uint) error
+		CreateOIDCLoginState(ctx context.Context, state *user.OIDCLoginState) error
+		GetOIDCLoginStateByHash(ctx context.Context, stateHash string) (*user.OIDCLoginState, error)
+		UseOIDCLoginState(ctx context.Context, id uint) (bool, error)
 	}
 
 	UserRepository struct {
@@ -54,6 +82,26 @@ func (repo UserRepository) GetUserByID(ctx context.Context, id uint) (user user.
 	return user, nil
 }
 
+func (repo UserRepository) GetUserByEmail(ctx context.Context, email string) (user user.User, err error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err = repo.getInstanceDB(ctx).WithContext(ctxWT).Where("LOWER(email) = LOWER(?)", email).First(&user).Error; err != nil {
+		return
+	}
+
+	return user, nil
+}
+
+func (repo UserRepository) GetUserByOIDCSubject(ctx context.Context, subject string) (user user.User, err error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err = repo.getInstanceDB(ctx).WithContext(ctxWT).Where("oidc_subject = ?", subject).First(&user).Error; err != nil {
+		return
+	}
+
+	return user, nil
+}
+
 func (repo UserRepository) CreateUser(ctx context.Context, user user.User) (createdUser user.User, err error) {
 	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
 	defer cancel()
@@ -63,3 +111,284 @@ func (repo UserRepository) CreateUser(ctx context.Context, user user.User) (crea
 
 	return user, nil
 }
+
+func (repo UserRepository) GetEmployees(ctx context.Context) (users []user.User, err error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err = repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Where("roles = ? AND status = ?", constant.EmployeeRole, constant.StatusActive).
+		Order("id ASC").
+		Find(&users).Error
+	return
+}
+
+func (repo UserRepository) UpdateUser(ctx context.Context, id uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&user.User{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (repo UserRepository) ListUsers(ctx context.Context, req user.ListUsersRequest) (*user.ListUsersResponse, error) {
+	var users []user.User
+	var total int64
+
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+
+	// Build query
+	query := repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&user.User{})
+
+	// Apply filters
+	if req.Search != "" {
+		query = query.Where("LOWER(username) LIKE ?", "%"+strings.ToLower(req.Search)+"%")
+	}
+	if req.Role != "" {
+		query = query.Where("roles = ?", req.Role)
+	}
+	if req.Status != nil {
+		query = query.Where("status = ?", *req.Status)
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// Apply pagination
+	offset := (req.Page - 1) * req.Limit
+	if err := query.Order("username ASC").Offset(offset).Limit(req.Limit).Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	// Convert to response
+	responses := make([]user.UserResponse, 0, len(users))
+	for _, u := range users {
+		responses = append(responses, u.ToResponse())
+	}
+
+	// Calculate pagination info
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return &user.ListUsersResponse{
+		Data: responses,
+		Pagination: user.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (repo UserRepository) GetEmployeeProfile(ctx context.Context, userID uint) (*user.EmployeeProfile, error) {
+	var profile user.EmployeeProfile
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("user_id = ?", userID).First(&profile).Error; err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (repo UserRepository) GetEmployeeProfileByNumber(ctx context.Context, employeeNumber string) (*user.EmployeeProfile, error) {
+	var profile user.EmployeeProfile
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("LOWER(employee_number) = LOWER(?)", employeeNumber).First(&profile).Error; err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// SaveEmployeeProfile inserts the profile when it has no ID yet, otherwise replaces every column
+func (repo UserRepository) SaveEmployeeProfile(ctx context.Context, profile *user.EmployeeProfile) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Save(profile).Error
+}
+
+func (repo UserRepository) UpdateEmployeeProfile(ctx context.Context, userID uint, updates map[string]interface{}) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Model(&user.EmployeeProfile{}).Where("user_id = ?", userID).Updates(updates).Error
+}
+
+func (repo UserRepository) CreatePasswordResetToken(ctx context.Context, token *user.PasswordResetToken) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(token).Error
+}
+
+func (repo UserRepository) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*user.PasswordResetToken, error) {
+	var token user.PasswordResetToken
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// UsePasswordResetToken marks the token as used, it reports false when the token was already redeemed
+func (repo UserRepository) UsePasswordResetToken(ctx context.Context, id uint) (bool, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&user.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// InvalidatePasswordResetTokens marks every unused token of the user as used so only the newest one works
+func (repo UserRepository) InvalidatePasswordResetTokens(ctx context.Context, userID uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&user.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now()).Error
+}
+
+// RecordFailedLogin increments the consecutive failed logins of the user and returns the new count,
+// the count starts over when the previous failure happened before since
+func (repo UserRepository) RecordFailedLogin(ctx context.Context, id uint, since time.Time) (count int, err error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	db := repo.getInstanceDB(ctx).WithContext(ctxWT)
+	err = db.Model(&user.User{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"failed_login_count":   gorm.Expr("CASE WHEN last_failed_login_at >= ? THEN failed_login_count + 1 ELSE 1 END", since),
+			"last_failed_login_at": time.Now(),
+		}).Error
+	if err != nil {
+		return
+	}
+	err = db.Model(&user.User{}).Where("id = ?", id).Select("failed_login_count").Scan(&count).Error
+	return
+}
+
+// UseTwoFactorStep records the time step of an accepted code, it reports false when that step or a later one
+// was already used so a code cannot be replayed
+func (repo UserRepository) UseTwoFactorStep(ctx context.Context, id uint, step int64) (bool, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&user.User{}).
+		Where("id = ? AND two_factor_last_step < ?", id, step).
+		UpdateColumn("two_factor_last_step", step)
+	return result.RowsAffected == 1, result.Error
+}
+
+func (repo UserRepository) CreateTwoFactorChallenge(ctx context.Context, challenge *user.TwoFactorChallenge) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(challenge).Error
+}
+
+func (repo UserRepository) GetTwoFactorChallengeByHash(ctx context.Context, tokenHash string) (*user.TwoFactorChallenge, error) {
+	var challenge user.TwoFactorChallenge
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("token_hash = ?", tokenHash).First(&challenge).Error; err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// RecordTwoFactorChallengeFailure counts a wrong code against the challenge
+func (repo UserRepository) RecordTwoFactorChallengeFailure(ctx context.Context, id uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&user.TwoFactorChallenge{}).
+		Where("id = ?", id).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// UseTwoFactorChallenge marks the challenge as completed, it reports false when it was already used
+func (repo UserRepository) UseTwoFactorChallenge(ctx context.Context, id uint) (bool, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&user.TwoFactorChallenge{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// ReplaceRecoveryCodes removes every recovery code of the user and stores the given hashes in one transaction
+// so the old codes stay valid when storing the new ones fails
+func (repo UserRepository) ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&user.TwoFactorRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		if len(codeHashes) == 0 {
+			return nil
+		}
+
+		codes := make([]user.TwoFactorRecoveryCode, 0, len(codeHashes))
+		for _, hash := range codeHashes {
+			codes = append(codes, user.TwoFactorRecoveryCode{UserID: userID, CodeHash: hash})
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+// UseRecoveryCode marks a matching unused recovery code as used, it reports false when none matched
+func (repo UserRepository) UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&user.TwoFactorRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (repo UserRepository) CountUnusedRecoveryCodes(ctx context.Context, userID uint) (count int64, err error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	err = repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&user.TwoFactorRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return
+}
+
+func (repo UserRepository) DeleteRecoveryCodes(ctx context.Context, userID uint) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Where("user_id = ?", userID).Delete(&user.TwoFactorRecoveryCode{}).Error
+}
+
+func (repo UserRepository) CreateOIDCLoginState(ctx context.Context, state *user.OIDCLoginState) error {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	return repo.getInstanceDB(ctx).WithContext(ctxWT).Create(state).Error
+}
+
+func (repo UserRepository) GetOIDCLoginStateByHash(ctx context.Context, stateHash string) (*user.OIDCLoginState, error) {
+	var state user.OIDCLoginState
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	if err := repo.getInstanceDB(ctx).WithContext(ctxWT).Where("state_hash = ?", stateHash).First(&state).Error; err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// UseOIDCLoginState marks the sign-in as completed, it reports false when its callback was already handled
+func (repo UserRepository) UseOIDCLoginState(ctx context.Context, id uint) (bool, error) {
+	ctxWT, cancel := context.WithTimeout(ctx, constant.DBTimeout)
+	defer cancel()
+	result := repo.getInstanceDB(ctx).WithContext(ctxWT).
+		Model(&user.OIDCLoginState{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -256,6 +257,780 @@ func TestUserRepository_GetUserByUsername(t *testing.T) {
 }
 
 // Test untuk memastikan interface berfungsi dengan benar
+func TestUserRepository_GetEmployees(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		expectedUsers := []user.User{
+			{ID: 2, Username: "employee1", Role: "employee", Salary: 5000000},
+			{ID: 3, Username: "employee2", Role: "employee", Salary: 6000000},
+		}
+
+		// Setup expectations
+		mockRepo.On("GetEmployees", mock.Anything).Return(expectedUsers, nil)
+
+		// Execute
+		users, err := mockRepo.GetEmployees(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, users, 2)
+		assert.Equal(t, "employee1", users[0].Username)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetEmployees", mock.Anything).Return(nil, assert.AnError)
+
+		// Execute
+		users, err := mockRepo.GetEmployees(context.Background())
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, users)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_UpdateUser(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		updates := map[string]interface{}{
+			"salary":     6500000.0,
+			"status":     2,
+			"updated_by": uint(1),
+		}
+
+		// Setup expectations
+		mockRepo.On("UpdateUser", mock.Anything, uint(2), updates).Return(nil)
+
+		// Execute
+		err := mockRepo.UpdateUser(context.Background(), 2, updates)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		updates := map[string]interface{}{"roles": "admin"}
+
+		// Setup expectations
+		mockRepo.On("UpdateUser", mock.Anything, uint(999), updates).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.UpdateUser(context.Background(), 999, updates)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_ListUsers(t *testing.T) {
+	t.Run("success with filters", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		status := int8(1)
+		req := user.ListUsersRequest{
+			Page:   1,
+			Limit:  10,
+			Search: "employee",
+			Role:   "employee",
+			Status: &status,
+		}
+
+		expectedResponse := &user.ListUsersResponse{
+			Data: []user.UserResponse{
+				{ID: 2, Username: "employee1", Role: "employee", Salary: 5000000, Status: 1},
+				{ID: 3, Username: "employee2", Role: "employee", Salary: 6000000, Status: 1},
+			},
+			Pagination: user.Pagination{
+				Page:       1,
+				Limit:      10,
+				Total:      2,
+				TotalPages: 1,
+			},
+		}
+
+		// Setup expectations
+		mockRepo.On("ListUsers", mock.Anything, req).Return(expectedResponse, nil)
+
+		// Execute
+		response, err := mockRepo.ListUsers(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, response.Data, 2)
+		assert.Equal(t, 2, response.Pagination.Total)
+		assert.Equal(t, "employee1", response.Data[0].Username)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		req := user.ListUsersRequest{Page: 1, Limit: 10}
+
+		// Setup expectations
+		mockRepo.On("ListUsers", mock.Anything, req).Return(nil, assert.AnError)
+
+		// Execute
+		response, err := mockRepo.ListUsers(context.Background(), req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, response)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_GetEmployeeProfile(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		nik := "3174011505900001"
+		expectedProfile := &user.EmployeeProfile{
+			ID:             1,
+			UserID:         2,
+			FullName:       "John Doe",
+			EmployeeNumber: "EMP-0001",
+			NIK:            &nik,
+		}
+
+		// Setup expectations
+		mockRepo.On("GetEmployeeProfile", mock.Anything, uint(2)).Return(expectedProfile, nil)
+
+		// Execute
+		profile, err := mockRepo.GetEmployeeProfile(context.Background(), 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "John Doe", profile.FullName)
+		assert.Equal(t, "EMP-0001", profile.EmployeeNumber)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetEmployeeProfile", mock.Anything, uint(999)).Return(nil, assert.AnError)
+
+		// Execute
+		profile, err := mockRepo.GetEmployeeProfile(context.Background(), 999)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, profile)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_GetEmployeeProfileByNumber(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		expectedProfile := &user.EmployeeProfile{ID: 1, UserID: 2, FullName: "John Doe", EmployeeNumber: "EMP-0001"}
+
+		// Setup expectations
+		mockRepo.On("GetEmployeeProfileByNumber", mock.Anything, "EMP-0001").Return(expectedProfile, nil)
+
+		// Execute
+		profile, err := mockRepo.GetEmployeeProfileByNumber(context.Background(), "EMP-0001")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint(2), profile.UserID)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_SaveEmployeeProfile(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		createdBy := uint(1)
+		profile := &user.EmployeeProfile{
+			UserID:         2,
+			FullName:       "John Doe",
+			EmployeeNumber: "EMP-0001",
+			CreatedBy:      &createdBy,
+		}
+
+		// Setup expectations
+		mockRepo.On("SaveEmployeeProfile", mock.Anything, profile).Return(nil)
+
+		// Execute
+		err := mockRepo.SaveEmployeeProfile(context.Background(), profile)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("duplicate employee number", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		profile := &user.EmployeeProfile{UserID: 3, FullName: "Jane Doe", EmployeeNumber: "EMP-0001"}
+
+		// Setup expectations
+		mockRepo.On("SaveEmployeeProfile", mock.Anything, profile).Return(assert.AnError)
+
+		// Execute
+		err := mockRepo.SaveEmployeeProfile(context.Background(), profile)
+
+		// Assert
+		assert.Error(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_UpdateEmployeeProfile(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		updates := map[string]interface{}{
+			"phone":      "081234567890",
+			"updated_by": uint(2),
+		}
+
+		// Setup expectations
+		mockRepo.On("UpdateEmployeeProfile", mock.Anything, uint(2), updates).Return(nil)
+
+		// Execute
+		err := mockRepo.UpdateEmployeeProfile(context.Background(), 2, updates)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_CreatePasswordResetToken(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		createdBy := uint(1)
+		token := &user.PasswordResetToken{
+			UserID:    2,
+			TokenHash: "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8",
+			ExpiresAt: time.Now().Add(time.Hour),
+			CreatedBy: &createdBy,
+		}
+
+		// Setup expectations
+		mockRepo.On("CreatePasswordResetToken", mock.Anything, token).Return(nil)
+
+		// Execute
+		err := mockRepo.CreatePasswordResetToken(context.Background(), token)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_GetPasswordResetTokenByHash(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		expected := &user.PasswordResetToken{
+			ID:        1,
+			UserID:    2,
+			TokenHash: "hash",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		// Setup expectations
+		mockRepo.On("GetPasswordResetTokenByHash", mock.Anything, "hash").Return(expected, nil)
+
+		// Execute
+		token, err := mockRepo.GetPasswordResetTokenByHash(context.Background(), "hash")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint(2), token.UserID)
+		assert.True(t, token.IsUsableAt(time.Now()))
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetPasswordResetTokenByHash", mock.Anything, "unknown").Return(nil, assert.AnError)
+
+		// Execute
+		token, err := mockRepo.GetPasswordResetTokenByHash(context.Background(), "unknown")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, token)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_UsePasswordResetToken(t *testing.T) {
+	t.Run("first use", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("UsePasswordResetToken", mock.Anything, uint(1)).Return(true, nil)
+
+		// Execute
+		used, err := mockRepo.UsePasswordResetToken(context.Background(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, used)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already used", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("UsePasswordResetToken", mock.Anything, uint(1)).Return(false, nil)
+
+		// Execute
+		used, err := mockRepo.UsePasswordResetToken(context.Background(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, used)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_InvalidatePasswordResetTokens(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("InvalidatePasswordResetTokens", mock.Anything, uint(2)).Return(nil)
+
+		// Execute
+		err := mockRepo.InvalidatePasswordResetTokens(context.Background(), 2)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_RecordFailedLogin(t *testing.T) {
+	t.Run("increments count", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		since := time.Now().Add(-15 * time.Minute)
+
+		// Setup expectations
+		mockRepo.On("RecordFailedLogin", mock.Anything, uint(2), since).Return(3, nil)
+
+		// Execute
+		count, err := mockRepo.RecordFailedLogin(context.Background(), 2, since)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("RecordFailedLogin", mock.Anything, uint(2), mock.Anything).Return(0, assert.AnError)
+
+		// Execute
+		count, err := mockRepo.RecordFailedLogin(context.Background(), 2, time.Now())
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, 0, count)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_UseTwoFactorStep(t *testing.T) {
+	t.Run("new step", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("UseTwoFactorStep", mock.Anything, uint(2), int64(58000000)).Return(true, nil)
+
+		// Execute
+		used, err := mockRepo.UseTwoFactorStep(context.Background(), 2, 58000000)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, used)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("replayed step", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("UseTwoFactorStep", mock.Anything, uint(2), int64(58000000)).Return(false, nil)
+
+		// Execute
+		used, err := mockRepo.UseTwoFactorStep(context.Background(), 2, 58000000)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, used)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_TwoFactorChallenge(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		challenge := &user.TwoFactorChallenge{UserID: 2, TokenHash: "hash", ExpiresAt: time.Now().Add(5 * time.Minute)}
+
+		// Setup expectations
+		mockRepo.On("CreateTwoFactorChallenge", mock.Anything, challenge).Return(nil)
+
+		// Execute
+		err := mockRepo.CreateTwoFactorChallenge(context.Background(), challenge)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("get by hash", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		expected := &user.TwoFactorChallenge{ID: 1, UserID: 2, TokenHash: "hash"}
+
+		// Setup expectations
+		mockRepo.On("GetTwoFactorChallengeByHash", mock.Anything, "hash").Return(expected, nil)
+
+		// Execute
+		result, err := mockRepo.GetTwoFactorChallengeByHash(context.Background(), "hash")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("GetTwoFactorChallengeByHash", mock.Anything, "unknown").Return(nil, assert.AnError)
+
+		// Execute
+		result, err := mockRepo.GetTwoFactorChallengeByHash(context.Background(), "unknown")
+
+		// Assert
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("record failure and use", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("RecordTwoFactorChallengeFailure", mock.Anything, uint(1)).Return(nil)
+		mockRepo.On("UseTwoFactorChallenge", mock.Anything, uint(1)).Return(true, nil).Once()
+		mockRepo.On("UseTwoFactorChallenge", mock.Anything, uint(1)).Return(false, nil).Once()
+
+		// Execute
+		err := mockRepo.RecordTwoFactorChallengeFailure(context.Background(), 1)
+		first, firstErr := mockRepo.UseTwoFactorChallenge(context.Background(), 1)
+		second, secondErr := mockRepo.UseTwoFactorChallenge(context.Background(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, firstErr)
+		assert.True(t, first)
+		assert.NoError(t, secondErr)
+		assert.False(t, second)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestTwoFactorChallenge_IsUsableAt(t *testing.T) {
+	now := time.Now()
+	usedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		challenge user.TwoFactorChallenge
+		expected  bool
+	}{
+		{"fresh", user.TwoFactorChallenge{ExpiresAt: now.Add(time.Minute)}, true},
+		{"expired", user.TwoFactorChallenge{ExpiresAt: now.Add(-time.Second)}, false},
+		{"used", user.TwoFactorChallenge{ExpiresAt: now.Add(time.Minute), UsedAt: &usedAt}, false},
+		{"attempts exhausted", user.TwoFactorChallenge{ExpiresAt: now.Add(time.Minute), Attempts: 5}, false},
+		{"attempts left", user.TwoFactorChallenge{ExpiresAt: now.Add(time.Minute), Attempts: 4}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.challenge.IsUsableAt(now, 5))
+		})
+	}
+}
+
+func TestUserRepository_RecoveryCodes(t *testing.T) {
+	t.Run("replace", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		hashes := []string{"hash-1", "hash-2"}
+
+		// Setup expectations
+		mockRepo.On("ReplaceRecoveryCodes", mock.Anything, uint(2), hashes).Return(nil)
+
+		// Execute
+		err := mockRepo.ReplaceRecoveryCodes(context.Background(), 2, hashes)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("use", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("UseRecoveryCode", mock.Anything, uint(2), "hash-1").Return(true, nil)
+		mockRepo.On("UseRecoveryCode", mock.Anything, uint(2), "unknown").Return(false, nil)
+
+		// Execute
+		used, err := mockRepo.UseRecoveryCode(context.Background(), 2, "hash-1")
+		unknown, unknownErr := mockRepo.UseRecoveryCode(context.Background(), 2, "unknown")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, used)
+		assert.NoError(t, unknownErr)
+		assert.False(t, unknown)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("count and delete", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Setup expectations
+		mockRepo.On("CountUnusedRecoveryCodes", mock.Anything, uint(2)).Return(int64(9), nil)
+		mockRepo.On("DeleteRecoveryCodes", mock.Anything, uint(2)).Return(nil)
+
+		// Execute
+		count, err := mockRepo.CountUnusedRecoveryCodes(context.Background(), 2)
+		deleteErr := mockRepo.DeleteRecoveryCodes(context.Background(), 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), count)
+		assert.NoError(t, deleteErr)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUserRepository_SingleSignOn(t *testing.T) {
+	t.Run("find by email and subject", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		email := "jane@example.com"
+		subject := "f3b1c2d4"
+		expected := user.User{ID: 2, Username: "jane", Email: &email, OIDCSubject: &subject}
+
+		// Setup expectations
+		mockRepo.On("GetUserByEmail", mock.Anything, "Jane@Example.com").Return(expected, nil)
+		mockRepo.On("GetUserByOIDCSubject", mock.Anything, subject).Return(expected, nil)
+		mockRepo.On("GetUserByOIDCSubject", mock.Anything, "unknown").Return(user.User{}, assert.AnError)
+
+		// Execute
+		byEmail, err := mockRepo.GetUserByEmail(context.Background(), "Jane@Example.com")
+		bySubject, subjectErr := mockRepo.GetUserByOIDCSubject(context.Background(), subject)
+		unknown, unknownErr := mockRepo.GetUserByOIDCSubject(context.Background(), "unknown")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expected, byEmail)
+		assert.NoError(t, subjectErr)
+		assert.Equal(t, expected, bySubject)
+		assert.ErrorIs(t, unknownErr, assert.AnError)
+		assert.Equal(t, user.User{}, unknown)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("login state", func(t *testing.T) {
+		// Setup mock
+		mockRepo := &mocks.MockIUserRepository{}
+
+		// Test data
+		state := &user.OIDCLoginState{StateHash: "hash", Nonce: "nonce", CodeVerifier: "verifier", ExpiresAt: time.Now().Add(10 * time.Minute)}
+		stored := &user.OIDCLoginState{ID: 1, StateHash: "hash", Nonce: "nonce", CodeVerifier: "verifier", ExpiresAt: state.ExpiresAt}
+
+		// Setup expectations
+		mockRepo.On("CreateOIDCLoginState", mock.Anything, state).Return(nil)
+		mockRepo.On("GetOIDCLoginStateByHash", mock.Anything, "hash").Return(stored, nil)
+		mockRepo.On("UseOIDCLoginState", mock.Anything, uint(1)).Return(true, nil).Once()
+		mockRepo.On("UseOIDCLoginState", mock.Anything, uint(1)).Return(false, nil).Once()
+
+		// Execute
+		err := mockRepo.CreateOIDCLoginState(context.Background(), state)
+		result, getErr := mockRepo.GetOIDCLoginStateByHash(context.Background(), "hash")
+		first, firstErr := mockRepo.UseOIDCLoginState(context.Background(), 1)
+		second, secondErr := mockRepo.UseOIDCLoginState(context.Background(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, getErr)
+		assert.Equal(t, stored, result)
+		assert.NoError(t, firstErr)
+		assert.True(t, first)
+		assert.NoError(t, secondErr)
+		assert.False(t, second)
+
+		// Verify expectations
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestOIDCLoginState_IsUsableAt(t *testing.T) {
+	now := time.Now()
+	usedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		state    user.OIDCLoginState
+		expected bool
+	}{
+		{"fresh", user.OIDCLoginState{ExpiresAt: now.Add(time.Minute)}, true},
+		{"expired", user.OIDCLoginState{ExpiresAt: now.Add(-time.Second)}, false},
+		{"used", user.OIDCLoginState{ExpiresAt: now.Add(time.Minute), UsedAt: &usedAt}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.state.IsUsableAt(now))
+		})
+	}
+}
+
 func TestUserRepository_Interface(t *testing.T) {
 	t.Run("interface implementation", func(t *testing.T) {
 		// Setup mock
@@ -0,0 +1,315 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/api_key"
+	apiKeyRepo "github.com/riskykurniawan15/payrolls/repositories/api_key"
+	instanceRepo "github.com/riskykurniawan15/payrolls/repositories/instance"
+	roleRepo "github.com/riskykurniawan15/payrolls/repositories/role"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	"github.com/riskykurniawan15/payrolls/utils/apikey"
+	"github.com/riskykurniawan15/payrolls/utils/jwt"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+// lastUsedInterval limits how often last_used_at is written for a busy key
+const lastUsedInterval = time.Minute
+
+// nonDelegableScopes need a person behind the request: self service and team review act on the caller's
+// own records and reports, and role or key management would let a key widen its own access
+var nonDelegableScopes = map[string]bool{
+	constant.PermissionAttendanceSelf:    true,
+	constant.PermissionOvertimeSelf:      true,
+	constant.PermissionReimbursementSelf: true,
+	constant.PermissionPayslipSelf:       true,
+	constant.PermissionTeamReview:        true,
+	constant.PermissionRoleManage:        true,
+	constant.PermissionAPIKeyManage:      true,
+}
+
+type (
+	IAPIKeyService interface {
+		List(ctx context.Context) ([]api_key.APIKey, error)
+		Get(ctx context.Context, id uint) (*api_key.APIKey, error)
+		Create(ctx context.Context, req api_key.CreateAPIKeyRequest, createdBy uint) (*api_key.CreateAPIKeyResponse, error)
+		Revoke(ctx context.Context, id uint, revokedBy uint) error
+		AuthenticateAPIKey(ctx context.Context, key, ip string) (*api_key.Identity, error)
+	}
+
+	APIKeyService struct {
+		logger       logger.Logger
+		instanceRepo instanceRepo.IInstanceRepository
+		apiKeyRepo   apiKeyRepo.IAPIKeyRepository
+		roleRepo     roleRepo.IRoleRepository
+		userRepo     userRepo.IUserRepository
+	}
+)
+
+func NewAPIKeyService(logger logger.Logger, instanceRepo instanceRepo.IInstanceRepository, apiKeyRepo apiKeyRepo.IAPIKeyRepository, roleRepo roleRepo.IRoleRepository, userRepo userRepo.IUserRepository) IAPIKeyService {
+	return &APIKeyService{
+		logger:       logger,
+		instanceRepo: instanceRepo,
+		apiKeyRepo:   apiKeyRepo,
+		roleRepo:     roleRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (s *APIKeyService) List(ctx context.Context) ([]api_key.APIKey, error) {
+	keys, err := s.apiKeyRepo.List(ctx)
+	if err != nil {
+		s.logger.ErrorT("failed to list api keys", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *APIKeyService) Get(ctx context.Context, id uint) (*api_key.APIKey, error) {
+	key, err := s.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.WarningT("api key not found", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"api_key_id": id,
+			"error":      err.Error(),
+		})
+		return nil, errors.New("api key not found")
+	}
+	return key, nil
+}
+
+// Create issues a key limited to the given scopes, the creator can only delegate permissions they hold
+func (s *APIKeyService) Create(c context.Context, req api_key.CreateAPIKeyRequest, createdBy uint) (*api_key.CreateAPIKeyResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing create api key request", requestID, map[string]interface{}{
+		"name":       req.Name,
+		"scopes":     req.Scopes,
+		"created_by": createdBy,
+	})
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("expires_at must be in the future")
+	}
+
+	scopes := uniqueScopes(req.Scopes)
+	var refused []string
+	for _, scope := range scopes {
+		if nonDelegableScopes[scope] {
+			refused = append(refused, scope)
+		}
+	}
+	if len(refused) > 0 {
+		return nil, fmt.Errorf("permissions cannot be granted to an api key: %s", strings.Join(refused, ", "))
+	}
+
+	held, err := s.roleRepo.GetUserPermissions(c, createdBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions: %w", err)
+	}
+	for _, scope := range scopes {
+		if !contains(held, scope) {
+			refused = append(refused, scope)
+		}
+	}
+	if len(refused) > 0 {
+		return nil, fmt.Errorf("you can only grant permissions you hold: %s", strings.Join(refused, ", "))
+	}
+
+	permissionIDs, err := s.resolvePermissions(c, scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	key, prefix, err := apikey.Generate()
+	if err != nil {
+		s.logger.ErrorT("failed to generate api key", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, errors.New("failed to generate api key")
+	}
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		s.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	record := &api_key.APIKey{
+		Name:      strings.TrimSpace(req.Name),
+		Prefix:    prefix,
+		KeyHash:   jwt.HashToken(key),
+		ExpiresAt: req.ExpiresAt,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}
+	if err := s.apiKeyRepo.Create(ctx, record); err != nil {
+		s.logger.ErrorT("failed to create api key", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"name":  req.Name,
+		})
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+	if err := s.apiKeyRepo.SetScopes(ctx, record.ID, permissionIDs); err != nil {
+		s.logger.ErrorT("failed to set api key scopes", requestID, map[string]interface{}{
+			"error":      err.Error(),
+			"api_key_id": record.ID,
+		})
+		return nil, fmt.Errorf("failed to set api key scopes: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.logger.ErrorT("failed to commit api key", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	s.logger.InfoT("api key created", requestID, map[string]interface{}{
+		"api_key_id": record.ID,
+		"prefix":     prefix,
+		"scopes":     scopes,
+		"created_by": createdBy,
+	})
+
+	record.Scopes = scopes
+	return &api_key.CreateAPIKeyResponse{
+		APIKey: *record,
+		Key:    key,
+	}, nil
+}
+
+// Revoke stops a key from authenticating, requests already in flight are not affected
+func (s *APIKeyService) Revoke(ctx context.Context, id uint, revokedBy uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	if _, err := s.apiKeyRepo.GetByID(ctx, id); err != nil {
+		return errors.New("api key not found")
+	}
+
+	revoked, err := s.apiKeyRepo.Revoke(ctx, id, revokedBy)
+	if err != nil {
+		s.logger.ErrorT("failed to revoke api key", requestID, map[string]interface{}{
+			"api_key_id": id,
+			"error":      err.Error(),
+		})
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	if !revoked {
+		return errors.New("api key is already revoked")
+	}
+
+	s.logger.InfoT("api key revoked", requestID, map[string]interface{}{
+		"api_key_id": id,
+		"revoked_by": revokedBy,
+	})
+
+	return nil
+}
+
+// AuthenticateAPIKey is used by the JWT middleware for requests carrying an API key. The key acts as the user
+// who issued it, limited to the scopes of the key that the user still holds
+func (s *APIKeyService) AuthenticateAPIKey(ctx context.Context, key, ip string) (*api_key.Identity, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	now := time.Now()
+
+	if _, ok := apikey.Prefix(key); !ok {
+		return nil, errors.New("invalid api key")
+	}
+
+	record, err := s.apiKeyRepo.GetByHash(ctx, jwt.HashToken(key))
+	if err != nil || !record.IsActiveAt(now) {
+		return nil, errors.New("invalid api key")
+	}
+
+	owner, err := s.userRepo.GetUserByID(ctx, record.CreatedBy)
+	if err != nil || owner.Status != constant.StatusActive {
+		s.logger.WarningT("api key rejected for deactivated owner", requestID, map[string]interface{}{
+			"api_key_id": record.ID,
+			"owner_id":   record.CreatedBy,
+		})
+		return nil, errors.New("invalid api key")
+	}
+
+	held, err := s.roleRepo.GetUserPermissions(ctx, record.CreatedBy)
+	if err != nil {
+		s.logger.ErrorT("failed to load api key owner permissions", requestID, map[string]interface{}{
+			"api_key_id": record.ID,
+			"error":      err.Error(),
+		})
+		return nil, fmt.Errorf("failed to authenticate api key: %w", err)
+	}
+
+	permissions := make([]string, 0, len(record.Scopes))
+	for _, scope := range record.Scopes {
+		if !nonDelegableScopes[scope] && contains(held, scope) {
+			permissions = append(permissions, scope)
+		}
+	}
+
+	if record.LastUsedAt == nil || now.Sub(*record.LastUsedAt) >= lastUsedInterval || record.LastUsedIP == nil || *record.LastUsedIP != ip {
+		if err := s.apiKeyRepo.TouchLastUsed(ctx, record.ID, ip, now); err != nil {
+			s.logger.ErrorT("failed to record api key usage", requestID, map[string]interface{}{
+				"api_key_id": record.ID,
+				"error":      err.Error(),
+			})
+		}
+	}
+
+	return &api_key.Identity{
+		APIKeyID:    record.ID,
+		Prefix:      record.Prefix,
+		UserID:      record.CreatedBy,
+		Permissions: permissions,
+	}, nil
+}
+
+func (s *APIKeyService) resolvePermissions(ctx context.Context, codes []string) ([]uint, error) {
+	permissions, err := s.roleRepo.GetPermissionsByCodes(ctx, codes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions: %w", err)
+	}
+	if len(permissions) != len(codes) {
+		return nil, errors.New("unknown permissions in scopes")
+	}
+
+	ids := make([]uint, 0, len(permissions))
+	for _, p := range permissions {
+		ids = append(ids, p.ID)
+	}
+	return ids, nil
+}
+
+func uniqueScopes(scopes []string) []string {
+	seen := make(map[string]bool, len(scopes))
+	unique := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		unique = append(unique, scope)
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -3,14 +3,25 @@ package attendance
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
 	"github.com/riskykurniawan15/payrolls/models/attendance"
 	attendanceRepo "github.com/riskykurniawan15/payrolls/repositories/attendance"
+	officeLocationRepo "github.com/riskykurniawan15/payrolls/repositories/office_location"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	notificationService "github.com/riskykurniawan15/payrolls/services/notification"
+	"github.com/riskykurniawan15/payrolls/utils/data_tipes"
+	"github.com/riskykurniawan15/payrolls/utils/geo"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
 )
 
+// autoCloseBatchSize limits the attendances closed in one run
+const autoCloseBatchSize = 500
+
 type (
 	IAttendanceService interface {
 		GetAttendances(ctx context.Context, userID uint, page, limit int, startDate, endDate *time.Time) (attendance.AttendanceListResponse, error)
@@ -18,18 +29,37 @@ type (
 		CheckIn(ctx context.Context, userID uint, req attendance.AttendanceRequest) (attendance.AttendanceResponse, error)
 		CheckOut(ctx context.Context, userID uint, req attendance.AttendanceRequest) (attendance.AttendanceResponse, error)
 		CheckOutByID(ctx context.Context, id, userID uint, req attendance.AttendanceRequest) (attendance.AttendanceResponse, error)
+		AutoCloseForgottenCheckOuts(ctx context.Context, now time.Time) (int, error)
+		AdminList(ctx context.Context, req attendance.AdminAttendanceListRequest) (attendance.AdminAttendanceListResponse, error)
+		AdminGetByID(ctx context.Context, id uint) (attendance.AdminAttendanceResponse, error)
+		AdminCreate(ctx context.Context, adminID uint, req attendance.AdminAttendanceRequest) (attendance.AdminAttendanceResponse, error)
+		AdminUpdate(ctx context.Context, id, adminID uint, req attendance.AdminUpdateAttendanceRequest) (attendance.AdminAttendanceResponse, error)
 	}
 
 	AttendanceService struct {
-		attendanceRepo attendanceRepo.IAttendanceRepository
-		logger         logger.Logger
+		config             config.Config
+		attendanceRepo     attendanceRepo.IAttendanceRepository
+		officeLocationRepo officeLocationRepo.IOfficeLocationRepository
+		userRepo           userRepo.IUserRepository
+		notification       notificationService.INotificationService
+		logger             logger.Logger
+	}
+
+	// geofenceResult is the outcome of matching a device location against office geofences
+	geofenceResult struct {
+		OfficeLocationID *uint
+		OutsideGeofence  bool
 	}
 )
 
-func NewAttendanceService(logger logger.Logger, attendanceRepo attendanceRepo.IAttendanceRepository) IAttendanceService {
+func NewAttendanceService(logger logger.Logger, config config.Config, attendanceRepo attendanceRepo.IAttendanceRepository, officeLocationRepo officeLocationRepo.IOfficeLocationRepository, userRepo userRepo.IUserRepository, notification notificationService.INotificationService) IAttendanceService {
 	return &AttendanceService{
-		attendanceRepo: attendanceRepo,
-		logger:         logger,
+		config:             config,
+		attendanceRepo:     attendanceRepo,
+		officeLocationRepo: officeLocationRepo,
+		userRepo:           userRepo,
+		notification:       notification,
+		logger:             logger,
 	}
 }
 
@@ -76,17 +106,7 @@ func (service *AttendanceService) GetAttendances(ctx context.Context, userID uin
 	// Convert to response format
 	var responses []attendance.AttendanceResponse
 	for _, att := range attendances {
-		response := attendance.AttendanceResponse{
-			ID:           att.ID,
-			UserID:       att.UserID,
-			CheckInDate:  att.CheckInDate,
-			CheckOutDate: att.CheckOutDate,
-			CreatedAt:    att.CreatedAt,
-		}
-		if att.UpdatedAt != nil {
-			response.UpdatedAt = att.UpdatedAt
-		}
-		responses = append(responses, response)
+		responses = append(responses, service.toResponse(att))
 	}
 
 	totalPages := int((total + int64(limit) - 1) / int64(limit))
@@ -139,17 +159,7 @@ func (service *AttendanceService) GetAttendanceByID(ctx context.Context, id, use
 		"check_out_date": att.CheckOutDate,
 	})
 
-	response := attendance.AttendanceResponse{
-		ID:           att.ID,
-		UserID:       att.UserID,
-		CheckInDate:  att.CheckInDate,
-		CheckOutDate: att.CheckOutDate,
-		CreatedAt:    att.CreatedAt,
-	}
-	if att.UpdatedAt != nil {
-		response.UpdatedAt = att.UpdatedAt
-	}
-	return response, nil
+	return service.toResponse(att), nil
 }
 
 func (service *AttendanceService) CheckIn(ctx context.Context, userID uint, req attendance.AttendanceRequest) (attendance.AttendanceResponse, error) {
@@ -193,11 +203,23 @@ func (service *AttendanceService) CheckIn(ctx context.Context, userID uint, req
 		return attendance.AttendanceResponse{}, errors.New("you already have an active check-in. Please check-out first")
 	}
 
+	// Validate device location against office geofences
+	geofence, err := service.evaluateGeofence(ctx, userID, req, checkInDate)
+	if err != nil {
+		return attendance.AttendanceResponse{}, err
+	}
+
 	// Create new attendance record
 	attendanceData := attendance.Attendance{
-		UserID:      userID,
-		CheckInDate: checkInDate,
-		CreatedBy:   userID,
+		UserID:                  userID,
+		CheckInDate:             checkInDate,
+		CheckInLatitude:         req.Latitude,
+		CheckInLongitude:        req.Longitude,
+		CheckInAccuracy:         req.Accuracy,
+		CheckInOfficeLocationID: geofence.OfficeLocationID,
+		CheckInOutsideGeofence:  geofence.OutsideGeofence,
+		Source:                  constant.AttendanceSourceManual,
+		CreatedBy:               userID,
 	}
 
 	createdAttendance, err := service.attendanceRepo.CreateAttendance(ctx, attendanceData)
@@ -216,17 +238,7 @@ func (service *AttendanceService) CheckIn(ctx context.Context, userID uint, req
 		"check_in_date": checkInDate,
 	})
 
-	response := attendance.AttendanceResponse{
-		ID:           createdAttendance.ID,
-		UserID:       createdAttendance.UserID,
-		CheckInDate:  createdAttendance.CheckInDate,
-		CheckOutDate: createdAttendance.CheckOutDate,
-		CreatedAt:    createdAttendance.CreatedAt,
-	}
-	if createdAttendance.UpdatedAt != nil {
-		response.UpdatedAt = createdAttendance.UpdatedAt
-	}
-	return response, nil
+	return service.toResponse(createdAttendance), nil
 }
 
 func (service *AttendanceService) CheckOut(ctx context.Context, userID uint, req attendance.AttendanceRequest) (attendance.AttendanceResponse, error) {
@@ -271,8 +283,19 @@ func (service *AttendanceService) CheckOut(ctx context.Context, userID uint, req
 		return attendance.AttendanceResponse{}, errors.New("invalid check-out time. Check-out must be after check-in")
 	}
 
-	// Update attendance with check-out date
+	// Validate device location against office geofences
+	geofence, err := service.evaluateGeofence(ctx, userID, req, checkOutDate)
+	if err != nil {
+		return attendance.AttendanceResponse{}, err
+	}
+
+	// Update attendance with check-out date and location
 	attendanceData.CheckOutDate = &checkOutDate
+	attendanceData.CheckOutLatitude = req.Latitude
+	attendanceData.CheckOutLongitude = req.Longitude
+	attendanceData.CheckOutAccuracy = req.Accuracy
+	attendanceData.CheckOutOfficeLocationID = geofence.OfficeLocationID
+	attendanceData.CheckOutOutsideGeofence = geofence.OutsideGeofence
 	attendanceData.UpdatedBy = &userID
 	now := time.Now()
 	attendanceData.UpdatedAt = &now
@@ -295,17 +318,7 @@ func (service *AttendanceService) CheckOut(ctx context.Context, userID uint, req
 		"check_out_date": updatedAttendance.CheckOutDate,
 	})
 
-	response := attendance.AttendanceResponse{
-		ID:           updatedAttendance.ID,
-		UserID:       updatedAttendance.UserID,
-		CheckInDate:  updatedAttendance.CheckInDate,
-		CheckOutDate: updatedAttendance.CheckOutDate,
-		CreatedAt:    updatedAttendance.CreatedAt,
-	}
-	if updatedAttendance.UpdatedAt != nil {
-		response.UpdatedAt = updatedAttendance.UpdatedAt
-	}
-	return response, nil
+	return service.toResponse(updatedAttendance), nil
 }
 
 func (service *AttendanceService) CheckOutByID(ctx context.Context, id, userID uint, req attendance.AttendanceRequest) (attendance.AttendanceResponse, error) {
@@ -362,8 +375,19 @@ func (service *AttendanceService) CheckOutByID(ctx context.Context, id, userID u
 		return attendance.AttendanceResponse{}, errors.New("invalid check-out time. Check-out must be after check-in")
 	}
 
-	// Update attendance with check-out date
+	// Validate device location against office geofences
+	geofence, err := service.evaluateGeofence(ctx, userID, req, checkOutDate)
+	if err != nil {
+		return attendance.AttendanceResponse{}, err
+	}
+
+	// Update attendance with check-out date and location
 	attendanceData.CheckOutDate = &checkOutDate
+	attendanceData.CheckOutLatitude = req.Latitude
+	attendanceData.CheckOutLongitude = req.Longitude
+	attendanceData.CheckOutAccuracy = req.Accuracy
+	attendanceData.CheckOutOfficeLocationID = geofence.OfficeLocationID
+	attendanceData.CheckOutOutsideGeofence = geofence.OutsideGeofence
 	attendanceData.UpdatedBy = &userID
 	now := time.Now()
 	attendanceData.UpdatedAt = &now
@@ -386,15 +410,422 @@ func (service *AttendanceService) CheckOutByID(ctx context.Context, id, userID u
 		"check_out_date": updatedAttendance.CheckOutDate,
 	})
 
+	return service.toResponse(updatedAttendance), nil
+}
+
+// evaluateGeofence matches the request location against active office locations.
+// Depending on the configured mode an outside location is rejected or only flagged.
+// Users on the override list are never treated as outside.
+func (service *AttendanceService) evaluateGeofence(ctx context.Context, userID uint, req attendance.AttendanceRequest, at time.Time) (geofenceResult, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	mode := service.config.Geofence.Mode
+
+	if mode == constant.GeofenceModeDisabled {
+		return geofenceResult{}, nil
+	}
+
+	offices, err := service.officeLocationRepo.GetActiveLocations(ctx)
+	if err != nil {
+		service.logger.ErrorT("failed to get office locations", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return geofenceResult{}, errors.New("failed to validate location")
+	}
+
+	// Nothing to enforce until at least one office is configured
+	if len(offices) == 0 {
+		return geofenceResult{}, nil
+	}
+
+	var result geofenceResult
+	if req.Latitude != nil && req.Longitude != nil {
+		nearestDistance := -1.0
+		for _, office := range offices {
+			distance := geo.DistanceInMeters(*req.Latitude, *req.Longitude, office.Latitude, office.Longitude)
+			if distance <= float64(office.RadiusMeters) && (nearestDistance < 0 || distance < nearestDistance) {
+				officeID := office.ID
+				result.OfficeLocationID = &officeID
+				nearestDistance = distance
+			}
+		}
+	}
+
+	// A fix that is too inaccurate cannot prove the user is inside an office
+	lowAccuracy := req.Accuracy != nil && service.config.Geofence.MaxAccuracy > 0 && *req.Accuracy > service.config.Geofence.MaxAccuracy
+	if result.OfficeLocationID != nil && !lowAccuracy {
+		return result, nil
+	}
+
+	override, err := service.officeLocationRepo.GetOverrideByUserID(ctx, userID)
+	if err == nil && override.IsActiveAt(at) {
+		service.logger.InfoT("location outside geofence allowed by override", requestID, map[string]interface{}{
+			"user_id":     userID,
+			"override_id": override.ID,
+		})
+		return result, nil
+	}
+
+	result.OutsideGeofence = true
+	service.logger.WarningT("location outside geofence", requestID, map[string]interface{}{
+		"user_id":      userID,
+		"mode":         mode,
+		"has_location": req.Latitude != nil && req.Longitude != nil,
+		"accuracy":     req.Accuracy,
+		"low_accuracy": lowAccuracy,
+	})
+
+	if mode == constant.GeofenceModeReject {
+		if req.Latitude == nil || req.Longitude == nil {
+			return result, errors.New("location is required. Please enable location services")
+		}
+		if lowAccuracy {
+			return result, errors.New("location accuracy is too low. Please try again")
+		}
+		return result, errors.New("you are outside of the allowed office area")
+	}
+
+	return result, nil
+}
+
+// AutoCloseForgottenCheckOuts closes attendances that are still open past the employee's
+// scheduled end of day plus the configured cutoff. The check-out is set to the scheduled end,
+// the row is marked auto_closed and the employee is notified.
+func (service *AttendanceService) AutoCloseForgottenCheckOuts(ctx context.Context, now time.Time) (int, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	defaultHour, defaultMinute, err := attendance.ParseClock(service.config.Attendance.WorkEndTime)
+	if err != nil {
+		return 0, fmt.Errorf("invalid WORK_END_TIME: %w", err)
+	}
+
+	loc, err := time.LoadLocation(service.config.PostgressDB.DBTimeZone)
+	if err != nil {
+		loc = time.Local
+	}
+
+	// An attendance can only be due once the cutoff has passed since its check-in
+	cutoff := time.Duration(service.config.Attendance.AutoCloseCutoff) * time.Hour
+	openAttendances, err := service.attendanceRepo.GetOpenAttendancesBefore(ctx, now.Add(-cutoff), autoCloseBatchSize)
+	if err != nil {
+		service.logger.ErrorT("failed to get open attendances", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, err
+	}
+
+	endTimes := map[uint][2]int{}
+	closed := 0
+	for _, att := range openAttendances {
+		// Resolve the scheduled end of day of the employee
+		endTime, ok := endTimes[att.UserID]
+		if !ok {
+			endTime = [2]int{defaultHour, defaultMinute}
+			if u, err := service.userRepo.GetUserByID(ctx, att.UserID); err == nil && u.WorkEndTime != nil {
+				if hour, minute, err := attendance.ParseClock(*u.WorkEndTime); err == nil {
+					endTime = [2]int{hour, minute}
+				}
+			}
+			endTimes[att.UserID] = endTime
+		}
+
+		checkIn := att.CheckInDate.In(loc)
+		scheduledEnd := time.Date(checkIn.Year(), checkIn.Month(), checkIn.Day(), endTime[0], endTime[1], 0, 0, loc)
+		if scheduledEnd.Before(checkIn) {
+			// Checked in after the scheduled end, close at check-in time
+			scheduledEnd = checkIn
+		}
+		if now.Before(scheduledEnd.Add(cutoff)) {
+			continue
+		}
+
+		// Only an attendance that is still open is closed, an employee checking out meanwhile keeps their own time
+		updated, err := service.attendanceRepo.AutoClose(ctx, att.ID, scheduledEnd, now)
+		if err != nil {
+			service.logger.ErrorT("failed to auto-close attendance", requestID, map[string]interface{}{
+				"error":         err.Error(),
+				"attendance_id": att.ID,
+				"user_id":       att.UserID,
+			})
+			continue
+		}
+		if !updated {
+			continue
+		}
+		closed++
+
+		service.logger.InfoT("attendance auto-closed", requestID, map[string]interface{}{
+			"attendance_id":  att.ID,
+			"user_id":        att.UserID,
+			"check_in_date":  att.CheckInDate,
+			"check_out_date": scheduledEnd,
+		})
+
+		message := fmt.Sprintf(
+			"You did not check out on %s. Your attendance was closed automatically at %s, submit an attendance correction if this is not right.",
+			checkIn.Format("2006-01-02"), scheduledEnd.Format("15:04"),
+		)
+		if err := service.notification.Notify(ctx, att.UserID, constant.NotificationTypeAttendanceAutoClosed, "Attendance closed automatically", message); err != nil {
+			service.logger.WarningT("failed to notify auto-closed attendance", requestID, map[string]interface{}{
+				"error":         err.Error(),
+				"attendance_id": att.ID,
+			})
+		}
+	}
+
+	return closed, nil
+}
+
+func (service *AttendanceService) AdminList(ctx context.Context, req attendance.AdminAttendanceListRequest) (attendance.AdminAttendanceListResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default date range to current month if not provided
+	if req.StartDate == nil && req.EndDate == nil {
+		now := time.Now()
+		startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		endOfMonth := startOfMonth.AddDate(0, 1, 0).Add(-time.Second)
+
+		req.StartDate = &startOfMonth
+		req.EndDate = &endOfMonth
+	}
+
+	// Validate pagination parameters
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 || req.Limit > 100 {
+		req.Limit = 10
+	}
+	req.WorkStartTime = service.config.Attendance.WorkStartTime
+
+	service.logger.InfoT("starting admin get attendances", requestID, map[string]interface{}{
+		"user_id":           req.UserID,
+		"page":              req.Page,
+		"limit":             req.Limit,
+		"start_date":        req.StartDate,
+		"end_date":          req.EndDate,
+		"missing_check_out": req.MissingCheckOut,
+		"late":              req.Late,
+	})
+
+	attendances, total, err := service.attendanceRepo.GetAllAttendances(ctx, req)
+	if err != nil {
+		service.logger.ErrorT("failed to get attendances from repository", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return attendance.AdminAttendanceListResponse{}, err
+	}
+
+	// Convert to response format
+	responses := make([]attendance.AdminAttendanceResponse, 0, len(attendances))
+	for _, att := range attendances {
+		responses = append(responses, service.toAdminResponse(att))
+	}
+
+	totalPages := int((total + int64(req.Limit) - 1) / int64(req.Limit))
+
+	return attendance.AdminAttendanceListResponse{
+		Data: responses,
+		Pagination: attendance.Pagination{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (service *AttendanceService) AdminGetByID(ctx context.Context, id uint) (attendance.AdminAttendanceResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	att, err := service.attendanceRepo.GetAttendanceWithUserByID(ctx, id, service.config.Attendance.WorkStartTime)
+	if err != nil {
+		service.logger.WarningT("attendance not found", requestID, map[string]interface{}{
+			"attendance_id": id,
+			"error":         err.Error(),
+		})
+		return attendance.AdminAttendanceResponse{}, err
+	}
+
+	return service.toAdminResponse(att), nil
+}
+
+func (service *AttendanceService) AdminCreate(ctx context.Context, adminID uint, req attendance.AdminAttendanceRequest) (attendance.AdminAttendanceResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	service.logger.InfoT("starting admin create attendance", requestID, map[string]interface{}{
+		"admin_id": adminID,
+		"user_id":  req.UserID,
+	})
+
+	// Attendance is only recorded for employees
+	employee, err := service.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		service.logger.WarningT("employee not found", requestID, map[string]interface{}{
+			"user_id": req.UserID,
+			"error":   err.Error(),
+		})
+		return attendance.AdminAttendanceResponse{}, errors.New("user not found")
+	}
+	if employee.Role != constant.EmployeeRole {
+		return attendance.AdminAttendanceResponse{}, errors.New("attendance can only be recorded for employees")
+	}
+
+	checkIn, checkOut, err := service.validateAdminTimes(req.CheckInDate.Time, req.CheckOutDate)
+	if err != nil {
+		return attendance.AdminAttendanceResponse{}, err
+	}
+
+	// Only one attendance per employee per day
+	existing, err := service.attendanceRepo.GetByUserAndDate(ctx, req.UserID, checkIn)
+	if err != nil && err.Error() != "record not found" {
+		return attendance.AdminAttendanceResponse{}, fmt.Errorf("failed to check existing attendance: %w", err)
+	}
+	if existing != nil {
+		return attendance.AdminAttendanceResponse{}, fmt.Errorf("employee already has attendance %d on this day, update it instead", existing.ID)
+	}
+
+	createdAttendance, err := service.attendanceRepo.CreateAttendance(ctx, attendance.Attendance{
+		UserID:       req.UserID,
+		CheckInDate:  checkIn,
+		CheckOutDate: checkOut,
+		Source:       constant.AttendanceSourceAdmin,
+		CreatedBy:    adminID,
+	})
+	if err != nil {
+		service.logger.ErrorT("failed to create attendance record", requestID, map[string]interface{}{
+			"admin_id": adminID,
+			"user_id":  req.UserID,
+			"error":    err.Error(),
+		})
+		return attendance.AdminAttendanceResponse{}, errors.New("failed to create attendance record")
+	}
+
+	service.logger.InfoT("attendance created by admin", requestID, map[string]interface{}{
+		"attendance_id": createdAttendance.ID,
+		"admin_id":      adminID,
+		"user_id":       req.UserID,
+	})
+
+	return service.AdminGetByID(ctx, createdAttendance.ID)
+}
+
+func (service *AttendanceService) AdminUpdate(ctx context.Context, id, adminID uint, req attendance.AdminUpdateAttendanceRequest) (attendance.AdminAttendanceResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	service.logger.InfoT("starting admin update attendance", requestID, map[string]interface{}{
+		"admin_id":      adminID,
+		"attendance_id": id,
+	})
+
+	existing, err := service.attendanceRepo.GetAttendanceWithUserByID(ctx, id, service.config.Attendance.WorkStartTime)
+	if err != nil {
+		return attendance.AdminAttendanceResponse{}, err
+	}
+
+	checkIn, checkOut, err := service.validateAdminTimes(req.CheckInDate.Time, req.CheckOutDate)
+	if err != nil {
+		return attendance.AdminAttendanceResponse{}, err
+	}
+
+	// Moving the attendance must not collide with another one on the new day
+	sameDay, err := service.attendanceRepo.GetByUserAndDate(ctx, existing.UserID, checkIn)
+	if err != nil && err.Error() != "record not found" {
+		return attendance.AdminAttendanceResponse{}, fmt.Errorf("failed to check existing attendance: %w", err)
+	}
+	if sameDay != nil && sameDay.ID != id {
+		return attendance.AdminAttendanceResponse{}, fmt.Errorf("employee already has attendance %d on this day", sameDay.ID)
+	}
+
+	now := time.Now()
+	updated := existing.Attendance
+	updated.CheckInDate = checkIn
+	updated.CheckOutDate = checkOut
+	updated.AutoClosed = false
+	updated.UpdatedBy = &adminID
+	updated.UpdatedAt = &now
+
+	if _, err := service.attendanceRepo.UpdateAttendance(ctx, updated); err != nil {
+		service.logger.ErrorT("failed to update attendance record", requestID, map[string]interface{}{
+			"attendance_id": id,
+			"admin_id":      adminID,
+			"error":         err.Error(),
+		})
+		return attendance.AdminAttendanceResponse{}, errors.New("failed to update attendance record")
+	}
+
+	service.logger.InfoT("attendance updated by admin", requestID, map[string]interface{}{
+		"attendance_id":      id,
+		"admin_id":           adminID,
+		"user_id":            existing.UserID,
+		"old_check_in_date":  existing.CheckInDate,
+		"old_check_out_date": existing.CheckOutDate,
+		"check_in_date":      checkIn,
+		"check_out_date":     checkOut,
+	})
+
+	return service.AdminGetByID(ctx, id)
+}
+
+// validateAdminTimes applies the check-in rules of employees to attendance entered by admins
+func (service *AttendanceService) validateAdminTimes(checkIn time.Time, checkOutDate *data_tipes.CustomDateTime) (time.Time, *time.Time, error) {
+	var checkOut *time.Time
+	if checkOutDate != nil && !checkOutDate.Time.IsZero() {
+		value := checkOutDate.Time
+		checkOut = &value
+	}
+
+	if checkIn.After(time.Now()) {
+		return checkIn, nil, errors.New("check-in date cannot be in the future")
+	}
+	if !attendance.IsWeekday(checkIn) {
+		return checkIn, nil, errors.New("attendance is only allowed on weekdays (Monday to Friday)")
+	}
+	if checkOut != nil && !attendance.IsValidCheckOutTime(checkIn, *checkOut) {
+		return checkIn, nil, errors.New("check-out date must be after check-in date")
+	}
+
+	return checkIn, checkOut, nil
+}
+
+// Helper function to convert Attendance to AttendanceResponse
+func (service *AttendanceService) toResponse(att attendance.Attendance) attendance.AttendanceResponse {
 	response := attendance.AttendanceResponse{
-		ID:           updatedAttendance.ID,
-		UserID:       updatedAttendance.UserID,
-		CheckInDate:  updatedAttendance.CheckInDate,
-		CheckOutDate: updatedAttendance.CheckOutDate,
-		CreatedAt:    updatedAttendance.CreatedAt,
+		ID:           att.ID,
+		UserID:       att.UserID,
+		CheckInDate:  att.CheckInDate,
+		CheckOutDate: att.CheckOutDate,
+		CheckIn: attendance.LocationInfo{
+			Latitude:         att.CheckInLatitude,
+			Longitude:        att.CheckInLongitude,
+			Accuracy:         att.CheckInAccuracy,
+			OfficeLocationID: att.CheckInOfficeLocationID,
+			OutsideGeofence:  att.CheckInOutsideGeofence,
+		},
+		Source:     att.Source,
+		AutoClosed: att.AutoClosed,
+		CreatedAt:  att.CreatedAt,
+		UpdatedAt:  att.UpdatedAt,
+	}
+	if att.CheckOutDate != nil {
+		response.CheckOut = &attendance.LocationInfo{
+			Latitude:         att.CheckOutLatitude,
+			Longitude:        att.CheckOutLongitude,
+			Accuracy:         att.CheckOutAccuracy,
+			OfficeLocationID: att.CheckOutOfficeLocationID,
+			OutsideGeofence:  att.CheckOutOutsideGeofence,
+		}
 	}
-	if updatedAttendance.UpdatedAt != nil {
-		response.UpdatedAt = updatedAttendance.UpdatedAt
+	return response
+}
+
+// Helper function to convert AttendanceWithUser to AdminAttendanceResponse
+func (service *AttendanceService) toAdminResponse(att attendance.AttendanceWithUser) attendance.AdminAttendanceResponse {
+	return attendance.AdminAttendanceResponse{
+		AttendanceResponse: service.toResponse(att.Attendance),
+		Username:           att.Username,
+		Late:               att.Late,
+		CreatedBy:          att.CreatedBy,
+		UpdatedBy:          att.UpdatedBy,
 	}
-	return response, nil
 }
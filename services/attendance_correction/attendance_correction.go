@@ -0,0 +1,393 @@
+package attendance_correction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/attendance"
+	"github.com/riskykurniawan15/payrolls/models/attendance_correction"
+	attendanceRepo "github.com/riskykurniawan15/payrolls/repositories/attendance"
+	attendanceCorrectionRepo "github.com/riskykurniawan15/payrolls/repositories/attendance_correction"
+	instanceRepo "github.com/riskykurniawan15/payrolls/repositories/instance"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+// ErrSelfReview is returned when a reviewer tries to approve or reject their own correction
+var ErrSelfReview = errors.New("cannot review your own attendance correction")
+
+type (
+	IAttendanceCorrectionService interface {
+		Create(ctx context.Context, userID uint, req attendance_correction.CreateAttendanceCorrectionRequest) (*attendance_correction.AttendanceCorrectionResponse, error)
+		GetByID(ctx context.Context, id, userID uint) (*attendance_correction.AttendanceCorrectionResponse, error)
+		List(ctx context.Context, req attendance_correction.ListAttendanceCorrectionsRequest) (*attendance_correction.ListAttendanceCorrectionsResponse, error)
+		Approve(ctx context.Context, id, reviewerID uint, req attendance_correction.ReviewAttendanceCorrectionRequest) (*attendance_correction.AttendanceCorrectionResponse, error)
+		Reject(ctx context.Context, id, reviewerID uint, req attendance_correction.ReviewAttendanceCorrectionRequest) (*attendance_correction.AttendanceCorrectionResponse, error)
+	}
+
+	AttendanceCorrectionService struct {
+		logger                   logger.Logger
+		attendanceCorrectionRepo attendanceCorrectionRepo.IAttendanceCorrectionRepository
+		attendanceRepo           attendanceRepo.IAttendanceRepository
+		instanceRepo             instanceRepo.IInstanceRepository
+	}
+)
+
+func NewAttendanceCorrectionService(
+	logger logger.Logger,
+	attendanceCorrectionRepo attendanceCorrectionRepo.IAttendanceCorrectionRepository,
+	attendanceRepo attendanceRepo.IAttendanceRepository,
+	instanceRepo instanceRepo.IInstanceRepository,
+) IAttendanceCorrectionService {
+	return &AttendanceCorrectionService{
+		logger:                   logger,
+		attendanceCorrectionRepo: attendanceCorrectionRepo,
+		attendanceRepo:           attendanceRepo,
+		instanceRepo:             instanceRepo,
+	}
+}
+
+func (s *AttendanceCorrectionService) Create(ctx context.Context, userID uint, req attendance_correction.CreateAttendanceCorrectionRequest) (*attendance_correction.AttendanceCorrectionResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing create attendance correction request", requestID, map[string]interface{}{
+		"user_id":       userID,
+		"attendance_id": req.AttendanceID,
+		"has_check_out": req.CheckOutDate != nil,
+	})
+
+	checkIn := req.CheckInDate.Time
+	var checkOut *time.Time
+	if req.CheckOutDate != nil && !req.CheckOutDate.Time.IsZero() {
+		value := req.CheckOutDate.Time
+		checkOut = &value
+	}
+
+	// Validate requested times
+	if checkIn.After(time.Now()) {
+		return nil, errors.New("check-in date cannot be in the future")
+	}
+	if !attendance.IsWeekday(checkIn) {
+		return nil, errors.New("attendance is only allowed on weekdays (Monday to Friday)")
+	}
+	if checkOut != nil && !attendance.IsValidCheckOutTime(checkIn, *checkOut) {
+		return nil, errors.New("check-out date must be after check-in date")
+	}
+
+	correction := &attendance_correction.AttendanceCorrection{
+		UserID:                userID,
+		RequestedCheckInDate:  checkIn,
+		RequestedCheckOutDate: checkOut,
+		Reason:                strings.TrimSpace(req.Reason),
+		Status:                constant.ApprovalStatusPending,
+		CreatedBy:             userID,
+		CreatedAt:             time.Now(),
+	}
+
+	// Link the attendance being corrected, or the one already recorded on that day
+	var existing *attendance.Attendance
+	if req.AttendanceID != nil {
+		att, err := s.attendanceRepo.GetAttendanceByID(ctx, *req.AttendanceID, userID)
+		if err != nil {
+			s.logger.WarningT("attendance not found for correction", requestID, map[string]interface{}{
+				"error":         err.Error(),
+				"attendance_id": *req.AttendanceID,
+				"user_id":       userID,
+			})
+			return nil, err
+		}
+		if !sameDay(att.CheckInDate, checkIn) {
+			return nil, errors.New("corrected check-in must be on the same day as the attendance")
+		}
+		existing = &att
+	} else {
+		att, err := s.attendanceRepo.GetByUserAndDate(ctx, userID, checkIn)
+		if err != nil && err.Error() != "record not found" {
+			return nil, fmt.Errorf("failed to check existing attendance: %w", err)
+		}
+		existing = att
+	}
+
+	if existing != nil {
+		correction.AttendanceID = &existing.ID
+		correction.OriginalCheckInDate = &existing.CheckInDate
+		correction.OriginalCheckOutDate = existing.CheckOutDate
+	}
+
+	pending, err := s.attendanceCorrectionRepo.HasPendingByUserAndDate(ctx, userID, checkIn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pending corrections: %w", err)
+	}
+	if pending {
+		s.logger.WarningT("pending correction already exists", requestID, map[string]interface{}{
+			"user_id": userID,
+			"date":    checkIn.Format("2006-01-02"),
+		})
+		return nil, errors.New("a pending correction already exists for this day")
+	}
+
+	if err := s.attendanceCorrectionRepo.Create(ctx, correction); err != nil {
+		s.logger.ErrorT("failed to create attendance correction", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		})
+		return nil, fmt.Errorf("failed to create attendance correction: %w", err)
+	}
+
+	s.logger.InfoT("attendance correction created successfully", requestID, map[string]interface{}{
+		"correction_id": correction.ID,
+		"user_id":       userID,
+		"attendance_id": correction.AttendanceID,
+	})
+
+	response := s.toResponse(*correction)
+	return &response, nil
+}
+
+func (s *AttendanceCorrectionService) GetByID(ctx context.Context, id, userID uint) (*attendance_correction.AttendanceCorrectionResponse, error) {
+	correction, err := s.attendanceCorrectionRepo.GetByID(ctx, id)
+	if err != nil || correction.UserID != userID {
+		return nil, errors.New("attendance correction not found")
+	}
+
+	response := s.toResponse(*correction)
+	return &response, nil
+}
+
+func (s *AttendanceCorrectionService) List(ctx context.Context, req attendance_correction.ListAttendanceCorrectionsRequest) (*attendance_correction.ListAttendanceCorrectionsResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default values if not provided
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	s.logger.InfoT("processing list attendance corrections request", requestID, map[string]interface{}{
+		"page":    req.Page,
+		"limit":   req.Limit,
+		"user_id": req.UserID,
+		"status":  req.Status,
+	})
+
+	response, err := s.attendanceCorrectionRepo.List(ctx, req)
+	if err != nil {
+		s.logger.ErrorT("failed to list attendance corrections", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"page":  req.Page,
+			"limit": req.Limit,
+		})
+		return nil, fmt.Errorf("failed to list attendance corrections: %w", err)
+	}
+
+	return response, nil
+}
+
+func (s *AttendanceCorrectionService) Approve(c context.Context, id, reviewerID uint, req attendance_correction.ReviewAttendanceCorrectionRequest) (*attendance_correction.AttendanceCorrectionResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing approve attendance correction request", requestID, map[string]interface{}{
+		"correction_id": id,
+		"reviewer_id":   reviewerID,
+	})
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		s.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	correction, err := s.attendanceCorrectionRepo.GetByID(ctx, id)
+	if err != nil || (req.UserIDs != nil && !slices.Contains(req.UserIDs, correction.UserID)) {
+		return nil, errors.New("attendance correction not found")
+	}
+	if correction.UserID == reviewerID {
+		return nil, ErrSelfReview
+	}
+	if correction.Status != constant.ApprovalStatusPending {
+		return nil, fmt.Errorf("attendance correction is already %s", correction.Status)
+	}
+
+	now := time.Now()
+	var att attendance.Attendance
+	if correction.AttendanceID != nil {
+		att, err = s.attendanceRepo.GetAttendanceByIDForUpdate(ctx, *correction.AttendanceID, correction.UserID)
+		if err != nil {
+			s.logger.WarningT("attendance to correct not found", requestID, map[string]interface{}{
+				"error":         err.Error(),
+				"attendance_id": *correction.AttendanceID,
+			})
+			return nil, err
+		}
+
+		// Keep the values as they are right before the change
+		correction.OriginalCheckInDate = &att.CheckInDate
+		correction.OriginalCheckOutDate = att.CheckOutDate
+
+		updated := att
+		updated.CheckInDate = correction.RequestedCheckInDate
+		updated.CheckOutDate = correction.RequestedCheckOutDate
+		updated.AutoClosed = false
+		updated.UpdatedBy = &reviewerID
+		updated.UpdatedAt = &now
+		if att, err = s.attendanceRepo.UpdateAttendance(ctx, updated); err != nil {
+			s.logger.ErrorT("failed to apply attendance correction", requestID, map[string]interface{}{
+				"error":         err.Error(),
+				"attendance_id": updated.ID,
+			})
+			return nil, fmt.Errorf("failed to apply attendance correction: %w", err)
+		}
+	} else {
+		// The employee may have checked in after submitting the request
+		if _, err := s.attendanceRepo.GetByUserAndDate(ctx, correction.UserID, correction.RequestedCheckInDate); err == nil {
+			return nil, errors.New("attendance has been recorded for this day since the request, ask the employee to submit a new correction")
+		}
+
+		att, err = s.attendanceRepo.CreateAttendance(ctx, attendance.Attendance{
+			UserID:       correction.UserID,
+			CheckInDate:  correction.RequestedCheckInDate,
+			CheckOutDate: correction.RequestedCheckOutDate,
+			Source:       constant.AttendanceSourceCorrection,
+			CreatedBy:    correction.UserID,
+			UpdatedBy:    &reviewerID,
+			UpdatedAt:    &now,
+		})
+		if err != nil {
+			s.logger.ErrorT("failed to create corrected attendance", requestID, map[string]interface{}{
+				"error":   err.Error(),
+				"user_id": correction.UserID,
+			})
+			return nil, fmt.Errorf("failed to create corrected attendance: %w", err)
+		}
+	}
+
+	correction.AttendanceID = &att.ID
+	correction.Status = constant.ApprovalStatusApproved
+	correction.ReviewedBy = &reviewerID
+	correction.ReviewedAt = &now
+	correction.ReviewComment = optionalComment(req.Comment)
+	correction.UpdatedBy = &reviewerID
+	correction.UpdatedAt = &now
+
+	if err := s.attendanceCorrectionRepo.Review(ctx, id, map[string]interface{}{
+		"status":                  correction.Status,
+		"attendance_id":           correction.AttendanceID,
+		"original_check_in_date":  correction.OriginalCheckInDate,
+		"original_check_out_date": correction.OriginalCheckOutDate,
+		"reviewed_by":             correction.ReviewedBy,
+		"reviewed_at":             correction.ReviewedAt,
+		"review_comment":          correction.ReviewComment,
+		"updated_by":              correction.UpdatedBy,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.logger.ErrorT("failed to commit attendance correction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to commit attendance correction: %w", err)
+	}
+
+	s.logger.InfoT("attendance correction approved", requestID, map[string]interface{}{
+		"correction_id": id,
+		"attendance_id": att.ID,
+		"reviewer_id":   reviewerID,
+	})
+
+	response := s.toResponse(*correction)
+	return &response, nil
+}
+
+func (s *AttendanceCorrectionService) Reject(ctx context.Context, id, reviewerID uint, req attendance_correction.ReviewAttendanceCorrectionRequest) (*attendance_correction.AttendanceCorrectionResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing reject attendance correction request", requestID, map[string]interface{}{
+		"correction_id": id,
+		"reviewer_id":   reviewerID,
+	})
+
+	if strings.TrimSpace(req.Comment) == "" {
+		return nil, errors.New("comment is required when rejecting a correction")
+	}
+
+	correction, err := s.attendanceCorrectionRepo.GetByID(ctx, id)
+	if err != nil || (req.UserIDs != nil && !slices.Contains(req.UserIDs, correction.UserID)) {
+		return nil, errors.New("attendance correction not found")
+	}
+	if correction.UserID == reviewerID {
+		return nil, ErrSelfReview
+	}
+	if correction.Status != constant.ApprovalStatusPending {
+		return nil, fmt.Errorf("attendance correction is already %s", correction.Status)
+	}
+
+	now := time.Now()
+	correction.Status = constant.ApprovalStatusRejected
+	correction.ReviewedBy = &reviewerID
+	correction.ReviewedAt = &now
+	correction.ReviewComment = optionalComment(req.Comment)
+	correction.UpdatedBy = &reviewerID
+	correction.UpdatedAt = &now
+
+	if err := s.attendanceCorrectionRepo.Review(ctx, id, map[string]interface{}{
+		"status":         correction.Status,
+		"reviewed_by":    correction.ReviewedBy,
+		"reviewed_at":    correction.ReviewedAt,
+		"review_comment": correction.ReviewComment,
+		"updated_by":     correction.UpdatedBy,
+	}); err != nil {
+		s.logger.ErrorT("failed to reject attendance correction", requestID, map[string]interface{}{
+			"error":         err.Error(),
+			"correction_id": id,
+		})
+		return nil, err
+	}
+
+	s.logger.InfoT("attendance correction rejected", requestID, map[string]interface{}{
+		"correction_id": id,
+		"reviewer_id":   reviewerID,
+	})
+
+	response := s.toResponse(*correction)
+	return &response, nil
+}
+
+func (s *AttendanceCorrectionService) toResponse(c attendance_correction.AttendanceCorrection) attendance_correction.AttendanceCorrectionResponse {
+	return attendance_correction.AttendanceCorrectionResponse{
+		ID:                    c.ID,
+		UserID:                c.UserID,
+		AttendanceID:          c.AttendanceID,
+		OriginalCheckInDate:   c.OriginalCheckInDate,
+		OriginalCheckOutDate:  c.OriginalCheckOutDate,
+		RequestedCheckInDate:  c.RequestedCheckInDate,
+		RequestedCheckOutDate: c.RequestedCheckOutDate,
+		Reason:                c.Reason,
+		Status:                c.Status,
+		ReviewedBy:            c.ReviewedBy,
+		ReviewedAt:            c.ReviewedAt,
+		ReviewComment:         c.ReviewComment,
+		CreatedAt:             c.CreatedAt,
+		UpdatedAt:             c.UpdatedAt,
+	}
+}
+
+func optionalComment(comment string) *string {
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return nil
+	}
+	return &comment
+}
+
+func sameDay(a, b time.Time) bool {
+	a = a.In(b.Location())
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
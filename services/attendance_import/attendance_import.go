@@ -0,0 +1,436 @@
+package attendance_import
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/attendance"
+	"github.com/riskykurniawan15/payrolls/models/attendance_import"
+	attendanceRepo "github.com/riskykurniawan15/payrolls/repositories/attendance"
+	attendanceImportRepo "github.com/riskykurniawan15/payrolls/repositories/attendance_import"
+	instanceRepo "github.com/riskykurniawan15/payrolls/repositories/instance"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/punch_log"
+)
+
+type (
+	IAttendanceImportService interface {
+		Import(ctx context.Context, req attendance_import.ImportAttendanceRequest, createdBy uint) (*attendance_import.ImportResult, error)
+		ListImports(ctx context.Context, page, limit int) (*attendance_import.ListAttendanceImportsResponse, error)
+		CreateDeviceUser(ctx context.Context, req attendance_import.CreateDeviceUserRequest, createdBy uint) (*attendance_import.DeviceUserResponse, error)
+		ListDeviceUsers(ctx context.Context, page, limit int) (*attendance_import.ListDeviceUsersResponse, error)
+		DeleteDeviceUser(ctx context.Context, id uint) error
+	}
+
+	AttendanceImportService struct {
+		logger               logger.Logger
+		config               config.Config
+		attendanceImportRepo attendanceImportRepo.IAttendanceImportRepository
+		attendanceRepo       attendanceRepo.IAttendanceRepository
+		userRepo             userRepo.IUserRepository
+		instanceRepo         instanceRepo.IInstanceRepository
+	}
+)
+
+func NewAttendanceImportService(
+	logger logger.Logger,
+	config config.Config,
+	attendanceImportRepo attendanceImportRepo.IAttendanceImportRepository,
+	attendanceRepo attendanceRepo.IAttendanceRepository,
+	userRepo userRepo.IUserRepository,
+	instanceRepo instanceRepo.IInstanceRepository,
+) IAttendanceImportService {
+	return &AttendanceImportService{
+		logger:               logger,
+		config:               config,
+		attendanceImportRepo: attendanceImportRepo,
+		attendanceRepo:       attendanceRepo,
+		userRepo:             userRepo,
+		instanceRepo:         instanceRepo,
+	}
+}
+
+func (s *AttendanceImportService) Import(c context.Context, req attendance_import.ImportAttendanceRequest, createdBy uint) (*attendance_import.ImportResult, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing attendance import", requestID, map[string]interface{}{
+		"file_name":  req.FileName,
+		"file_size":  len(req.Content),
+		"dry_run":    req.DryRun,
+		"created_by": createdBy,
+	})
+
+	// Device timestamps are wall clock time of the site
+	loc, err := time.LoadLocation(s.config.PostgressDB.DBTimeZone)
+	if err != nil {
+		loc = time.Local
+	}
+
+	punches, parseErrors, err := punch_log.Parse(bytes.NewReader(req.Content), req.FileName, loc)
+	if err != nil {
+		s.logger.WarningT("failed to parse punch log", requestID, map[string]interface{}{
+			"error":     err.Error(),
+			"file_name": req.FileName,
+		})
+		return nil, err
+	}
+
+	days := punch_log.GroupDaily(punches)
+	result := &attendance_import.ImportResult{
+		DryRun:              req.DryRun,
+		FileName:            req.FileName,
+		TotalPunches:        len(punches),
+		TotalDays:           len(days),
+		Conflicts:           []attendance_import.ImportConflict{},
+		UnmappedDeviceUsers: []string{},
+		ParseErrors:         parseErrors,
+	}
+	if result.ParseErrors == nil {
+		result.ParseErrors = []punch_log.ParseError{}
+	}
+
+	hash := sha256.Sum256(req.Content)
+	fileHash := hex.EncodeToString(hash[:])
+	if previous, err := s.attendanceImportRepo.GetImportByFileHash(c, fileHash); err == nil {
+		result.PreviousImportID = &previous.ID
+	}
+
+	// Map device user IDs to users
+	userMap, err := s.mapDeviceUsers(c, days)
+	if err != nil {
+		s.logger.ErrorT("failed to map device users", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to map device users: %w", err)
+	}
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		s.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var importRecord *attendance_import.AttendanceImport
+	if !req.DryRun {
+		importRecord = &attendance_import.AttendanceImport{
+			FileName:  req.FileName,
+			FileHash:  fileHash,
+			CreatedBy: createdBy,
+			CreatedAt: time.Now(),
+		}
+		if err := s.attendanceImportRepo.CreateImport(ctx, importRecord); err != nil {
+			s.logger.ErrorT("failed to create import record", requestID, map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, fmt.Errorf("failed to create import record: %w", err)
+		}
+		result.ImportID = &importRecord.ID
+	}
+
+	unmapped := map[string]bool{}
+	for _, day := range days {
+		userID, ok := userMap[day.DeviceUserID]
+		if !ok {
+			if !unmapped[day.DeviceUserID] {
+				unmapped[day.DeviceUserID] = true
+				result.UnmappedDeviceUsers = append(result.UnmappedDeviceUsers, day.DeviceUserID)
+			}
+			continue
+		}
+
+		if err := s.importDay(ctx, day, userID, importRecord, createdBy, result); err != nil {
+			s.logger.ErrorT("failed to import attendance day", requestID, map[string]interface{}{
+				"error":          err.Error(),
+				"device_user_id": day.DeviceUserID,
+				"user_id":        userID,
+				"date":           day.Date.Format("2006-01-02"),
+			})
+			return nil, fmt.Errorf("failed to import attendance for %s on %s: %w", day.DeviceUserID, day.Date.Format("2006-01-02"), err)
+		}
+	}
+
+	if req.DryRun {
+		s.logger.InfoT("attendance import dry run finished", requestID, map[string]interface{}{
+			"file_name": req.FileName,
+			"created":   result.Created,
+			"updated":   result.Updated,
+			"skipped":   result.Skipped,
+			"conflicts": len(result.Conflicts),
+		})
+		return result, nil
+	}
+
+	// Store summary counters
+	if err := s.attendanceImportRepo.UpdateImport(ctx, importRecord.ID, map[string]interface{}{
+		"total_punches":  result.TotalPunches,
+		"total_days":     result.TotalDays,
+		"created_count":  result.Created,
+		"updated_count":  result.Updated,
+		"skipped_count":  result.Skipped,
+		"conflict_count": len(result.Conflicts),
+		"error_count":    len(result.ParseErrors) + len(result.UnmappedDeviceUsers),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update import record: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.logger.ErrorT("failed to commit attendance import", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to commit attendance import: %w", err)
+	}
+
+	s.logger.InfoT("attendance import finished", requestID, map[string]interface{}{
+		"import_id": importRecord.ID,
+		"file_name": req.FileName,
+		"created":   result.Created,
+		"updated":   result.Updated,
+		"skipped":   result.Skipped,
+		"conflicts": len(result.Conflicts),
+		"unmapped":  len(result.UnmappedDeviceUsers),
+	})
+
+	return result, nil
+}
+
+// importDay creates or merges the attendance of one user on one day.
+// Records created by a previous import are merged so re-importing the same or a longer
+// export is idempotent; manually recorded attendance is never overwritten.
+func (s *AttendanceImportService) importDay(ctx context.Context, day punch_log.DailyPunch, userID uint, importRecord *attendance_import.AttendanceImport, createdBy uint, result *attendance_import.ImportResult) error {
+	conflict := attendance_import.ImportConflict{
+		DeviceUserID: day.DeviceUserID,
+		UserID:       userID,
+		Date:         day.Date.Format("2006-01-02"),
+		CheckIn:      day.CheckIn,
+		CheckOut:     day.CheckOut,
+	}
+
+	if !attendance.IsWeekday(day.Date) {
+		conflict.Reason = "attendance is only recorded on weekdays"
+		result.Conflicts = append(result.Conflicts, conflict)
+		return nil
+	}
+
+	existing, err := s.attendanceRepo.GetByUserAndDate(ctx, userID, day.Date)
+	if err != nil {
+		if err.Error() != "record not found" {
+			return err
+		}
+
+		result.Created++
+		if importRecord == nil {
+			return nil
+		}
+		_, err := s.attendanceRepo.CreateAttendance(ctx, attendance.Attendance{
+			UserID:       userID,
+			CheckInDate:  day.CheckIn,
+			CheckOutDate: day.CheckOut,
+			Source:       constant.AttendanceSourceImport,
+			ImportID:     &importRecord.ID,
+			CreatedBy:    createdBy,
+		})
+		return err
+	}
+
+	if sameTime(&existing.CheckInDate, &day.CheckIn) && sameTime(existing.CheckOutDate, day.CheckOut) {
+		result.Skipped++
+		return nil
+	}
+
+	if existing.Source != constant.AttendanceSourceImport {
+		conflict.ExistingAttendanceID = &existing.ID
+		conflict.Reason = "attendance already recorded manually"
+		result.Conflicts = append(result.Conflicts, conflict)
+		return nil
+	}
+
+	// Merge with the previously imported punches
+	checkIn := existing.CheckInDate
+	if day.CheckIn.Before(checkIn) {
+		checkIn = day.CheckIn
+	}
+	checkOut := existing.CheckOutDate
+	for _, candidate := range []*time.Time{day.CheckOut, &day.CheckIn} {
+		if candidate != nil && candidate.After(checkIn) && (checkOut == nil || candidate.After(*checkOut)) {
+			value := *candidate
+			checkOut = &value
+		}
+	}
+
+	if sameTime(&existing.CheckInDate, &checkIn) && sameTime(existing.CheckOutDate, checkOut) {
+		result.Skipped++
+		return nil
+	}
+
+	result.Updated++
+	if importRecord == nil {
+		return nil
+	}
+
+	now := time.Now()
+	existing.CheckInDate = checkIn
+	existing.CheckOutDate = checkOut
+	existing.ImportID = &importRecord.ID
+	existing.UpdatedBy = &createdBy
+	existing.UpdatedAt = &now
+	_, err = s.attendanceRepo.UpdateAttendance(ctx, *existing)
+	return err
+}
+
+func (s *AttendanceImportService) mapDeviceUsers(ctx context.Context, days []punch_log.DailyPunch) (map[string]uint, error) {
+	seen := map[string]bool{}
+	var deviceUserIDs []string
+	for _, day := range days {
+		if !seen[day.DeviceUserID] {
+			seen[day.DeviceUserID] = true
+			deviceUserIDs = append(deviceUserIDs, day.DeviceUserID)
+		}
+	}
+
+	deviceUsers, err := s.attendanceImportRepo.GetDeviceUsersByDeviceIDs(ctx, deviceUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	userMap := make(map[string]uint, len(deviceUsers))
+	for _, du := range deviceUsers {
+		userMap[du.DeviceUserID] = du.UserID
+	}
+	return userMap, nil
+}
+
+func (s *AttendanceImportService) ListImports(ctx context.Context, page, limit int) (*attendance_import.ListAttendanceImportsResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default values if not provided
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	response, err := s.attendanceImportRepo.ListImports(ctx, page, limit)
+	if err != nil {
+		s.logger.ErrorT("failed to list attendance imports", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"page":  page,
+			"limit": limit,
+		})
+		return nil, fmt.Errorf("failed to list attendance imports: %w", err)
+	}
+
+	return response, nil
+}
+
+func (s *AttendanceImportService) CreateDeviceUser(ctx context.Context, req attendance_import.CreateDeviceUserRequest, createdBy uint) (*attendance_import.DeviceUserResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing create device user request", requestID, map[string]interface{}{
+		"device_user_id": req.DeviceUserID,
+		"user_id":        req.UserID,
+		"created_by":     createdBy,
+	})
+
+	u, err := s.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		s.logger.WarningT("user not found for device user", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": req.UserID,
+		})
+		return nil, fmt.Errorf("user not found")
+	}
+
+	if existing, err := s.attendanceImportRepo.GetDeviceUserByDeviceID(ctx, req.DeviceUserID); err == nil && existing != nil {
+		s.logger.WarningT("device user already mapped", requestID, map[string]interface{}{
+			"device_user_id": req.DeviceUserID,
+			"user_id":        existing.UserID,
+		})
+		return nil, fmt.Errorf("device user ID %s is already mapped", req.DeviceUserID)
+	}
+
+	deviceUser := &attendance_import.DeviceUser{
+		DeviceUserID: req.DeviceUserID,
+		UserID:       req.UserID,
+		CreatedBy:    createdBy,
+		CreatedAt:    time.Now(),
+	}
+	if err := s.attendanceImportRepo.CreateDeviceUser(ctx, deviceUser); err != nil {
+		s.logger.ErrorT("failed to create device user", requestID, map[string]interface{}{
+			"error":          err.Error(),
+			"device_user_id": req.DeviceUserID,
+		})
+		return nil, fmt.Errorf("failed to create device user: %w", err)
+	}
+
+	return &attendance_import.DeviceUserResponse{
+		ID:           deviceUser.ID,
+		DeviceUserID: deviceUser.DeviceUserID,
+		UserID:       deviceUser.UserID,
+		Username:     u.Username,
+		CreatedBy:    deviceUser.CreatedBy,
+		CreatedAt:    deviceUser.CreatedAt,
+	}, nil
+}
+
+func (s *AttendanceImportService) ListDeviceUsers(ctx context.Context, page, limit int) (*attendance_import.ListDeviceUsersResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default values if not provided
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	response, err := s.attendanceImportRepo.ListDeviceUsers(ctx, page, limit)
+	if err != nil {
+		s.logger.ErrorT("failed to list device users", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"page":  page,
+			"limit": limit,
+		})
+		return nil, fmt.Errorf("failed to list device users: %w", err)
+	}
+
+	return response, nil
+}
+
+func (s *AttendanceImportService) DeleteDeviceUser(ctx context.Context, id uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing delete device user request", requestID, map[string]interface{}{
+		"id": id,
+	})
+
+	if _, err := s.attendanceImportRepo.GetDeviceUserByID(ctx, id); err != nil {
+		return fmt.Errorf("device user not found: %w", err)
+	}
+
+	if err := s.attendanceImportRepo.DeleteDeviceUser(ctx, id); err != nil {
+		s.logger.ErrorT("failed to delete device user", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		})
+		return fmt.Errorf("failed to delete device user: %w", err)
+	}
+
+	return nil
+}
+
+// sameTime compares timestamps at second precision, device exports have no sub-second part
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Truncate(time.Second).Equal(b.Truncate(time.Second))
+}
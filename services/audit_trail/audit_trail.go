@@ -30,6 +30,7 @@ func (s *AuditTrailService) LogRequest(ctx context.Context, req *audit_trail.Cre
 		Method:         req.Method,
 		Path:           req.Path,
 		UserID:         req.UserID,
+		APIKeyID:       req.APIKeyID,
 		Payload:        req.Payload,
 		ResponseCode:   req.ResponseCode,
 		ErrorResponse:  req.ErrorResponse,
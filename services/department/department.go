@@ -0,0 +1,310 @@
+package department
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/department"
+	departmentRepo "github.com/riskykurniawan15/payrolls/repositories/department"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+type (
+	IDepartmentService interface {
+		ListDepartments(ctx context.Context) ([]department.Department, error)
+		GetDepartment(ctx context.Context, id uint) (*department.Department, error)
+		CreateDepartment(ctx context.Context, req department.CreateDepartmentRequest, createdBy uint) (*department.Department, error)
+		UpdateDepartment(ctx context.Context, id uint, req department.UpdateDepartmentRequest, updatedBy uint) (*department.Department, error)
+		DeleteDepartment(ctx context.Context, id uint, deletedBy uint) error
+		ReportIDs(ctx context.Context, managerID uint) ([]uint, error)
+		ListTeamMembers(ctx context.Context, managerID uint, req department.ListTeamMembersRequest) ([]department.TeamMember, error)
+		TeamSummary(ctx context.Context, managerID uint, req department.TeamSummaryRequest) (*department.TeamSummaryResponse, error)
+	}
+
+	DepartmentService struct {
+		logger         logger.Logger
+		config         config.Config
+		departmentRepo departmentRepo.IDepartmentRepository
+	}
+)
+
+func NewDepartmentService(logger logger.Logger, config config.Config, departmentRepo departmentRepo.IDepartmentRepository) IDepartmentService {
+	return &DepartmentService{
+		logger:         logger,
+		config:         config,
+		departmentRepo: departmentRepo,
+	}
+}
+
+func (s *DepartmentService) ListDepartments(ctx context.Context) ([]department.Department, error) {
+	departments, err := s.departmentRepo.ListDepartments(ctx)
+	if err != nil {
+		s.logger.ErrorT("failed to list departments", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list departments: %w", err)
+	}
+	return departments, nil
+}
+
+func (s *DepartmentService) GetDepartment(ctx context.Context, id uint) (*department.Department, error) {
+	d, err := s.departmentRepo.GetDepartmentByID(ctx, id)
+	if err != nil {
+		s.logger.WarningT("department not found", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"department_id": id,
+			"error":         err.Error(),
+		})
+		return nil, errors.New("department not found")
+	}
+	return d, nil
+}
+
+func (s *DepartmentService) CreateDepartment(ctx context.Context, req department.CreateDepartmentRequest, createdBy uint) (*department.Department, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing create department request", requestID, map[string]interface{}{
+		"name":       req.Name,
+		"created_by": createdBy,
+	})
+
+	name := strings.TrimSpace(req.Name)
+	if _, err := s.departmentRepo.GetDepartmentByName(ctx, name); err == nil {
+		return nil, errors.New("department name already exists")
+	}
+
+	d := &department.Department{
+		Name:        name,
+		Description: req.Description,
+		CreatedBy:   &createdBy,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.departmentRepo.CreateDepartment(ctx, d); err != nil {
+		s.logger.ErrorT("failed to create department", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"name":  name,
+		})
+		return nil, fmt.Errorf("failed to create department: %w", err)
+	}
+
+	s.logger.InfoT("department created successfully", requestID, map[string]interface{}{
+		"department_id": d.ID,
+		"name":          d.Name,
+	})
+
+	return d, nil
+}
+
+func (s *DepartmentService) UpdateDepartment(ctx context.Context, id uint, req department.UpdateDepartmentRequest, updatedBy uint) (*department.Department, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing update department request", requestID, map[string]interface{}{
+		"department_id": id,
+		"name":          req.Name,
+		"updated_by":    updatedBy,
+	})
+
+	current, err := s.GetDepartment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{
+		"updated_by": updatedBy,
+		"updated_at": time.Now(),
+	}
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if !strings.EqualFold(name, current.Name) {
+			if _, err := s.departmentRepo.GetDepartmentByName(ctx, name); err == nil {
+				return nil, errors.New("department name already exists")
+			}
+		}
+		updates["name"] = name
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+
+	if err := s.departmentRepo.UpdateDepartment(ctx, id, updates); err != nil {
+		s.logger.ErrorT("failed to update department", requestID, map[string]interface{}{
+			"error":         err.Error(),
+			"department_id": id,
+		})
+		return nil, fmt.Errorf("failed to update department: %w", err)
+	}
+
+	s.logger.InfoT("department updated successfully", requestID, map[string]interface{}{
+		"department_id": id,
+		"previous_name": current.Name,
+	})
+
+	return s.GetDepartment(ctx, id)
+}
+
+func (s *DepartmentService) DeleteDepartment(ctx context.Context, id uint, deletedBy uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing delete department request", requestID, map[string]interface{}{
+		"department_id": id,
+		"deleted_by":    deletedBy,
+	})
+
+	current, err := s.GetDepartment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.departmentRepo.DeleteDepartment(ctx, id); err != nil {
+		s.logger.ErrorT("failed to delete department", requestID, map[string]interface{}{
+			"error":         err.Error(),
+			"department_id": id,
+		})
+		return fmt.Errorf("failed to delete department: %w", err)
+	}
+
+	s.logger.InfoT("department deleted successfully", requestID, map[string]interface{}{
+		"department_id": id,
+		"name":          current.Name,
+		"member_count":  current.MemberCount,
+		"deleted_by":    deletedBy,
+	})
+
+	return nil
+}
+
+// ReportIDs returns every direct and indirect report of a manager, it backs the team scope middleware
+func (s *DepartmentService) ReportIDs(ctx context.Context, managerID uint) ([]uint, error) {
+	ids, err := s.departmentRepo.GetReportIDs(ctx, managerID)
+	if err != nil {
+		s.logger.ErrorT("failed to load reports", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error":      err.Error(),
+			"manager_id": managerID,
+		})
+		return nil, fmt.Errorf("failed to load reports: %w", err)
+	}
+	return ids, nil
+}
+
+func (s *DepartmentService) ListTeamMembers(ctx context.Context, managerID uint, req department.ListTeamMembersRequest) ([]department.TeamMember, error) {
+	members, err := s.departmentRepo.ListReports(ctx, managerID)
+	if err != nil {
+		s.logger.ErrorT("failed to list team members", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error":      err.Error(),
+			"manager_id": managerID,
+		})
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+
+	if !req.DirectOnly {
+		return members, nil
+	}
+	direct := make([]department.TeamMember, 0, len(members))
+	for _, member := range members {
+		if member.Level == 1 {
+			direct = append(direct, member)
+		}
+	}
+	return direct, nil
+}
+
+func (s *DepartmentService) TeamSummary(ctx context.Context, managerID uint, req department.TeamSummaryRequest) (*department.TeamSummaryResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing team summary request", requestID, map[string]interface{}{
+		"manager_id": managerID,
+		"start_date": req.StartDate,
+		"end_date":   req.EndDate,
+	})
+
+	start, end, err := s.summaryRange(req)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.departmentRepo.ListReports(ctx, managerID)
+	if err != nil {
+		s.logger.ErrorT("failed to list team members", requestID, map[string]interface{}{
+			"error":      err.Error(),
+			"manager_id": managerID,
+		})
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+
+	response := &department.TeamSummaryResponse{
+		StartDate: start.Format("2006-01-02"),
+		EndDate:   end.AddDate(0, 0, -1).Format("2006-01-02"),
+		Members:   []department.TeamMemberSummary{},
+	}
+	userIDs := make([]uint, 0, len(members))
+	for _, member := range members {
+		userIDs = append(userIDs, member.ID)
+		if member.Level == 1 {
+			response.Totals.DirectReportCount++
+		}
+	}
+	response.Totals.MemberCount = len(members)
+	if len(userIDs) == 0 {
+		return response, nil
+	}
+
+	summaries, err := s.departmentRepo.GetTeamSummary(ctx, userIDs, start, end)
+	if err != nil {
+		s.logger.ErrorT("failed to summarize team", requestID, map[string]interface{}{
+			"error":      err.Error(),
+			"manager_id": managerID,
+		})
+		return nil, fmt.Errorf("failed to summarize team: %w", err)
+	}
+	for _, summary := range summaries {
+		response.Totals.Add(summary)
+	}
+	response.Members = summaries
+
+	return response, nil
+}
+
+// summaryRange resolves the summary dates in the company time zone, end is exclusive
+func (s *DepartmentService) summaryRange(req department.TeamSummaryRequest) (time.Time, time.Time, error) {
+	loc := s.location()
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	end := start.AddDate(0, 1, 0)
+
+	if req.StartDate != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", req.StartDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid start_date format, use YYYY-MM-DD")
+		}
+		start = parsed
+		if req.EndDate == "" {
+			end = start.AddDate(0, 1, 0)
+		}
+	}
+	if req.EndDate != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", req.EndDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid end_date format, use YYYY-MM-DD")
+		}
+		end = parsed.AddDate(0, 0, 1)
+		if req.StartDate == "" {
+			start = time.Date(parsed.Year(), parsed.Month(), 1, 0, 0, 0, 0, loc)
+		}
+	}
+
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, errors.New("end_date must not be before start_date")
+	}
+	if end.After(start.AddDate(1, 0, 0)) {
+		return time.Time{}, time.Time{}, errors.New("summary range cannot exceed one year")
+	}
+	return start, end, nil
+}
+
+func (s *DepartmentService) location() *time.Location {
+	loc, err := time.LoadLocation(s.config.PostgressDB.DBTimeZone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
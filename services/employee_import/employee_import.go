@@ -0,0 +1,622 @@
+package employee_import
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/department"
+	"github.com/riskykurniawan15/payrolls/models/employee_import"
+	"github.com/riskykurniawan15/payrolls/models/role"
+	"github.com/riskykurniawan15/payrolls/models/user"
+	departmentRepo "github.com/riskykurniawan15/payrolls/repositories/department"
+	instanceRepo "github.com/riskykurniawan15/payrolls/repositories/instance"
+	roleRepo "github.com/riskykurniawan15/payrolls/repositories/role"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	"github.com/riskykurniawan15/payrolls/utils/bcrypt"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/spreadsheet"
+	"github.com/riskykurniawan15/payrolls/utils/validator"
+)
+
+// maxImportRows keeps a single import small enough to run in one transaction
+const maxImportRows = 1000
+
+// importColumns lists the accepted header names, only employee_number is mandatory
+var importColumns = []string{
+	"employee_number", "username", "full_name", "email", "role", "salary", "grade", "employment_type",
+	"department", "position", "bank_name", "bank_account_number", "bank_account_name", "join_date",
+}
+
+type (
+	IEmployeeImportService interface {
+		Import(ctx context.Context, req employee_import.ImportEmployeesRequest, createdBy uint) (*employee_import.ImportResult, error)
+	}
+
+	EmployeeImportService struct {
+		logger         logger.Logger
+		config         config.Config
+		instanceRepo   instanceRepo.IInstanceRepository
+		userRepo       userRepo.IUserRepository
+		roleRepo       roleRepo.IRoleRepository
+		departmentRepo departmentRepo.IDepartmentRepository
+		validator      *validator.CustomValidator
+	}
+
+	// employeeRow is one sheet row, blank cells leave the current value of an existing employee untouched
+	employeeRow struct {
+		EmployeeNumber    string `json:"employee_number" validate:"required,max=30"`
+		Username          string `json:"username" validate:"omitempty,min=3,max=50"`
+		FullName          string `json:"full_name" validate:"omitempty,min=3,max=150"`
+		Email             string `json:"email" validate:"omitempty,email,max=150"`
+		Role              string `json:"role" validate:"omitempty,max=50"`
+		Salary            string `json:"salary"`
+		Grade             string `json:"grade" validate:"omitempty,max=20"`
+		EmploymentType    string `json:"employment_type" validate:"omitempty,oneof=permanent contract probation intern"`
+		Department        string `json:"department" validate:"omitempty,max=100"`
+		Position          string `json:"position" validate:"omitempty,max=100"`
+		BankName          string `json:"bank_name" validate:"omitempty,max=50"`
+		BankAccountNumber string `json:"bank_account_number" validate:"omitempty,numeric,max=30"`
+		BankAccountName   string `json:"bank_account_name" validate:"omitempty,max=150"`
+		JoinDate          string `json:"join_date"`
+	}
+
+	// employeePlan is a validated row together with the records it resolved to
+	employeePlan struct {
+		row        int
+		data       employeeRow
+		userData   *user.User
+		profile    *user.EmployeeProfile
+		role       *role.Role
+		department *department.Department
+		salary     *float64
+		joinDate   *time.Time
+	}
+)
+
+func NewEmployeeImportService(
+	logger logger.Logger,
+	config config.Config,
+	instanceRepo instanceRepo.IInstanceRepository,
+	userRepo userRepo.IUserRepository,
+	roleRepo roleRepo.IRoleRepository,
+	departmentRepo departmentRepo.IDepartmentRepository,
+) IEmployeeImportService {
+	return &EmployeeImportService{
+		logger:         logger,
+		config:         config,
+		instanceRepo:   instanceRepo,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		departmentRepo: departmentRepo,
+		validator:      validator.NewCustomValidator(),
+	}
+}
+
+// Import creates or updates employees by employee number. Every row is validated first and
+// nothing is written unless the whole sheet is valid, new users get a temporary password that is only returned here.
+func (s *EmployeeImportService) Import(c context.Context, req employee_import.ImportEmployeesRequest, createdBy uint) (*employee_import.ImportResult, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing employee import", requestID, map[string]interface{}{
+		"file_name":  req.FileName,
+		"file_size":  len(req.Content),
+		"dry_run":    req.DryRun,
+		"created_by": createdBy,
+	})
+
+	rows, err := spreadsheet.ReadTable(req.FileName, req.Content)
+	if err != nil {
+		s.logger.WarningT("failed to read employee sheet", requestID, map[string]interface{}{
+			"file_name": req.FileName,
+			"error":     err.Error(),
+		})
+		return nil, err
+	}
+	if len(rows) < 2 {
+		return nil, errors.New("file has no employee rows")
+	}
+	if len(rows)-1 > maxImportRows {
+		return nil, fmt.Errorf("file has more than %d employee rows", maxImportRows)
+	}
+
+	columns, err := parseHeader(rows[0])
+	if err != nil {
+		return nil, err
+	}
+
+	result := &employee_import.ImportResult{
+		DryRun:    req.DryRun,
+		FileName:  req.FileName,
+		Employees: []employee_import.ImportedEmployee{},
+		Errors:    []employee_import.RowError{},
+	}
+
+	plans := s.planRows(c, rows[1:], columns, createdBy, req.CanAssignRoles, result)
+	result.TotalRows = len(plans) + countFailedRows(result.Errors)
+
+	for _, plan := range plans {
+		imported := employee_import.ImportedEmployee{
+			Row:            plan.row,
+			EmployeeNumber: plan.data.EmployeeNumber,
+			Username:       plan.data.Username,
+			Action:         employee_import.ActionCreated,
+		}
+		if plan.userData != nil {
+			imported.UserID = &plan.userData.ID
+			imported.Username = plan.userData.Username
+			imported.Action = employee_import.ActionUpdated
+			result.Updated++
+		} else {
+			result.Created++
+		}
+		result.Employees = append(result.Employees, imported)
+	}
+
+	if req.DryRun || len(result.Errors) > 0 {
+		s.logger.InfoT("employee import validated", requestID, map[string]interface{}{
+			"file_name":  req.FileName,
+			"dry_run":    req.DryRun,
+			"total_rows": result.TotalRows,
+			"errors":     len(result.Errors),
+		})
+		if !req.DryRun {
+			result.Created, result.Updated = 0, 0
+			result.Employees = []employee_import.ImportedEmployee{}
+		}
+		return result, nil
+	}
+
+	// Hashing is slow, do it before the transaction so row locks are held briefly
+	passwords := map[int]string{}
+	hashes := map[int]string{}
+	for _, plan := range plans {
+		if plan.userData != nil {
+			continue
+		}
+		password, err := bcrypt.GenerateTemporaryPassword(s.passwordPolicy())
+		if err != nil {
+			return nil, errors.New("failed to generate temporary password")
+		}
+		hashed, err := bcrypt.HashPasswordWithEnvCost(password)
+		if err != nil {
+			return nil, errors.New("failed to hash password")
+		}
+		passwords[plan.row] = password
+		hashes[plan.row] = hashed
+	}
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		s.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for i, plan := range plans {
+		var userID uint
+		if plan.userData == nil {
+			userID, err = s.createEmployee(ctx, plan, hashes[plan.row], createdBy)
+		} else {
+			userID, err = plan.userData.ID, s.updateEmployee(ctx, plan, createdBy)
+		}
+		if err != nil {
+			s.logger.ErrorT("failed to import employee", requestID, map[string]interface{}{
+				"row":             plan.row,
+				"employee_number": plan.data.EmployeeNumber,
+				"error":           err.Error(),
+			})
+			return nil, fmt.Errorf("failed to import row %d: %w", plan.row, err)
+		}
+
+		result.Employees[i].UserID = &userID
+		result.Employees[i].TemporaryPassword = passwords[plan.row]
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.logger.ErrorT("failed to commit employee import", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to commit employee import: %w", err)
+	}
+
+	s.logger.InfoT("employee import completed", requestID, map[string]interface{}{
+		"file_name": req.FileName,
+		"created":   result.Created,
+		"updated":   result.Updated,
+	})
+	return result, nil
+}
+
+// planRows validates every row and resolves the employee, role and department it refers to
+func (s *EmployeeImportService) planRows(ctx context.Context, rows [][]string, columns map[string]int, createdBy uint, canAssignRoles bool, result *employee_import.ImportResult) []employeePlan {
+	roles := map[string]*role.Role{}
+	departments := map[string]*department.Department{}
+	employeeNumbers := map[string]int{}
+	usernames := map[string]int{}
+	emails := map[string]int{}
+
+	plans := []employeePlan{}
+	for i, cells := range rows {
+		rowNumber := i + 2
+		if isBlankRow(cells) {
+			continue
+		}
+
+		rowErrors := []employee_import.RowError{}
+		addError := func(field, message string) {
+			rowErrors = append(rowErrors, employee_import.RowError{Row: rowNumber, Field: field, Message: message})
+		}
+
+		data := readRow(cells, columns)
+		if err := s.validator.Validate(data); err != nil {
+			var validationErrors *validator.ValidationErrors
+			if errors.As(err, &validationErrors) {
+				for _, fieldError := range validationErrors.GetValidationErrors() {
+					addError(fieldError.Field, fieldError.Message)
+				}
+			}
+		}
+
+		plan := employeePlan{row: rowNumber, data: data}
+
+		if data.Salary != "" {
+			salary, err := strconv.ParseFloat(data.Salary, 64)
+			if err != nil || salary < 0 {
+				addError("salary", "salary must be a positive number")
+			} else {
+				plan.salary = &salary
+			}
+		}
+		if data.JoinDate != "" {
+			joinDate, err := parseJoinDate(data.JoinDate)
+			if err != nil {
+				addError("join_date", err.Error())
+			} else {
+				plan.joinDate = &joinDate
+			}
+		}
+
+		if data.EmployeeNumber != "" {
+			key := strings.ToLower(data.EmployeeNumber)
+			if previous, ok := employeeNumbers[key]; ok {
+				addError("employee_number", fmt.Sprintf("employee number is repeated from row %d", previous))
+			} else {
+				employeeNumbers[key] = rowNumber
+			}
+
+			if profile, err := s.userRepo.GetEmployeeProfileByNumber(ctx, data.EmployeeNumber); err == nil {
+				plan.profile = profile
+				if existing, err := s.userRepo.GetUserByID(ctx, profile.UserID); err == nil {
+					plan.userData = &existing
+				} else {
+					addError("employee_number", "employee number belongs to a missing user")
+				}
+			}
+		}
+
+		if plan.userData != nil {
+			if data.Username != "" && !strings.EqualFold(data.Username, plan.userData.Username) {
+				addError("username", "username does not match the existing employee "+plan.userData.Username)
+			}
+		} else {
+			if data.Username == "" {
+				addError("username", "username is required for a new employee")
+			} else if existing, _ := s.userRepo.GetUserByUsername(ctx, data.Username); existing.ID != 0 {
+				addError("username", "username already exists")
+			}
+			if data.FullName == "" {
+				addError("full_name", "full_name is required for a new employee")
+			}
+		}
+
+		if data.Username != "" {
+			key := strings.ToLower(data.Username)
+			if previous, ok := usernames[key]; ok {
+				addError("username", fmt.Sprintf("username is repeated from row %d", previous))
+			} else {
+				usernames[key] = rowNumber
+			}
+		}
+
+		// The email links the corporate single sign-on account, it has to be unique as well
+		if data.Email != "" {
+			key := strings.ToLower(data.Email)
+			if previous, ok := emails[key]; ok {
+				addError("email", fmt.Sprintf("email is repeated from row %d", previous))
+			} else {
+				emails[key] = rowNumber
+			}
+			if existing, _ := s.userRepo.GetUserByEmail(ctx, data.Email); existing.ID != 0 && (plan.userData == nil || existing.ID != plan.userData.ID) {
+				addError("email", "email already in use")
+			}
+		}
+
+		if data.Role != "" {
+			key := strings.ToLower(data.Role)
+			if _, ok := roles[key]; !ok {
+				roles[key], _ = s.roleRepo.GetRoleByName(ctx, data.Role)
+			}
+			plan.role = roles[key]
+			if plan.role == nil {
+				addError("role", "role not found")
+			} else if !canAssignRoles && !keepsEmployeeRole(plan) {
+				// Granting a role is role assignment, user:manage alone only creates and keeps plain employees
+				addError("role", "assigning a role requires the "+constant.PermissionRoleManage+" permission")
+			} else if plan.userData != nil && plan.userData.ID == createdBy && plan.role.IsSystem && plan.role.Name != plan.userData.Role {
+				// An admin demoting themselves could leave nobody able to manage users
+				addError("role", "cannot change your own role")
+			}
+		}
+
+		if data.Department != "" {
+			key := strings.ToLower(data.Department)
+			if _, ok := departments[key]; !ok {
+				departments[key], _ = s.departmentRepo.GetDepartmentByName(ctx, data.Department)
+			}
+			plan.department = departments[key]
+			if plan.department == nil {
+				addError("department", "department not found")
+			}
+		}
+
+		if len(rowErrors) > 0 {
+			result.Errors = append(result.Errors, rowErrors...)
+			continue
+		}
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+func (s *EmployeeImportService) createEmployee(ctx context.Context, plan employeePlan, hashedPassword string, createdBy uint) (uint, error) {
+	data := plan.data
+
+	// users.roles only knows the system roles, custom roles are granted on top of the employee role
+	systemRole := constant.EmployeeRole
+	if plan.role != nil && plan.role.IsSystem {
+		systemRole = plan.role.Name
+	}
+
+	userData := user.User{
+		Username:           data.Username,
+		Password:           hashedPassword,
+		Email:              optional(data.Email),
+		Role:               systemRole,
+		Grade:              optional(data.Grade),
+		EmploymentType:     optional(data.EmploymentType),
+		Status:             constant.StatusActive,
+		CreatedBy:          &createdBy,
+		MustChangePassword: true,
+	}
+	if plan.salary != nil {
+		userData.Salary = *plan.salary
+	}
+	if plan.department != nil {
+		userData.DepartmentID = &plan.department.ID
+	}
+
+	createdUser, err := s.userRepo.CreateUser(ctx, userData)
+	if err != nil {
+		return 0, err
+	}
+
+	profile := &user.EmployeeProfile{
+		UserID:            createdUser.ID,
+		FullName:          data.FullName,
+		EmployeeNumber:    data.EmployeeNumber,
+		BankName:          optional(data.BankName),
+		BankAccountNumber: optional(data.BankAccountNumber),
+		BankAccountName:   optional(data.BankAccountName),
+		Position:          optional(data.Position),
+		JoinDate:          plan.joinDate,
+		CreatedBy:         &createdBy,
+	}
+	if plan.department != nil {
+		profile.Department = &plan.department.Name
+	}
+	if err := s.userRepo.SaveEmployeeProfile(ctx, profile); err != nil {
+		return 0, err
+	}
+
+	if plan.role != nil && !plan.role.IsSystem {
+		if err := s.roleRepo.SetUserRoles(ctx, createdUser.ID, []uint{plan.role.ID}, createdBy); err != nil {
+			return 0, err
+		}
+	}
+	return createdUser.ID, nil
+}
+
+func (s *EmployeeImportService) updateEmployee(ctx context.Context, plan employeePlan, updatedBy uint) error {
+	data := plan.data
+	userID := plan.userData.ID
+	now := time.Now()
+
+	updates := map[string]interface{}{
+		"updated_by": updatedBy,
+		"updated_at": now,
+	}
+	if plan.role != nil && plan.role.IsSystem {
+		updates["roles"] = plan.role.Name
+	}
+	if plan.salary != nil {
+		updates["salary"] = *plan.salary
+	}
+	if data.Email != "" {
+		updates["email"] = data.Email
+	}
+	if data.Grade != "" {
+		updates["grade"] = data.Grade
+	}
+	if data.EmploymentType != "" {
+		updates["employment_type"] = data.EmploymentType
+	}
+	if plan.department != nil {
+		updates["department_id"] = plan.department.ID
+	}
+	if err := s.userRepo.UpdateUser(ctx, userID, updates); err != nil {
+		return err
+	}
+
+	profile := plan.profile
+	profile.UpdatedBy = &updatedBy
+	profile.UpdatedAt = &now
+	if data.FullName != "" {
+		profile.FullName = data.FullName
+	}
+	if data.BankName != "" {
+		profile.BankName = &data.BankName
+	}
+	if data.BankAccountNumber != "" {
+		profile.BankAccountNumber = &data.BankAccountNumber
+	}
+	if data.BankAccountName != "" {
+		profile.BankAccountName = &data.BankAccountName
+	}
+	if data.Position != "" {
+		profile.Position = &data.Position
+	}
+	if plan.department != nil {
+		profile.Department = &plan.department.Name
+	}
+	if plan.joinDate != nil {
+		profile.JoinDate = plan.joinDate
+	}
+	if err := s.userRepo.SaveEmployeeProfile(ctx, profile); err != nil {
+		return err
+	}
+
+	// A custom role is granted next to the roles the employee already has
+	if plan.role != nil && !plan.role.IsSystem {
+		current, err := s.roleRepo.GetUserRoles(ctx, userID)
+		if err != nil {
+			return err
+		}
+		roleIDs := []uint{plan.role.ID}
+		for _, r := range current {
+			if !r.IsSystem && r.ID != plan.role.ID {
+				roleIDs = append(roleIDs, r.ID)
+			}
+		}
+		if err := s.roleRepo.SetUserRoles(ctx, userID, roleIDs, updatedBy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keepsEmployeeRole reports whether the role column only repeats the employee role the row already gets
+func keepsEmployeeRole(plan employeePlan) bool {
+	if !plan.role.IsSystem || plan.role.Name != constant.EmployeeRole {
+		return false
+	}
+	return plan.userData == nil || plan.userData.Role == constant.EmployeeRole
+}
+
+func (s *EmployeeImportService) passwordPolicy() bcrypt.PasswordPolicy {
+	return bcrypt.PasswordPolicy{
+		MinLength:     s.config.Password.MinLength,
+		RequireUpper:  s.config.Password.RequireUpper,
+		RequireLower:  s.config.Password.RequireLower,
+		RequireDigit:  s.config.Password.RequireDigit,
+		RequireSymbol: s.config.Password.RequireSymbol,
+	}
+}
+
+// parseHeader maps the column names to their index, names are matched case-insensitively
+func parseHeader(header []string) (map[string]int, error) {
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		known := false
+		for _, column := range importColumns {
+			if column == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown column %q, accepted columns are %s", name, strings.Join(importColumns, ", "))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("column %q appears more than once", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["employee_number"]; !ok {
+		return nil, errors.New("column employee_number is required")
+	}
+	return columns, nil
+}
+
+func readRow(cells []string, columns map[string]int) employeeRow {
+	cell := func(name string) string {
+		index, ok := columns[name]
+		if !ok || index >= len(cells) {
+			return ""
+		}
+		return strings.TrimSpace(cells[index])
+	}
+
+	return employeeRow{
+		EmployeeNumber:    cell("employee_number"),
+		Username:          cell("username"),
+		FullName:          cell("full_name"),
+		Email:             cell("email"),
+		Role:              cell("role"),
+		Salary:            cell("salary"),
+		Grade:             cell("grade"),
+		EmploymentType:    strings.ToLower(cell("employment_type")),
+		Department:        cell("department"),
+		Position:          cell("position"),
+		BankName:          cell("bank_name"),
+		BankAccountNumber: cell("bank_account_number"),
+		BankAccountName:   cell("bank_account_name"),
+		JoinDate:          cell("join_date"),
+	}
+}
+
+// parseJoinDate accepts YYYY-MM-DD or the day number spreadsheets store dates as
+func parseJoinDate(value string) (time.Time, error) {
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		return date, nil
+	}
+	if serial, err := strconv.Atoi(value); err == nil && serial > 0 {
+		return time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC).AddDate(0, 0, serial), nil
+	}
+	return time.Time{}, errors.New("invalid join_date format, use YYYY-MM-DD")
+}
+
+func isBlankRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func countFailedRows(rowErrors []employee_import.RowError) int {
+	rows := map[int]bool{}
+	for _, rowError := range rowErrors {
+		rows[rowError.Row] = true
+	}
+	return len(rows)
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
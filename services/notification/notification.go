@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/notification"
+	notificationRepo "github.com/riskykurniawan15/payrolls/repositories/notification"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+type (
+	INotificationService interface {
+		Notify(ctx context.Context, userID uint, notificationType, title, message string) error
+		List(ctx context.Context, req notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error)
+		MarkAsRead(ctx context.Context, id, userID uint) error
+	}
+
+	NotificationService struct {
+		logger           logger.Logger
+		notificationRepo notificationRepo.INotificationRepository
+	}
+)
+
+func NewNotificationService(logger logger.Logger, notificationRepo notificationRepo.INotificationRepository) INotificationService {
+	return &NotificationService{
+		logger:           logger,
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Notify stores an in-app notification for the user
+func (s *NotificationService) Notify(ctx context.Context, userID uint, notificationType, title, message string) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	err := s.notificationRepo.Create(ctx, &notification.Notification{
+		UserID:    userID,
+		Type:      notificationType,
+		Title:     title,
+		Message:   message,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		s.logger.ErrorT("failed to create notification", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+			"type":    notificationType,
+		})
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	s.logger.InfoT("notification sent", requestID, map[string]interface{}{
+		"user_id": userID,
+		"type":    notificationType,
+	})
+
+	return nil
+}
+
+func (s *NotificationService) List(ctx context.Context, req notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default values if not provided
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	response, err := s.notificationRepo.List(ctx, req)
+	if err != nil {
+		s.logger.ErrorT("failed to list notifications", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": req.UserID,
+		})
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	return response, nil
+}
+
+func (s *NotificationService) MarkAsRead(ctx context.Context, id, userID uint) error {
+	return s.notificationRepo.MarkAsRead(ctx, id, userID)
+}
@@ -0,0 +1,377 @@
+package office_location
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/office_location"
+	officeLocationRepo "github.com/riskykurniawan15/payrolls/repositories/office_location"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+type (
+	IOfficeLocationService interface {
+		Create(ctx context.Context, req office_location.CreateOfficeLocationRequest, createdBy uint) (*office_location.OfficeLocationResponse, error)
+		GetByID(ctx context.Context, id uint) (*office_location.OfficeLocationResponse, error)
+		Update(ctx context.Context, id uint, req office_location.UpdateOfficeLocationRequest, updatedBy uint) (*office_location.OfficeLocationResponse, error)
+		Delete(ctx context.Context, id uint, deletedBy uint) error
+		List(ctx context.Context, req office_location.ListOfficeLocationsRequest) (*office_location.ListOfficeLocationsResponse, error)
+		CreateOverride(ctx context.Context, req office_location.CreateGeofenceOverrideRequest, createdBy uint) (*office_location.GeofenceOverrideResponse, error)
+		ListOverrides(ctx context.Context, page, limit int) (*office_location.ListGeofenceOverridesResponse, error)
+		DeleteOverride(ctx context.Context, id uint) error
+	}
+
+	OfficeLocationService struct {
+		logger             logger.Logger
+		officeLocationRepo officeLocationRepo.IOfficeLocationRepository
+		userRepo           userRepo.IUserRepository
+	}
+)
+
+func NewOfficeLocationService(logger logger.Logger, officeLocationRepo officeLocationRepo.IOfficeLocationRepository, userRepo userRepo.IUserRepository) IOfficeLocationService {
+	return &OfficeLocationService{
+		logger:             logger,
+		officeLocationRepo: officeLocationRepo,
+		userRepo:           userRepo,
+	}
+}
+
+func (s *OfficeLocationService) Create(ctx context.Context, req office_location.CreateOfficeLocationRequest, createdBy uint) (*office_location.OfficeLocationResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing create office location request", requestID, map[string]interface{}{
+		"name":          req.Name,
+		"latitude":      req.Latitude,
+		"longitude":     req.Longitude,
+		"radius_meters": req.RadiusMeters,
+		"created_by":    createdBy,
+	})
+
+	location := &office_location.OfficeLocation{
+		Name:         req.Name,
+		Address:      req.Address,
+		Latitude:     *req.Latitude,
+		Longitude:    *req.Longitude,
+		RadiusMeters: req.RadiusMeters,
+		Status:       constant.StatusActive,
+		CreatedBy:    createdBy,
+		CreatedAt:    time.Now(),
+	}
+
+	if err := s.officeLocationRepo.Create(ctx, location); err != nil {
+		s.logger.ErrorT("failed to create office location", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"name":  req.Name,
+		})
+		return nil, fmt.Errorf("failed to create office location: %w", err)
+	}
+
+	s.logger.InfoT("office location created successfully", requestID, map[string]interface{}{
+		"office_location_id": location.ID,
+		"name":               location.Name,
+	})
+
+	response := s.toResponse(*location)
+	return &response, nil
+}
+
+func (s *OfficeLocationService) GetByID(ctx context.Context, id uint) (*office_location.OfficeLocationResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing get office location by ID request", requestID, map[string]interface{}{
+		"office_location_id": id,
+	})
+
+	location, err := s.officeLocationRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.ErrorT("failed to get office location by ID", requestID, map[string]interface{}{
+			"error":              err.Error(),
+			"office_location_id": id,
+		})
+		return nil, fmt.Errorf("office location not found: %w", err)
+	}
+
+	response := s.toResponse(*location)
+	return &response, nil
+}
+
+func (s *OfficeLocationService) Update(ctx context.Context, id uint, req office_location.UpdateOfficeLocationRequest, updatedBy uint) (*office_location.OfficeLocationResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing update office location request", requestID, map[string]interface{}{
+		"office_location_id": id,
+		"updated_by":         updatedBy,
+	})
+
+	// Check if office location exists
+	if _, err := s.officeLocationRepo.GetByID(ctx, id); err != nil {
+		s.logger.ErrorT("failed to get office location for update", requestID, map[string]interface{}{
+			"error":              err.Error(),
+			"office_location_id": id,
+		})
+		return nil, fmt.Errorf("office location not found: %w", err)
+	}
+
+	// Prepare updates
+	updates := make(map[string]interface{})
+	updates["updated_by"] = updatedBy
+	updates["updated_at"] = time.Now()
+
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Address != nil {
+		updates["address"] = *req.Address
+	}
+	if req.Latitude != nil {
+		updates["latitude"] = *req.Latitude
+	}
+	if req.Longitude != nil {
+		updates["longitude"] = *req.Longitude
+	}
+	if req.RadiusMeters != nil {
+		updates["radius_meters"] = *req.RadiusMeters
+	}
+	if req.Status != nil {
+		updates["status"] = *req.Status
+	}
+
+	// Apply updates
+	if err := s.officeLocationRepo.Update(ctx, id, updates); err != nil {
+		s.logger.ErrorT("failed to update office location", requestID, map[string]interface{}{
+			"error":              err.Error(),
+			"office_location_id": id,
+		})
+		return nil, fmt.Errorf("failed to update office location: %w", err)
+	}
+
+	// Get updated office location
+	updatedLocation, err := s.officeLocationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated office location: %w", err)
+	}
+
+	s.logger.InfoT("office location updated successfully", requestID, map[string]interface{}{
+		"office_location_id": id,
+	})
+
+	response := s.toResponse(*updatedLocation)
+	return &response, nil
+}
+
+func (s *OfficeLocationService) Delete(ctx context.Context, id uint, deletedBy uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing delete office location request", requestID, map[string]interface{}{
+		"office_location_id": id,
+		"deleted_by":         deletedBy,
+	})
+
+	// Check if office location exists
+	if _, err := s.officeLocationRepo.GetByID(ctx, id); err != nil {
+		s.logger.ErrorT("failed to get office location for delete", requestID, map[string]interface{}{
+			"error":              err.Error(),
+			"office_location_id": id,
+		})
+		return fmt.Errorf("office location not found: %w", err)
+	}
+
+	if err := s.officeLocationRepo.Delete(ctx, id); err != nil {
+		s.logger.ErrorT("failed to delete office location", requestID, map[string]interface{}{
+			"error":              err.Error(),
+			"office_location_id": id,
+		})
+		return fmt.Errorf("failed to delete office location: %w", err)
+	}
+
+	s.logger.InfoT("office location deleted successfully", requestID, map[string]interface{}{
+		"office_location_id": id,
+		"deleted_by":         deletedBy,
+	})
+
+	return nil
+}
+
+func (s *OfficeLocationService) List(ctx context.Context, req office_location.ListOfficeLocationsRequest) (*office_location.ListOfficeLocationsResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default values if not provided
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	s.logger.InfoT("processing list office locations request", requestID, map[string]interface{}{
+		"page":      req.Page,
+		"limit":     req.Limit,
+		"search":    req.Search,
+		"sort_by":   req.SortBy,
+		"sort_desc": req.SortDesc,
+	})
+
+	response, err := s.officeLocationRepo.List(ctx, req)
+	if err != nil {
+		s.logger.ErrorT("failed to list office locations", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"page":  req.Page,
+			"limit": req.Limit,
+		})
+		return nil, fmt.Errorf("failed to list office locations: %w", err)
+	}
+
+	s.logger.InfoT("office locations listed successfully", requestID, map[string]interface{}{
+		"total_count":  response.Pagination.Total,
+		"total_pages":  response.Pagination.TotalPages,
+		"current_page": response.Pagination.Page,
+		"data_count":   len(response.Data),
+	})
+
+	return response, nil
+}
+
+func (s *OfficeLocationService) CreateOverride(ctx context.Context, req office_location.CreateGeofenceOverrideRequest, createdBy uint) (*office_location.GeofenceOverrideResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing create geofence override request", requestID, map[string]interface{}{
+		"user_id":     req.UserID,
+		"valid_until": req.ValidUntil,
+		"created_by":  createdBy,
+	})
+
+	// Make sure the user exists
+	u, err := s.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		s.logger.WarningT("user not found for geofence override", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": req.UserID,
+		})
+		return nil, fmt.Errorf("user not found")
+	}
+
+	// Only one override per user
+	if existing, err := s.officeLocationRepo.GetOverrideByUserID(ctx, req.UserID); err == nil && existing != nil {
+		s.logger.WarningT("geofence override already exists", requestID, map[string]interface{}{
+			"user_id":     req.UserID,
+			"override_id": existing.ID,
+		})
+		return nil, fmt.Errorf("geofence override already exists for this user")
+	}
+
+	var validUntil *time.Time
+	if req.ValidUntil != nil {
+		parsed, err := time.ParseInLocation("2006-01-02", *req.ValidUntil, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid valid_until format, expected YYYY-MM-DD")
+		}
+		// Valid through the end of the given day
+		endOfDay := parsed.Add(24*time.Hour - time.Nanosecond)
+		validUntil = &endOfDay
+	}
+
+	override := &office_location.GeofenceOverride{
+		UserID:     req.UserID,
+		Reason:     req.Reason,
+		ValidUntil: validUntil,
+		CreatedBy:  createdBy,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := s.officeLocationRepo.CreateOverride(ctx, override); err != nil {
+		s.logger.ErrorT("failed to create geofence override", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": req.UserID,
+		})
+		return nil, fmt.Errorf("failed to create geofence override: %w", err)
+	}
+
+	s.logger.InfoT("geofence override created successfully", requestID, map[string]interface{}{
+		"override_id": override.ID,
+		"user_id":     override.UserID,
+	})
+
+	return &office_location.GeofenceOverrideResponse{
+		ID:         override.ID,
+		UserID:     override.UserID,
+		Username:   u.Username,
+		Reason:     override.Reason,
+		ValidUntil: override.ValidUntil,
+		CreatedBy:  override.CreatedBy,
+		CreatedAt:  override.CreatedAt,
+	}, nil
+}
+
+func (s *OfficeLocationService) ListOverrides(ctx context.Context, page, limit int) (*office_location.ListGeofenceOverridesResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default values if not provided
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	s.logger.InfoT("processing list geofence overrides request", requestID, map[string]interface{}{
+		"page":  page,
+		"limit": limit,
+	})
+
+	response, err := s.officeLocationRepo.ListOverrides(ctx, page, limit)
+	if err != nil {
+		s.logger.ErrorT("failed to list geofence overrides", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"page":  page,
+			"limit": limit,
+		})
+		return nil, fmt.Errorf("failed to list geofence overrides: %w", err)
+	}
+
+	return response, nil
+}
+
+func (s *OfficeLocationService) DeleteOverride(ctx context.Context, id uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing delete geofence override request", requestID, map[string]interface{}{
+		"override_id": id,
+	})
+
+	if _, err := s.officeLocationRepo.GetOverrideByID(ctx, id); err != nil {
+		s.logger.ErrorT("failed to get geofence override for delete", requestID, map[string]interface{}{
+			"error":       err.Error(),
+			"override_id": id,
+		})
+		return fmt.Errorf("geofence override not found: %w", err)
+	}
+
+	if err := s.officeLocationRepo.DeleteOverride(ctx, id); err != nil {
+		s.logger.ErrorT("failed to delete geofence override", requestID, map[string]interface{}{
+			"error":       err.Error(),
+			"override_id": id,
+		})
+		return fmt.Errorf("failed to delete geofence override: %w", err)
+	}
+
+	s.logger.InfoT("geofence override deleted successfully", requestID, map[string]interface{}{
+		"override_id": id,
+	})
+
+	return nil
+}
+
+// Helper function to convert OfficeLocation to OfficeLocationResponse
+func (s *OfficeLocationService) toResponse(l office_location.OfficeLocation) office_location.OfficeLocationResponse {
+	return office_location.OfficeLocationResponse{
+		ID:           l.ID,
+		Name:         l.Name,
+		Address:      l.Address,
+		Latitude:     l.Latitude,
+		Longitude:    l.Longitude,
+		RadiusMeters: l.RadiusMeters,
+		Status:       l.Status,
+		CreatedBy:    l.CreatedBy,
+		CreatedAt:    l.CreatedAt,
+		UpdatedBy:    l.UpdatedBy,
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
@@ -2,14 +2,22 @@ package overtime
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
 	"github.com/riskykurniawan15/payrolls/models/attendance"
 	"github.com/riskykurniawan15/payrolls/models/overtime"
 	attendanceRepo "github.com/riskykurniawan15/payrolls/repositories/attendance"
 	overtimeRepo "github.com/riskykurniawan15/payrolls/repositories/overtime"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	notificationService "github.com/riskykurniawan15/payrolls/services/notification"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
 )
 
@@ -20,29 +28,63 @@ type (
 		Update(ctx context.Context, id uint, req overtime.UpdateOvertimeRequest, userID uint) (*overtime.OvertimeResponse, error)
 		Delete(ctx context.Context, id uint, userID uint) error
 		List(ctx context.Context, req overtime.ListOvertimesRequest, userID uint) (*overtime.ListOvertimesResponse, error)
+		ListProposals(ctx context.Context, userID uint, startDate, endDate *string) ([]overtime.OvertimeProposal, error)
+		ConfirmProposal(ctx context.Context, req overtime.ConfirmOvertimeProposalRequest, userID uint) (*overtime.OvertimeResponse, error)
+		ListAll(ctx context.Context, req overtime.ListAllOvertimesRequest) (*overtime.ListOvertimesResponse, error)
+		Approve(ctx context.Context, id, reviewerID uint, req overtime.ReviewOvertimeRequest) (*overtime.OvertimeResponse, error)
+		Reject(ctx context.Context, id, reviewerID uint, req overtime.ReviewOvertimeRequest) (*overtime.OvertimeResponse, error)
 	}
 
 	OvertimeService struct {
 		logger         logger.Logger
+		config         config.Config
 		overtimeRepo   overtimeRepo.IOvertimeRepository
 		attendanceRepo attendanceRepo.IAttendanceRepository
+		userRepo       userRepo.IUserRepository
+		notification   notificationService.INotificationService
 	}
 )
 
-func NewOvertimeService(logger logger.Logger, overtimeRepo overtimeRepo.IOvertimeRepository, attendanceRepo attendanceRepo.IAttendanceRepository) IOvertimeService {
+// capWindow is a rolling period with an overtime cap, a cap of zero is not enforced
+type capWindow struct {
+	name string
+	days int
+	cap  float64
+}
+
+const (
+	// maxDailyHours is the overtime limit of a single day
+	maxDailyHours = 3.0
+
+	weeklyCapDays  = 7
+	monthlyCapDays = 30
+)
+
+// ErrSelfReview is returned when a reviewer tries to approve or reject their own overtime
+var ErrSelfReview = errors.New("cannot review your own overtime")
+
+func NewOvertimeService(logger logger.Logger, config config.Config, overtimeRepo overtimeRepo.IOvertimeRepository, attendanceRepo attendanceRepo.IAttendanceRepository, userRepo userRepo.IUserRepository, notification notificationService.INotificationService) IOvertimeService {
 	return &OvertimeService{
 		logger:         logger,
+		config:         config,
 		overtimeRepo:   overtimeRepo,
 		attendanceRepo: attendanceRepo,
+		userRepo:       userRepo,
+		notification:   notification,
 	}
 }
 
 func (s *OvertimeService) Create(ctx context.Context, req overtime.CreateOvertimeRequest, userID uint) (*overtime.OvertimeResponse, error) {
+	return s.create(ctx, req, userID, constant.OvertimeSourceDeclared)
+}
+
+func (s *OvertimeService) create(ctx context.Context, req overtime.CreateOvertimeRequest, userID uint, source string) (*overtime.OvertimeResponse, error) {
 	requestID := middleware.GetRequestIDFromContext(ctx)
 	s.logger.InfoT("processing create overtime request", requestID, map[string]interface{}{
 		"user_id":          userID,
 		"overtimes_date":   req.OvertimesDate,
 		"total_hours_time": req.TotalHoursTime,
+		"source":           source,
 		"created_by":       userID,
 	})
 
@@ -60,12 +102,21 @@ func (s *OvertimeService) Create(ctx context.Context, req overtime.CreateOvertim
 		return nil, fmt.Errorf("overtime date cannot be in the future")
 	}
 
+	// Actual hours stay unknown when there is no completed attendance
+	var actualHours *float64
+
 	// Check if it's a weekday
 	if !attendance.IsWeekday(overtimeDate) {
 		s.logger.InfoT("overtime on weekend is allowed", requestID, map[string]interface{}{
 			"overtimes_date": overtimeDate.Format("2006-01-02"),
 			"weekday":        overtimeDate.Weekday().String(),
 		})
+
+		if attendanceRecord, err := s.attendanceRepo.GetByUserAndDate(ctx, userID, overtimeDate); err == nil {
+			if hours, _, ok := s.actualHours(ctx, *attendanceRecord); ok {
+				actualHours = &hours
+			}
+		}
 	} else {
 		// For weekdays, check if user has checked out attendance
 		s.logger.InfoT("checking attendance checkout for weekday", requestID, map[string]interface{}{
@@ -107,6 +158,10 @@ func (s *OvertimeService) Create(ctx context.Context, req overtime.CreateOvertim
 			"check_in_date":  attendanceRecord.CheckInDate.Format("2006-01-02 15:04:05"),
 			"check_out_date": attendanceRecord.CheckOutDate.Format("2006-01-02 15:04:05"),
 		})
+
+		if hours, _, ok := s.actualHours(ctx, *attendanceRecord); ok {
+			actualHours = &hours
+		}
 	}
 
 	// Check total hours limit (max 3 hours per day)
@@ -134,6 +189,11 @@ func (s *OvertimeService) Create(ctx context.Context, req overtime.CreateOvertim
 			overtimeDate.Format("2006-01-02"), totalHours, req.TotalHoursTime, newTotalHours)
 	}
 
+	// Check rolling weekly and monthly caps
+	if err := s.checkCaps(ctx, userID, overtimeDate, req.TotalHoursTime, 0); err != nil {
+		return nil, err
+	}
+
 	s.logger.InfoT("overtime validation passed", requestID, map[string]interface{}{
 		"user_id":          userID,
 		"overtimes_date":   overtimeDate.Format("2006-01-02"),
@@ -147,6 +207,9 @@ func (s *OvertimeService) Create(ctx context.Context, req overtime.CreateOvertim
 		UserID:         userID,
 		OvertimesDate:  overtimeDate,
 		TotalHoursTime: req.TotalHoursTime,
+		ActualHours:    actualHours,
+		Source:         source,
+		Status:         constant.ApprovalStatusPending,
 		CreatedBy:      userID,
 		CreatedAt:      time.Now(),
 		UpdatedBy:      nil,
@@ -245,6 +308,11 @@ func (s *OvertimeService) Update(ctx context.Context, id uint, req overtime.Upda
 		return nil, fmt.Errorf("overtime not found")
 	}
 
+	// Reviewed overtime is final
+	if existingOvertime.Status != constant.ApprovalStatusPending {
+		return nil, fmt.Errorf("overtime is already %s", existingOvertime.Status)
+	}
+
 	s.logger.InfoT("overtime validation passed", requestID, map[string]interface{}{
 		"overtime_id":      id,
 		"user_id":          existingOvertime.UserID,
@@ -285,6 +353,14 @@ func (s *OvertimeService) Update(ctx context.Context, id uint, req overtime.Upda
 		// Allow multiple overtime records per day, no duplicate check needed
 
 		updates["overtimes_date"] = overtimeDate
+
+		// Re-measure the actual hours of the new date
+		updates["actual_hours"] = nil
+		if attendanceRecord, err := s.attendanceRepo.GetByUserAndDate(ctx, existingOvertime.UserID, overtimeDate); err == nil {
+			if hours, _, ok := s.actualHours(ctx, *attendanceRecord); ok {
+				updates["actual_hours"] = hours
+			}
+		}
 	}
 
 	// Update total_hours_time if provided
@@ -310,6 +386,22 @@ func (s *OvertimeService) Update(ctx context.Context, id uint, req overtime.Upda
 		updates["total_hours_time"] = *req.TotalHoursTime
 	}
 
+	// Check rolling weekly and monthly caps with the updated date and hours
+	if req.OvertimesDate != nil || req.TotalHoursTime != nil {
+		overtimeDate := existingOvertime.OvertimesDate
+		if req.OvertimesDate != nil {
+			overtimeDate = req.OvertimesDate.Time
+		}
+		hours := existingOvertime.TotalHoursTime
+		if req.TotalHoursTime != nil {
+			hours = *req.TotalHoursTime
+		}
+
+		if err := s.checkCaps(ctx, existingOvertime.UserID, overtimeDate, hours, id); err != nil {
+			return nil, err
+		}
+	}
+
 	// Apply updates
 	if err := s.overtimeRepo.Update(ctx, id, updates); err != nil {
 		return nil, fmt.Errorf("failed to update overtime: %w", err)
@@ -351,6 +443,11 @@ func (s *OvertimeService) Delete(ctx context.Context, id uint, userID uint) erro
 		return fmt.Errorf("overtime not found")
 	}
 
+	// Approved overtime is paid in payroll
+	if existingOvertime.Status == constant.ApprovalStatusApproved {
+		return fmt.Errorf("approved overtime cannot be deleted")
+	}
+
 	s.logger.InfoT("overtime validation passed for delete", requestID, map[string]interface{}{
 		"overtime_id":      id,
 		"user_id":          existingOvertime.UserID,
@@ -415,6 +512,15 @@ func (s *OvertimeService) List(ctx context.Context, req overtime.ListOvertimesRe
 		return nil, fmt.Errorf("failed to list overtimes: %w", err)
 	}
 
+	response.Allowance, err = s.allowance(ctx, userID, time.Now())
+	if err != nil {
+		s.logger.ErrorT("failed to get overtime allowance", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		})
+		return nil, fmt.Errorf("failed to get overtime allowance: %w", err)
+	}
+
 	s.logger.InfoT("overtimes listed successfully", requestID, map[string]interface{}{
 		"total_count":  response.Pagination.Total,
 		"total_pages":  response.Pagination.TotalPages,
@@ -426,6 +532,416 @@ func (s *OvertimeService) List(ctx context.Context, req overtime.ListOvertimesRe
 	return response, nil
 }
 
+func (s *OvertimeService) ListProposals(ctx context.Context, userID uint, startDate, endDate *string) ([]overtime.OvertimeProposal, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing list overtime proposals request", requestID, map[string]interface{}{
+		"user_id":    userID,
+		"start_date": startDate,
+		"end_date":   endDate,
+	})
+
+	if !s.config.Overtime.ProposalEnabled {
+		return nil, fmt.Errorf("overtime proposals are disabled")
+	}
+
+	loc := s.location()
+
+	// Default to the current month
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	end := now
+	if startDate != nil {
+		parsed, err := time.ParseInLocation("2006-01-02", *startDate, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_date format, expected YYYY-MM-DD")
+		}
+		start = parsed
+	}
+	if endDate != nil {
+		parsed, err := time.ParseInLocation("2006-01-02", *endDate, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_date format, expected YYYY-MM-DD")
+		}
+		end = parsed.Add(24*time.Hour - time.Second)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("end_date must not be before start_date")
+	}
+
+	attendances, err := s.attendanceRepo.GetByUserAndDateRange(ctx, userID, start, end)
+	if err != nil {
+		s.logger.ErrorT("failed to get attendances for proposals", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		})
+		return nil, fmt.Errorf("failed to get attendances: %w", err)
+	}
+
+	overtimes, err := s.overtimeRepo.GetByUserAndDateRange(ctx, userID, start, end)
+	if err != nil {
+		s.logger.ErrorT("failed to get overtimes for proposals", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		})
+		return nil, fmt.Errorf("failed to get overtimes: %w", err)
+	}
+
+	// Days with declared overtime are not proposed again
+	declared := map[string]bool{}
+	for _, o := range overtimes {
+		declared[o.OvertimesDate.In(loc).Format("2006-01-02")] = true
+	}
+
+	minHours := float64(s.config.Overtime.ProposalMinMinutes) / 60
+	proposals := []overtime.OvertimeProposal{}
+	for _, att := range attendances {
+		date := att.CheckInDate.In(loc).Format("2006-01-02")
+		if declared[date] {
+			continue
+		}
+
+		hours, scheduledEnd, ok := s.actualHours(ctx, att)
+		if !ok || hours <= 0 || hours < minHours {
+			continue
+		}
+
+		proposals = append(proposals, overtime.OvertimeProposal{
+			OvertimesDate: date,
+			AttendanceID:  att.ID,
+			CheckInDate:   att.CheckInDate,
+			CheckOutDate:  att.CheckOutDate,
+			ScheduledEnd:  scheduledEnd,
+			ActualHours:   hours,
+			ProposedHours: math.Min(hours, maxDailyHours),
+		})
+		declared[date] = true
+	}
+
+	s.logger.InfoT("overtime proposals listed successfully", requestID, map[string]interface{}{
+		"user_id":        userID,
+		"proposal_count": len(proposals),
+	})
+
+	return proposals, nil
+}
+
+func (s *OvertimeService) ConfirmProposal(ctx context.Context, req overtime.ConfirmOvertimeProposalRequest, userID uint) (*overtime.OvertimeResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing confirm overtime proposal request", requestID, map[string]interface{}{
+		"user_id":          userID,
+		"overtimes_date":   req.OvertimesDate,
+		"total_hours_time": req.TotalHoursTime,
+	})
+
+	if !s.config.Overtime.ProposalEnabled {
+		return nil, fmt.Errorf("overtime proposals are disabled")
+	}
+
+	overtimeDate := req.OvertimesDate.Time
+	attendanceRecord, err := s.attendanceRepo.GetByUserAndDate(ctx, userID, overtimeDate)
+	if err != nil {
+		if err.Error() == "record not found" {
+			return nil, fmt.Errorf("no overtime proposal for %s", overtimeDate.Format("2006-01-02"))
+		}
+		return nil, fmt.Errorf("failed to check attendance: %w", err)
+	}
+
+	hours, _, ok := s.actualHours(ctx, *attendanceRecord)
+	if !ok || hours <= 0 || hours < float64(s.config.Overtime.ProposalMinMinutes)/60 {
+		return nil, fmt.Errorf("no overtime proposal for %s", overtimeDate.Format("2006-01-02"))
+	}
+
+	totalHours, err := s.overtimeRepo.GetTotalHoursByUserAndDate(ctx, userID, overtimeDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check total hours: %w", err)
+	}
+	if totalHours > 0 {
+		return nil, fmt.Errorf("overtime for %s is already declared", overtimeDate.Format("2006-01-02"))
+	}
+
+	// Accept the proposed hours unless the employee adjusted them
+	proposedHours := math.Min(hours, maxDailyHours)
+	if req.TotalHoursTime != nil {
+		proposedHours = *req.TotalHoursTime
+	}
+
+	return s.create(ctx, overtime.CreateOvertimeRequest{
+		OvertimesDate:  req.OvertimesDate,
+		TotalHoursTime: proposedHours,
+	}, userID, constant.OvertimeSourceProposal)
+}
+
+func (s *OvertimeService) ListAll(ctx context.Context, req overtime.ListAllOvertimesRequest) (*overtime.ListOvertimesResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default values if not provided
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	s.logger.InfoT("processing list all overtimes request", requestID, map[string]interface{}{
+		"page":       req.Page,
+		"limit":      req.Limit,
+		"user_id":    req.UserID,
+		"start_date": req.StartDate,
+		"end_date":   req.EndDate,
+		"status":     req.Status,
+		"gap_only":   req.GapOnly,
+	})
+
+	response, err := s.overtimeRepo.ListAll(ctx, req)
+	if err != nil {
+		s.logger.ErrorT("failed to list all overtimes", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list overtimes: %w", err)
+	}
+
+	return response, nil
+}
+
+func (s *OvertimeService) Approve(ctx context.Context, id, reviewerID uint, req overtime.ReviewOvertimeRequest) (*overtime.OvertimeResponse, error) {
+	return s.review(ctx, id, reviewerID, req, constant.ApprovalStatusApproved)
+}
+
+func (s *OvertimeService) Reject(ctx context.Context, id, reviewerID uint, req overtime.ReviewOvertimeRequest) (*overtime.OvertimeResponse, error) {
+	if strings.TrimSpace(req.Comment) == "" {
+		return nil, errors.New("comment is required when rejecting an overtime")
+	}
+	return s.review(ctx, id, reviewerID, req, constant.ApprovalStatusRejected)
+}
+
+func (s *OvertimeService) review(ctx context.Context, id, reviewerID uint, req overtime.ReviewOvertimeRequest, status string) (*overtime.OvertimeResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing review overtime request", requestID, map[string]interface{}{
+		"overtime_id": id,
+		"reviewer_id": reviewerID,
+		"status":      status,
+	})
+
+	o, err := s.overtimeRepo.GetByID(ctx, id)
+	if err != nil || (req.UserIDs != nil && !slices.Contains(req.UserIDs, o.UserID)) {
+		return nil, errors.New("overtime not found")
+	}
+	if o.UserID == reviewerID {
+		return nil, ErrSelfReview
+	}
+	if o.Status != constant.ApprovalStatusPending {
+		return nil, fmt.Errorf("overtime is already %s", o.Status)
+	}
+
+	now := time.Now()
+	var comment *string
+	if trimmed := strings.TrimSpace(req.Comment); trimmed != "" {
+		comment = &trimmed
+	}
+	o.Status = status
+	o.ReviewedBy = &reviewerID
+	o.ReviewedAt = &now
+	o.ReviewComment = comment
+	o.UpdatedBy = &reviewerID
+	o.UpdatedAt = &now
+
+	// Review only updates a pending overtime, a concurrent reviewer gets an error instead of overwriting the decision
+	if err := s.overtimeRepo.Review(ctx, id, map[string]interface{}{
+		"status":         o.Status,
+		"reviewed_by":    o.ReviewedBy,
+		"reviewed_at":    o.ReviewedAt,
+		"review_comment": o.ReviewComment,
+		"updated_by":     o.UpdatedBy,
+		"updated_at":     o.UpdatedAt,
+	}); err != nil {
+		s.logger.ErrorT("failed to review overtime", requestID, map[string]interface{}{
+			"error":       err.Error(),
+			"overtime_id": id,
+		})
+		return nil, fmt.Errorf("failed to review overtime: %w", err)
+	}
+
+	s.logger.InfoT("overtime reviewed", requestID, map[string]interface{}{
+		"overtime_id": id,
+		"reviewer_id": reviewerID,
+		"status":      status,
+	})
+
+	notificationType, title := constant.NotificationTypeOvertimeApproved, "Overtime approved"
+	if status == constant.ApprovalStatusRejected {
+		notificationType, title = constant.NotificationTypeOvertimeRejected, "Overtime rejected"
+	}
+	message := fmt.Sprintf("Your overtime of %.2f hours on %s was %s.", o.TotalHoursTime, o.OvertimesDate.Format("2006-01-02"), status)
+	if comment != nil {
+		message = fmt.Sprintf("%s Comment: %s", message, *comment)
+	}
+	if err := s.notification.Notify(ctx, o.UserID, notificationType, title, message); err != nil {
+		s.logger.WarningT("failed to notify overtime review", requestID, map[string]interface{}{
+			"error":       err.Error(),
+			"overtime_id": id,
+		})
+	}
+
+	response := s.toResponse(*o)
+	return &response, nil
+}
+
+// checkCaps enforces the rolling weekly and monthly caps on every window that contains the date.
+// excludeID skips the overtime being updated.
+func (s *OvertimeService) checkCaps(ctx context.Context, userID uint, date time.Time, hours float64, excludeID uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	day := startOfDay(date)
+
+	for _, window := range s.capWindows() {
+		if window.cap <= 0 {
+			continue
+		}
+
+		hoursByDay, err := s.hoursByDay(ctx, userID, day.AddDate(0, 0, -(window.days-1)), day.AddDate(0, 0, window.days-1), excludeID)
+		if err != nil {
+			return fmt.Errorf("failed to check %s overtime cap: %w", window.name, err)
+		}
+
+		// Find the busiest window containing the date
+		var used float64
+		var windowStart time.Time
+		for i := window.days - 1; i >= 0; i-- {
+			start := day.AddDate(0, 0, -i)
+			total := 0.0
+			for d := 0; d < window.days; d++ {
+				total += hoursByDay[start.AddDate(0, 0, d).Format("2006-01-02")]
+			}
+			if windowStart.IsZero() || total > used {
+				used, windowStart = total, start
+			}
+		}
+
+		if used+hours > window.cap {
+			windowEnd := windowStart.AddDate(0, 0, window.days-1)
+			s.logger.WarningT("overtime cap exceeded", requestID, map[string]interface{}{
+				"user_id":         userID,
+				"cap":             window.name,
+				"max_allowed":     window.cap,
+				"window_start":    windowStart.Format("2006-01-02"),
+				"window_end":      windowEnd.Format("2006-01-02"),
+				"current_total":   used,
+				"requested_hours": hours,
+			})
+			return fmt.Errorf("%s overtime cap of %.2f hours would be exceeded between %s and %s (current: %.2f, requested: %.2f, total: %.2f, remaining: %.2f)",
+				window.name, window.cap, windowStart.Format("2006-01-02"), windowEnd.Format("2006-01-02"),
+				used, hours, used+hours, math.Max(window.cap-used, 0))
+		}
+	}
+
+	return nil
+}
+
+// allowance reports the overtime left in the rolling windows ending on the given day
+func (s *OvertimeService) allowance(ctx context.Context, userID uint, now time.Time) (*overtime.OvertimeAllowance, error) {
+	today := startOfDay(now)
+	result := &overtime.OvertimeAllowance{}
+
+	for _, window := range s.capWindows() {
+		hoursByDay, err := s.hoursByDay(ctx, userID, today.AddDate(0, 0, -(window.days-1)), today, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		used := 0.0
+		for _, hours := range hoursByDay {
+			used += hours
+		}
+		used = math.Round(used*100) / 100
+
+		var capHours, remaining *float64
+		if window.cap > 0 {
+			c, r := window.cap, math.Max(window.cap-used, 0)
+			capHours, remaining = &c, &r
+		}
+
+		if window.days == weeklyCapDays {
+			result.WeeklyCap, result.WeeklyUsed, result.WeeklyRemaining = capHours, used, remaining
+		} else {
+			result.MonthlyCap, result.MonthlyUsed, result.MonthlyRemaining = capHours, used, remaining
+		}
+	}
+
+	return result, nil
+}
+
+func (s *OvertimeService) capWindows() []capWindow {
+	return []capWindow{
+		{name: "weekly", days: weeklyCapDays, cap: s.config.Overtime.WeeklyCapHours},
+		{name: "monthly", days: monthlyCapDays, cap: s.config.Overtime.MonthlyCapHours},
+	}
+}
+
+// hoursByDay sums overtime hours per day between start and end, rejected overtime does not count
+func (s *OvertimeService) hoursByDay(ctx context.Context, userID uint, start, end time.Time, excludeID uint) (map[string]float64, error) {
+	overtimes, err := s.overtimeRepo.GetByUserAndDateRange(ctx, userID, start, end.Add(24*time.Hour-time.Second))
+	if err != nil {
+		return nil, err
+	}
+
+	hours := map[string]float64{}
+	for _, o := range overtimes {
+		if o.ID == excludeID || o.Status == constant.ApprovalStatusRejected {
+			continue
+		}
+		hours[o.OvertimesDate.In(start.Location()).Format("2006-01-02")] += o.TotalHoursTime
+	}
+	return hours, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// actualHours measures the time worked beyond the scheduled end of day, a weekend counts fully.
+// It is not measurable while the attendance is still open.
+func (s *OvertimeService) actualHours(ctx context.Context, att attendance.Attendance) (float64, *time.Time, bool) {
+	if att.CheckOutDate == nil {
+		return 0, nil, false
+	}
+
+	loc := s.location()
+	checkIn := att.CheckInDate.In(loc)
+	start := checkIn
+
+	var scheduledEnd *time.Time
+	if attendance.IsWeekday(checkIn) {
+		hour, minute, err := attendance.ParseClock(s.config.Attendance.WorkEndTime)
+		if err != nil {
+			return 0, nil, false
+		}
+		if u, err := s.userRepo.GetUserByID(ctx, att.UserID); err == nil && u.WorkEndTime != nil {
+			if h, m, err := attendance.ParseClock(*u.WorkEndTime); err == nil {
+				hour, minute = h, m
+			}
+		}
+
+		end := time.Date(checkIn.Year(), checkIn.Month(), checkIn.Day(), hour, minute, 0, 0, loc)
+		scheduledEnd = &end
+		if end.After(start) {
+			start = end
+		}
+	}
+
+	hours := att.CheckOutDate.Sub(start).Hours()
+	if hours < 0 {
+		hours = 0
+	}
+	return math.Round(hours*100) / 100, scheduledEnd, true
+}
+
+func (s *OvertimeService) location() *time.Location {
+	loc, err := time.LoadLocation(s.config.PostgressDB.DBTimeZone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
 // Helper function to convert Overtime to OvertimeResponse
 func (s *OvertimeService) toResponse(o overtime.Overtime) overtime.OvertimeResponse {
 	return overtime.OvertimeResponse{
@@ -433,6 +949,12 @@ func (s *OvertimeService) toResponse(o overtime.Overtime) overtime.OvertimeRespo
 		UserID:         o.UserID,
 		OvertimesDate:  o.OvertimesDate,
 		TotalHoursTime: o.TotalHoursTime,
+		ActualHours:    o.ActualHours,
+		Source:         o.Source,
+		Status:         o.Status,
+		ReviewedBy:     o.ReviewedBy,
+		ReviewedAt:     o.ReviewedAt,
+		ReviewComment:  o.ReviewComment,
 		CreatedBy:      o.CreatedBy,
 		CreatedAt:      o.CreatedAt,
 		UpdatedBy:      o.UpdatedBy,
@@ -7,6 +7,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,9 +15,13 @@ import (
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
 	"github.com/riskykurniawan15/payrolls/models/payslip"
 	periodDetailRepo "github.com/riskykurniawan15/payrolls/repositories/period_detail"
+	terminationRepo "github.com/riskykurniawan15/payrolls/repositories/termination"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
 )
 
+// ErrInvalidToken is returned when a settlement payslip token cannot be decrypted or has expired
+var ErrInvalidToken = errors.New("invalid or expired token")
+
 type (
 	IPayslipService interface {
 		List(ctx context.Context, req payslip.PayslipListRequest, userID uint) (*payslip.PayslipListResponse, error)
@@ -24,11 +29,14 @@ type (
 		GetPayslipData(ctx context.Context, token string) (*payslip.PayslipData, error)
 		GeneratePayslipSummary(ctx context.Context, periodID uint, host string) (*payslip.GeneratePayslipResponse, error)
 		GetPayslipSummaryData(ctx context.Context, token string) (*payslip.PayslipSummaryData, error)
+		GenerateSettlementPayslip(ctx context.Context, terminationID uint, host string) (*payslip.GeneratePayslipResponse, error)
+		GetSettlementPayslipData(ctx context.Context, token string) (*payslip.SettlementPayslipData, error)
 	}
 
 	PayslipService struct {
 		logger           logger.Logger
 		periodDetailRepo periodDetailRepo.IPeriodDetailRepository
+		terminationRepo  terminationRepo.ITerminationRepository
 		config           config.Config
 	}
 
@@ -44,16 +52,24 @@ type (
 		PeriodID  uint      `json:"period_id"`
 		ExpiresAt time.Time `json:"expires_at"`
 	}
+
+	// SettlementTokenData for encrypted final settlement token
+	SettlementTokenData struct {
+		TerminationID uint      `json:"termination_id"`
+		ExpiresAt     time.Time `json:"expires_at"`
+	}
 )
 
 func NewPayslipService(
 	logger logger.Logger,
 	periodDetailRepo periodDetailRepo.IPeriodDetailRepository,
+	terminationRepo terminationRepo.ITerminationRepository,
 	config config.Config,
 ) IPayslipService {
 	return &PayslipService{
 		logger:           logger,
 		periodDetailRepo: periodDetailRepo,
+		terminationRepo:  terminationRepo,
 		config:           config,
 	}
 }
@@ -254,6 +270,89 @@ func (s *PayslipService) GetPayslipSummaryData(ctx context.Context, token string
 	return payslipSummaryData, nil
 }
 
+func (s *PayslipService) GenerateSettlementPayslip(ctx context.Context, terminationID uint, host string) (*payslip.GeneratePayslipResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing generate settlement payslip request", requestID, map[string]interface{}{
+		"termination_id": terminationID,
+	})
+
+	// Verify that the termination is settled
+	_, err := s.terminationRepo.GetSettlementPayslipData(ctx, terminationID)
+	if err != nil {
+		s.logger.ErrorT("failed to get settlement payslip data", requestID, map[string]interface{}{
+			"error":          err.Error(),
+			"termination_id": terminationID,
+		})
+		return nil, fmt.Errorf("failed to get settlement payslip data: %w", err)
+	}
+
+	// Generate token
+	token, expiresAt, err := s.generateSettlementToken(terminationID)
+	if err != nil {
+		s.logger.ErrorT("failed to generate settlement token", requestID, map[string]interface{}{
+			"error":          err.Error(),
+			"termination_id": terminationID,
+		})
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	// Generate print URL
+	printURL := fmt.Sprintf("%s/payslip/settlement/print?token=%s", host, token)
+
+	s.logger.InfoT("settlement payslip generated successfully", requestID, map[string]interface{}{
+		"termination_id": terminationID,
+		"expires_at":     expiresAt,
+	})
+
+	return &payslip.GeneratePayslipResponse{
+		PrintURL:  printURL,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (s *PayslipService) GetSettlementPayslipData(ctx context.Context, token string) (*payslip.SettlementPayslipData, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing get settlement payslip data request", requestID, map[string]interface{}{
+		"token": token,
+	})
+
+	// Decrypt and validate token
+	tokenData, err := s.decryptSettlementToken(token)
+	if err != nil {
+		s.logger.ErrorT("failed to decrypt settlement token", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, ErrInvalidToken
+	}
+
+	// Check if token is expired
+	if time.Now().After(tokenData.ExpiresAt) {
+		s.logger.WarningT("settlement token expired", requestID, map[string]interface{}{
+			"expires_at": tokenData.ExpiresAt,
+		})
+		return nil, ErrInvalidToken
+	}
+
+	// Get settlement payslip data
+	settlementData, err := s.terminationRepo.GetSettlementPayslipData(ctx, tokenData.TerminationID)
+	if err != nil {
+		s.logger.ErrorT("failed to get settlement payslip data", requestID, map[string]interface{}{
+			"error":          err.Error(),
+			"termination_id": tokenData.TerminationID,
+		})
+		return nil, fmt.Errorf("failed to get settlement payslip data: %w", err)
+	}
+
+	s.logger.InfoT("settlement payslip data retrieved successfully", requestID, map[string]interface{}{
+		"termination_id": tokenData.TerminationID,
+	})
+
+	settlementData.CompanyName = s.config.CompanyName
+
+	return settlementData, nil
+}
+
 func (s *PayslipService) generateToken(periodDetailID, userID uint) (string, time.Time, error) {
 	// Set expiration time (5 minutes from now)
 	expiresAt := time.Now().Add(5 * time.Minute)
@@ -355,6 +454,56 @@ func (s *PayslipService) decryptSummaryToken(token string) (*SummaryTokenData, e
 	return &tokenData, nil
 }
 
+func (s *PayslipService) generateSettlementToken(terminationID uint) (string, time.Time, error) {
+	// Set expiration time (5 minutes from now)
+	expiresAt := time.Now().Add(5 * time.Minute)
+
+	// Create token data
+	tokenData := SettlementTokenData{
+		TerminationID: terminationID,
+		ExpiresAt:     expiresAt,
+	}
+
+	// Convert to JSON
+	jsonData, err := json.Marshal(tokenData)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to marshal token data: %w", err)
+	}
+
+	// Encrypt the data
+	encryptedData, err := s.encrypt(jsonData)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to encrypt token: %w", err)
+	}
+
+	// Encode to base64
+	token := base64.URLEncoding.EncodeToString(encryptedData)
+
+	return token, expiresAt, nil
+}
+
+func (s *PayslipService) decryptSettlementToken(token string) (*SettlementTokenData, error) {
+	// Decode from base64
+	encryptedData, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token format: %w", err)
+	}
+
+	// Decrypt the data
+	decryptedData, err := s.decrypt(encryptedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token: %w", err)
+	}
+
+	// Parse JSON, a token issued for another kind of payslip has no termination
+	var tokenData SettlementTokenData
+	if err := json.Unmarshal(decryptedData, &tokenData); err != nil || tokenData.TerminationID == 0 {
+		return nil, fmt.Errorf("invalid token data")
+	}
+
+	return &tokenData, nil
+}
+
 func (s *PayslipService) encrypt(data []byte) ([]byte, error) {
 	// Use JWT secret as encryption key (pad to 32 bytes for AES-256)
 	key := make([]byte, 32)
@@ -192,10 +192,25 @@ func (s *PeriodDetailService) processPayrollBackground(c context.Context, period
 	lastID := uint(0)
 	batchSize := 50
 
+	// Claims and overtime paid by a previous run of this period become payable again
+	if err := s.reimbursementRepo.ResetPaidByPeriodID(ctx, periodID); err != nil {
+		s.logger.ErrorT("failed to reset paid reimbursements", requestID, map[string]interface{}{
+			"error":     err.Error(),
+			"period_id": periodID,
+		})
+		return
+	}
+	if err := s.overtimeRepo.ResetPaidByPeriodID(ctx, periodID); err != nil {
+		s.logger.ErrorT("failed to reset paid overtimes", requestID, map[string]interface{}{
+			"error":     err.Error(),
+			"period_id": periodID,
+		})
+		return
+	}
 	s.periodDetailRepo.DeleteByPeriodID(ctx, periodID)
 
 	for {
-		userIDs, err := s.periodDetailRepo.GetUsersByBatch(ctx, lastID, batchSize)
+		userIDs, err := s.periodDetailRepo.GetUsersByBatch(ctx, lastID, batchSize, endDate)
 		if err != nil {
 			s.logger.ErrorT("failed to get users batch", requestID, map[string]interface{}{
 				"error":      err.Error(),
@@ -212,11 +227,12 @@ func (s *PeriodDetailService) processPayrollBackground(c context.Context, period
 		// Process batch
 		err = s.processUserBatch(ctx, periodID, userIDs, startDate, endDate, userExecutablePayroll, requestID)
 		if err != nil {
+			// The run is rolled back instead of retried, a claim that could not be marked paid must not be paid either
 			s.logger.ErrorT("failed to process user batch", requestID, map[string]interface{}{
 				"error":    err.Error(),
 				"user_ids": userIDs,
 			})
-			continue
+			return
 		}
 
 		lastID = userIDs[len(userIDs)-1]
@@ -232,6 +248,8 @@ func (s *PeriodDetailService) processPayrollBackground(c context.Context, period
 func (s *PeriodDetailService) processUserBatch(ctx context.Context, periodID uint, userIDs []uint, startDate, endDate time.Time, userExecutablePayroll uint, requestID string) error {
 	// Use database transaction
 	var periodDetails []period_detail.PeriodDetail
+	var reimbursementIDs [][]uint
+	var overtimeIDs [][]uint
 
 	for _, userID := range userIDs {
 		payrollData, err := s.calculatePayroll(ctx, userID, startDate, endDate, requestID)
@@ -279,6 +297,18 @@ func (s *PeriodDetailService) processUserBatch(ctx context.Context, periodID uin
 		}
 
 		periodDetails = append(periodDetails, periodDetail)
+
+		var ids []uint
+		for _, reimb := range payrollData.Reimbursement {
+			ids = append(ids, reimb.ID)
+		}
+		reimbursementIDs = append(reimbursementIDs, ids)
+
+		ids = nil
+		for _, ot := range payrollData.Overtime {
+			ids = append(ids, ot.ID)
+		}
+		overtimeIDs = append(overtimeIDs, ids)
 	}
 
 	// Create batch records
@@ -289,6 +319,17 @@ func (s *PeriodDetailService) processUserBatch(ctx context.Context, periodID uin
 		}
 	}
 
+	// Mark the included claims and overtime paid so they are not paid again
+	paidAt := time.Now()
+	for i, periodDetail := range periodDetails {
+		if err := s.reimbursementRepo.MarkPaid(ctx, reimbursementIDs[i], periodDetail.ID, paidAt); err != nil {
+			return fmt.Errorf("failed to mark reimbursements paid: %w", err)
+		}
+		if err := s.overtimeRepo.MarkPaid(ctx, overtimeIDs[i], periodDetail.ID, paidAt); err != nil {
+			return fmt.Errorf("failed to mark overtimes paid: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -366,8 +407,8 @@ func (s *PeriodDetailService) calculatePayroll(ctx context.Context, userID uint,
 }
 
 func (s *PeriodDetailService) calculateOvertime(ctx context.Context, userID uint, startDate, endDate time.Time, dailyRate float64, requestID string) ([]OvertimeData, float64, error) {
-	// Get overtime records for the period
-	overtimes, err := s.overtimeRepo.GetByUserAndDateRange(ctx, userID, startDate, endDate)
+	// Get approved overtime not paid yet, including late approvals from earlier periods
+	overtimes, err := s.overtimeRepo.GetPayableByUser(ctx, userID, endDate)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get overtime data: %w", err)
 	}
@@ -395,8 +436,8 @@ func (s *PeriodDetailService) calculateOvertime(ctx context.Context, userID uint
 }
 
 func (s *PeriodDetailService) calculateReimbursement(ctx context.Context, userID uint, startDate, endDate time.Time, requestID string) ([]ReimbursementData, float64, error) {
-	// Get reimbursement records for the period
-	reimbursements, err := s.reimbursementRepo.GetByUserAndDateRange(ctx, userID, startDate, endDate)
+	// Get approved reimbursements not paid yet, including late approvals from earlier periods
+	reimbursements, err := s.reimbursementRepo.GetPayableByUser(ctx, userID, endDate)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get reimbursement data: %w", err)
 	}
@@ -405,13 +446,18 @@ func (s *PeriodDetailService) calculateReimbursement(ctx context.Context, userID
 	totalAmount := float64(0)
 
 	for _, reimb := range reimbursements {
-		totalAmount += reimb.Amount
+		// Only the approved amount is paid
+		amount := reimb.Amount
+		if reimb.ApprovedAmount != nil {
+			amount = *reimb.ApprovedAmount
+		}
+		totalAmount += amount
 
 		reimbursementData = append(reimbursementData, ReimbursementData{
 			ID:     reimb.ID,
 			Title:  reimb.Title,
 			Date:   reimb.Date.Format("2006-01-02"),
-			Amount: reimb.Amount,
+			Amount: amount,
 		})
 	}
 
@@ -2,15 +2,45 @@ package reimbursement
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
 	"github.com/riskykurniawan15/payrolls/models/reimbursement"
+	"github.com/riskykurniawan15/payrolls/models/user"
 	reimbursementRepo "github.com/riskykurniawan15/payrolls/repositories/reimbursement"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	notificationService "github.com/riskykurniawan15/payrolls/services/notification"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/similarity"
+	"github.com/riskykurniawan15/payrolls/utils/storage"
 )
 
+// maxAttachments is the number of receipts a single reimbursement can hold
+const maxAttachments = 5
+
+// ErrSelfReview is returned when a reviewer tries to approve or reject their own reimbursement
+var ErrSelfReview = errors.New("cannot review your own reimbursement")
+
+// receiptExtensions lists the accepted receipt types, detected from the file content
+var receiptExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
 type (
 	IReimbursementService interface {
 		Create(ctx context.Context, req reimbursement.CreateReimbursementRequest, userID uint) (*reimbursement.ReimbursementResponse, error)
@@ -18,18 +48,40 @@ type (
 		Update(ctx context.Context, id uint, req reimbursement.UpdateReimbursementRequest, userID uint) (*reimbursement.ReimbursementResponse, error)
 		Delete(ctx context.Context, id uint, userID uint) error
 		List(ctx context.Context, req reimbursement.ListReimbursementsRequest, userID uint) (*reimbursement.ListReimbursementsResponse, error)
+		UploadAttachment(ctx context.Context, reimbursementID, userID uint, req reimbursement.UploadAttachmentRequest) (*reimbursement.ReimbursementAttachment, error)
+		ListAttachments(ctx context.Context, reimbursementID, userID uint) ([]reimbursement.ReimbursementAttachment, error)
+		DownloadAttachment(ctx context.Context, reimbursementID, attachmentID, userID uint) (*reimbursement.AttachmentFile, error)
+		DeleteAttachment(ctx context.Context, reimbursementID, attachmentID, userID uint) error
+		ListAll(ctx context.Context, req reimbursement.ListAllReimbursementsRequest) (*reimbursement.ListReimbursementsResponse, error)
+		GetForReview(ctx context.Context, id uint, userIDs []uint) (*reimbursement.ReimbursementResponse, error)
+		DownloadReviewAttachment(ctx context.Context, reimbursementID, attachmentID uint, userIDs []uint) (*reimbursement.AttachmentFile, error)
+		Approve(ctx context.Context, id, reviewerID uint, req reimbursement.ReviewReimbursementRequest) (*reimbursement.ReimbursementResponse, error)
+		Reject(ctx context.Context, id, reviewerID uint, req reimbursement.ReviewReimbursementRequest) (*reimbursement.ReimbursementResponse, error)
+		CreateCategory(ctx context.Context, req reimbursement.CreateCategoryRequest, createdBy uint) (*reimbursement.CategoryResponse, error)
+		UpdateCategory(ctx context.Context, id uint, req reimbursement.UpdateCategoryRequest, updatedBy uint) (*reimbursement.CategoryResponse, error)
+		ListCategories(ctx context.Context) ([]reimbursement.CategoryResponse, error)
+		GetBalance(ctx context.Context, userID uint) ([]reimbursement.CategoryBalance, error)
+		ListDuplicates(ctx context.Context, req reimbursement.DuplicateReportRequest) (*reimbursement.DuplicateReportResponse, error)
 	}
 
 	ReimbursementService struct {
 		logger            logger.Logger
+		config            config.Config
 		reimbursementRepo reimbursementRepo.IReimbursementRepository
+		userRepo          userRepo.IUserRepository
+		storage           storage.Storage
+		notification      notificationService.INotificationService
 	}
 )
 
-func NewReimbursementService(logger logger.Logger, reimbursementRepo reimbursementRepo.IReimbursementRepository) IReimbursementService {
+func NewReimbursementService(logger logger.Logger, config config.Config, reimbursementRepo reimbursementRepo.IReimbursementRepository, userRepo userRepo.IUserRepository, storage storage.Storage, notification notificationService.INotificationService) IReimbursementService {
 	return &ReimbursementService{
 		logger:            logger,
+		config:            config,
 		reimbursementRepo: reimbursementRepo,
+		userRepo:          userRepo,
+		storage:           storage,
+		notification:      notification,
 	}
 }
 
@@ -61,6 +113,17 @@ func (s *ReimbursementService) Create(ctx context.Context, req reimbursement.Cre
 		return nil, fmt.Errorf("reimbursement date cannot be in the future")
 	}
 
+	// Validate the claim against the category policy
+	if err := s.checkPolicy(ctx, userID, req.CategoryID, reimbursementDate, req.Amount, 0); err != nil {
+		s.logger.WarningT("reimbursement rejected by category policy", requestID, map[string]interface{}{
+			"user_id":     userID,
+			"category_id": req.CategoryID,
+			"amount":      req.Amount,
+			"error":       err.Error(),
+		})
+		return nil, err
+	}
+
 	s.logger.InfoT("reimbursement validation passed", requestID, map[string]interface{}{
 		"user_id": userID,
 		"title":   req.Title,
@@ -71,10 +134,12 @@ func (s *ReimbursementService) Create(ctx context.Context, req reimbursement.Cre
 	// Create reimbursement
 	reimbursementRecord := &reimbursement.Reimbursement{
 		UserID:      userID,
+		CategoryID:  &req.CategoryID,
 		Title:       req.Title,
 		Date:        reimbursementDate,
 		Amount:      req.Amount,
 		Description: req.Description,
+		Status:      constant.ReimbursementStatusSubmitted,
 		CreatedBy:   userID,
 		CreatedAt:   time.Now(),
 		UpdatedBy:   nil,
@@ -106,6 +171,9 @@ func (s *ReimbursementService) Create(ctx context.Context, req reimbursement.Cre
 		"amount":           req.Amount,
 	})
 
+	// Suspected duplicates are flagged for reviewers, not rejected
+	s.flagSimilarClaims(ctx, reimbursementRecord)
+
 	// Convert to response
 	response := s.toResponse(*reimbursementRecord)
 	return &response, nil
@@ -145,7 +213,13 @@ func (s *ReimbursementService) GetByID(ctx context.Context, id uint, userID uint
 		"amount":           reimb.Amount,
 	})
 
+	attachments, err := s.reimbursementRepo.GetAttachmentsByReimbursementID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
 	response := s.toResponse(*reimb)
+	response.Attachments = attachments
 	return &response, nil
 }
 
@@ -176,6 +250,11 @@ func (s *ReimbursementService) Update(ctx context.Context, id uint, req reimburs
 		return nil, fmt.Errorf("reimbursement not found")
 	}
 
+	// Only claims waiting for review can be changed
+	if existingReimbursement.Status != constant.ReimbursementStatusSubmitted {
+		return nil, fmt.Errorf("reimbursement is already %s", existingReimbursement.Status)
+	}
+
 	s.logger.InfoT("reimbursement validation passed", requestID, map[string]interface{}{
 		"reimbursement_id": id,
 		"user_id":          existingReimbursement.UserID,
@@ -211,6 +290,28 @@ func (s *ReimbursementService) Update(ctx context.Context, id uint, req reimburs
 		updates["amount"] = *req.Amount
 	}
 
+	// Update category if provided
+	if req.CategoryID != nil {
+		updates["category_id"] = *req.CategoryID
+	}
+
+	// Revalidate the policy with the new values, claims filed before categories have none
+	categoryID, date, amount := existingReimbursement.CategoryID, existingReimbursement.Date, existingReimbursement.Amount
+	if req.CategoryID != nil {
+		categoryID = req.CategoryID
+	}
+	if req.Date != nil {
+		date = req.Date.Time
+	}
+	if req.Amount != nil {
+		amount = *req.Amount
+	}
+	if categoryID != nil {
+		if err := s.checkPolicy(ctx, userID, *categoryID, date, amount, id); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update description if provided
 	if req.Description != nil {
 		updates["description"] = *req.Description
@@ -258,6 +359,11 @@ func (s *ReimbursementService) Delete(ctx context.Context, id uint, userID uint)
 		return fmt.Errorf("reimbursement not found")
 	}
 
+	// Reviewed claims are kept for the payroll record, only rejected ones can be withdrawn
+	if existingReimbursement.Status != constant.ReimbursementStatusSubmitted && existingReimbursement.Status != constant.ReimbursementStatusRejected {
+		return fmt.Errorf("reimbursement is already %s", existingReimbursement.Status)
+	}
+
 	s.logger.InfoT("reimbursement validation passed for delete", requestID, map[string]interface{}{
 		"reimbursement_id": id,
 		"user_id":          existingReimbursement.UserID,
@@ -266,6 +372,12 @@ func (s *ReimbursementService) Delete(ctx context.Context, id uint, userID uint)
 		"amount":           existingReimbursement.Amount,
 	})
 
+	// Receipts are removed from storage once the reimbursement is gone
+	attachments, err := s.reimbursementRepo.GetAttachmentsByReimbursementID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get attachments: %w", err)
+	}
+
 	// Delete reimbursement
 	if err := s.reimbursementRepo.Delete(ctx, id); err != nil {
 		s.logger.ErrorT("failed to delete reimbursement", requestID, map[string]interface{}{
@@ -283,6 +395,10 @@ func (s *ReimbursementService) Delete(ctx context.Context, id uint, userID uint)
 		"amount":           existingReimbursement.Amount,
 	})
 
+	for _, attachment := range attachments {
+		s.removeFile(ctx, attachment)
+	}
+
 	return nil
 }
 
@@ -337,18 +453,818 @@ func (s *ReimbursementService) List(ctx context.Context, req reimbursement.ListR
 	return response, nil
 }
 
+func (s *ReimbursementService) UploadAttachment(ctx context.Context, reimbursementID, userID uint, req reimbursement.UploadAttachmentRequest) (*reimbursement.ReimbursementAttachment, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing upload reimbursement attachment request", requestID, map[string]interface{}{
+		"reimbursement_id": reimbursementID,
+		"user_id":          userID,
+		"file_name":        req.FileName,
+		"file_size":        len(req.Content),
+	})
+
+	reimb, err := s.getOwned(ctx, reimbursementID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if reimb.Status != constant.ReimbursementStatusSubmitted {
+		return nil, fmt.Errorf("reimbursement is already %s", reimb.Status)
+	}
+
+	// Validate the file
+	if len(req.Content) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	if len(req.Content) > constant.ReceiptMaxSize {
+		return nil, fmt.Errorf("file exceeds the maximum size of %d MB", constant.ReceiptMaxSize>>20)
+	}
+	contentType := http.DetectContentType(req.Content)
+	extension, ok := receiptExtensions[contentType]
+	if !ok {
+		s.logger.WarningT("unsupported receipt type", requestID, map[string]interface{}{
+			"reimbursement_id": reimbursementID,
+			"content_type":     contentType,
+		})
+		return nil, fmt.Errorf("unsupported file type %s, only JPEG, PNG, WEBP and PDF receipts are accepted", contentType)
+	}
+
+	existing, err := s.reimbursementRepo.GetAttachmentsByReimbursementID(ctx, reimbursementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+	if len(existing) >= maxAttachments {
+		return nil, fmt.Errorf("a reimbursement can have at most %d attachments", maxAttachments)
+	}
+
+	fileName := strings.TrimSpace(filepath.Base(strings.ReplaceAll(req.FileName, "\\", "/")))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		fileName = "receipt" + extension
+	}
+	if len(fileName) > 255 {
+		fileName = fileName[len(fileName)-255:]
+	}
+
+	checksum := sha256.Sum256(req.Content)
+	attachment := &reimbursement.ReimbursementAttachment{
+		ReimbursementID: reimbursementID,
+		FileName:        fileName,
+		ContentType:     contentType,
+		Size:            int64(len(req.Content)),
+		StorageKey:      fmt.Sprintf("receipts/%d/%d/%s%s", userID, reimbursementID, uuid.NewString(), extension),
+		Checksum:        hex.EncodeToString(checksum[:]),
+		CreatedBy:       userID,
+		CreatedAt:       time.Now(),
+	}
+
+	if err := s.storage.Put(ctx, attachment.StorageKey, req.Content, contentType); err != nil {
+		s.logger.ErrorT("failed to store receipt", requestID, map[string]interface{}{
+			"error":            err.Error(),
+			"reimbursement_id": reimbursementID,
+		})
+		return nil, fmt.Errorf("failed to store file: %w", err)
+	}
+
+	if err := s.reimbursementRepo.CreateAttachment(ctx, attachment); err != nil {
+		s.logger.ErrorT("failed to create reimbursement attachment", requestID, map[string]interface{}{
+			"error":            err.Error(),
+			"reimbursement_id": reimbursementID,
+		})
+		s.removeFile(ctx, *attachment)
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	s.logger.InfoT("reimbursement attachment uploaded", requestID, map[string]interface{}{
+		"attachment_id":    attachment.ID,
+		"reimbursement_id": reimbursementID,
+		"content_type":     contentType,
+		"size":             attachment.Size,
+	})
+
+	s.flagSameReceipt(ctx, reimbursementID, attachment.Checksum)
+
+	return attachment, nil
+}
+
+func (s *ReimbursementService) ListAttachments(ctx context.Context, reimbursementID, userID uint) ([]reimbursement.ReimbursementAttachment, error) {
+	if _, err := s.getOwned(ctx, reimbursementID, userID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.reimbursementRepo.GetAttachmentsByReimbursementID(ctx, reimbursementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+func (s *ReimbursementService) DownloadAttachment(ctx context.Context, reimbursementID, attachmentID, userID uint) (*reimbursement.AttachmentFile, error) {
+	attachment, err := s.getOwnedAttachment(ctx, reimbursementID, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.readFile(ctx, *attachment)
+}
+
+func (s *ReimbursementService) DeleteAttachment(ctx context.Context, reimbursementID, attachmentID, userID uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	attachment, err := s.getOwnedAttachment(ctx, reimbursementID, attachmentID, userID)
+	if err != nil {
+		return err
+	}
+
+	reimb, err := s.reimbursementRepo.GetByID(ctx, reimbursementID)
+	if err != nil {
+		return errors.New("reimbursement not found")
+	}
+	if reimb.Status != constant.ReimbursementStatusSubmitted {
+		return fmt.Errorf("reimbursement is already %s", reimb.Status)
+	}
+
+	if err := s.reimbursementRepo.DeleteAttachment(ctx, attachmentID); err != nil {
+		s.logger.ErrorT("failed to delete reimbursement attachment", requestID, map[string]interface{}{
+			"error":         err.Error(),
+			"attachment_id": attachmentID,
+		})
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	s.removeFile(ctx, *attachment)
+
+	s.logger.InfoT("reimbursement attachment deleted", requestID, map[string]interface{}{
+		"attachment_id":    attachmentID,
+		"reimbursement_id": reimbursementID,
+	})
+
+	return nil
+}
+
+func (s *ReimbursementService) ListAll(ctx context.Context, req reimbursement.ListAllReimbursementsRequest) (*reimbursement.ListReimbursementsResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing list all reimbursements request", requestID, map[string]interface{}{
+		"page":    req.Page,
+		"limit":   req.Limit,
+		"user_id": req.UserID,
+		"status":  req.Status,
+	})
+
+	response, err := s.reimbursementRepo.ListAll(ctx, req)
+	if err != nil {
+		s.logger.ErrorT("failed to list all reimbursements", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list reimbursements: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetForReview returns a claim with its attachments, userIDs limits a team review to those users
+func (s *ReimbursementService) GetForReview(ctx context.Context, id uint, userIDs []uint) (*reimbursement.ReimbursementResponse, error) {
+	reimb, err := s.reimbursementRepo.GetByID(ctx, id)
+	if err != nil || !inReviewScope(userIDs, reimb.UserID) {
+		return nil, errors.New("reimbursement not found")
+	}
+
+	attachments, err := s.reimbursementRepo.GetAttachmentsByReimbursementID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	duplicates, err := s.reimbursementRepo.GetDuplicatesByReimbursementID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get duplicates: %w", err)
+	}
+
+	response := s.toResponse(*reimb)
+	response.Attachments = attachments
+	response.Duplicates = duplicates
+	return &response, nil
+}
+
+func (s *ReimbursementService) ListDuplicates(ctx context.Context, req reimbursement.DuplicateReportRequest) (*reimbursement.DuplicateReportResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing list duplicate reimbursements request", requestID, map[string]interface{}{
+		"page":    req.Page,
+		"limit":   req.Limit,
+		"user_id": req.UserID,
+		"reason":  req.Reason,
+	})
+
+	response, err := s.reimbursementRepo.ListDuplicates(ctx, req)
+	if err != nil {
+		s.logger.ErrorT("failed to list duplicate reimbursements", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list duplicates: %w", err)
+	}
+
+	return response, nil
+}
+
+// flagSimilarClaims flags a claim matching an earlier claim of the employee with the same amount,
+// a date within the configured window and a similar title
+func (s *ReimbursementService) flagSimilarClaims(ctx context.Context, reimb *reimbursement.Reimbursement) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	window := s.config.Reimbursement.DuplicateWindowDays
+
+	candidates, err := s.reimbursementRepo.FindSameAmountClaims(ctx, reimb.UserID, reimb.Amount, reimb.Date.AddDate(0, 0, -window), reimb.Date.AddDate(0, 0, window), reimb.ID)
+	if err != nil {
+		s.logger.WarningT("failed to check duplicate reimbursements", requestID, map[string]interface{}{
+			"error":            err.Error(),
+			"reimbursement_id": reimb.ID,
+		})
+		return
+	}
+
+	var duplicates []reimbursement.ReimbursementDuplicate
+	for _, candidate := range candidates {
+		ratio := similarity.Ratio(reimb.Title, candidate.Title)
+		if ratio < s.config.Reimbursement.DuplicateTitleSimilarity {
+			continue
+		}
+		ratio = math.Round(ratio*10000) / 10000
+		duplicates = append(duplicates, reimbursement.ReimbursementDuplicate{
+			ReimbursementID:        reimb.ID,
+			MatchedReimbursementID: candidate.ID,
+			Reason:                 constant.DuplicateReasonSimilarClaim,
+			TitleSimilarity:        &ratio,
+			CreatedAt:              time.Now(),
+		})
+	}
+
+	if s.flagDuplicates(ctx, reimb.ID, duplicates) {
+		reimb.SuspectedDuplicate = true
+	}
+}
+
+// flagSameReceipt flags a claim whose receipt is identical to a file attached to any other claim
+func (s *ReimbursementService) flagSameReceipt(ctx context.Context, reimbursementID uint, checksum string) {
+	matches, err := s.reimbursementRepo.FindAttachmentsByChecksum(ctx, checksum, reimbursementID)
+	if err != nil {
+		s.logger.WarningT("failed to check duplicate receipts", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error":            err.Error(),
+			"reimbursement_id": reimbursementID,
+		})
+		return
+	}
+
+	var duplicates []reimbursement.ReimbursementDuplicate
+	seen := make(map[uint]bool)
+	for _, match := range matches {
+		if seen[match.ReimbursementID] {
+			continue
+		}
+		seen[match.ReimbursementID] = true
+		duplicates = append(duplicates, reimbursement.ReimbursementDuplicate{
+			ReimbursementID:        reimbursementID,
+			MatchedReimbursementID: match.ReimbursementID,
+			Reason:                 constant.DuplicateReasonSameReceipt,
+			CreatedAt:              time.Now(),
+		})
+	}
+
+	s.flagDuplicates(ctx, reimbursementID, duplicates)
+}
+
+// flagDuplicates stores the matches and marks the claim for reviewers, failures are only logged
+func (s *ReimbursementService) flagDuplicates(ctx context.Context, reimbursementID uint, duplicates []reimbursement.ReimbursementDuplicate) bool {
+	if len(duplicates) == 0 {
+		return false
+	}
+
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	if err := s.reimbursementRepo.CreateDuplicates(ctx, duplicates); err != nil {
+		s.logger.WarningT("failed to record duplicate reimbursements", requestID, map[string]interface{}{
+			"error":            err.Error(),
+			"reimbursement_id": reimbursementID,
+		})
+		return false
+	}
+	if err := s.reimbursementRepo.Update(ctx, reimbursementID, map[string]interface{}{"suspected_duplicate": true}); err != nil {
+		s.logger.WarningT("failed to flag duplicate reimbursement", requestID, map[string]interface{}{
+			"error":            err.Error(),
+			"reimbursement_id": reimbursementID,
+		})
+		return false
+	}
+
+	matched := make([]uint, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		matched = append(matched, duplicate.MatchedReimbursementID)
+	}
+	s.logger.WarningT("suspected duplicate reimbursement", requestID, map[string]interface{}{
+		"reimbursement_id": reimbursementID,
+		"matched_ids":      matched,
+		"reason":           duplicates[0].Reason,
+	})
+
+	return true
+}
+
+func (s *ReimbursementService) DownloadReviewAttachment(ctx context.Context, reimbursementID, attachmentID uint, userIDs []uint) (*reimbursement.AttachmentFile, error) {
+	attachment, err := s.reimbursementRepo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil || attachment.ReimbursementID != reimbursementID {
+		return nil, errors.New("attachment not found")
+	}
+	if userIDs != nil {
+		reimb, err := s.reimbursementRepo.GetByID(ctx, reimbursementID)
+		if err != nil || !inReviewScope(userIDs, reimb.UserID) {
+			return nil, errors.New("attachment not found")
+		}
+	}
+	return s.readFile(ctx, *attachment)
+}
+
+func (s *ReimbursementService) Approve(ctx context.Context, id, reviewerID uint, req reimbursement.ReviewReimbursementRequest) (*reimbursement.ReimbursementResponse, error) {
+	return s.review(ctx, id, reviewerID, req, constant.ReimbursementStatusApproved)
+}
+
+func (s *ReimbursementService) Reject(ctx context.Context, id, reviewerID uint, req reimbursement.ReviewReimbursementRequest) (*reimbursement.ReimbursementResponse, error) {
+	if strings.TrimSpace(req.Comment) == "" {
+		return nil, errors.New("comment is required when rejecting a reimbursement")
+	}
+	if req.ApprovedAmount != nil {
+		return nil, errors.New("approved amount cannot be set when rejecting a reimbursement")
+	}
+	return s.review(ctx, id, reviewerID, req, constant.ReimbursementStatusRejected)
+}
+
+func (s *ReimbursementService) review(ctx context.Context, id, reviewerID uint, req reimbursement.ReviewReimbursementRequest, status string) (*reimbursement.ReimbursementResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing review reimbursement request", requestID, map[string]interface{}{
+		"reimbursement_id": id,
+		"reviewer_id":      reviewerID,
+		"status":           status,
+		"approved_amount":  req.ApprovedAmount,
+	})
+
+	reimb, err := s.reimbursementRepo.GetByID(ctx, id)
+	if err != nil || !inReviewScope(req.UserIDs, reimb.UserID) {
+		return nil, errors.New("reimbursement not found")
+	}
+	if reimb.UserID == reviewerID {
+		return nil, ErrSelfReview
+	}
+	if reimb.Status != constant.ReimbursementStatusSubmitted {
+		return nil, fmt.Errorf("reimbursement is already %s", reimb.Status)
+	}
+
+	var comment *string
+	if trimmed := strings.TrimSpace(req.Comment); trimmed != "" {
+		comment = &trimmed
+	}
+
+	// Approving less than the claimed amount is a partial approval
+	var approvedAmount *float64
+	if status == constant.ReimbursementStatusApproved {
+		amount := reimb.Amount
+		if req.ApprovedAmount != nil {
+			amount = *req.ApprovedAmount
+		}
+		if amount > reimb.Amount {
+			return nil, fmt.Errorf("approved amount cannot exceed the claimed amount of %.2f", reimb.Amount)
+		}
+		if amount < reimb.Amount {
+			if comment == nil {
+				return nil, errors.New("comment is required when partially approving a reimbursement")
+			}
+			status = constant.ReimbursementStatusPartiallyApproved
+		}
+		approvedAmount = &amount
+	}
+
+	now := time.Now()
+	reimb.Status = status
+	reimb.ApprovedAmount = approvedAmount
+	reimb.ReviewedBy = &reviewerID
+	reimb.ReviewedAt = &now
+	reimb.ReviewComment = comment
+	reimb.UpdatedBy = &reviewerID
+	reimb.UpdatedAt = &now
+
+	// Review only updates a submitted claim, a concurrent reviewer gets an error instead of overwriting the decision
+	if err := s.reimbursementRepo.Review(ctx, id, map[string]interface{}{
+		"status":          reimb.Status,
+		"approved_amount": reimb.ApprovedAmount,
+		"reviewed_by":     reimb.ReviewedBy,
+		"reviewed_at":     reimb.ReviewedAt,
+		"review_comment":  reimb.ReviewComment,
+		"updated_by":      reimb.UpdatedBy,
+		"updated_at":      reimb.UpdatedAt,
+	}); err != nil {
+		s.logger.ErrorT("failed to review reimbursement", requestID, map[string]interface{}{
+			"error":            err.Error(),
+			"reimbursement_id": id,
+		})
+		return nil, fmt.Errorf("failed to review reimbursement: %w", err)
+	}
+
+	s.logger.InfoT("reimbursement reviewed", requestID, map[string]interface{}{
+		"reimbursement_id": id,
+		"reviewer_id":      reviewerID,
+		"status":           status,
+	})
+
+	notificationType, title := constant.NotificationTypeReimbursementApproved, "Reimbursement approved"
+	message := fmt.Sprintf("Your reimbursement %q of %.2f was approved.", reimb.Title, reimb.Amount)
+	switch status {
+	case constant.ReimbursementStatusPartiallyApproved:
+		title = "Reimbursement partially approved"
+		message = fmt.Sprintf("Your reimbursement %q of %.2f was partially approved for %.2f.", reimb.Title, reimb.Amount, *approvedAmount)
+	case constant.ReimbursementStatusRejected:
+		notificationType, title = constant.NotificationTypeReimbursementRejected, "Reimbursement rejected"
+		message = fmt.Sprintf("Your reimbursement %q of %.2f was rejected.", reimb.Title, reimb.Amount)
+	}
+	if comment != nil {
+		message = fmt.Sprintf("%s Comment: %s", message, *comment)
+	}
+	if err := s.notification.Notify(ctx, reimb.UserID, notificationType, title, message); err != nil {
+		s.logger.WarningT("failed to notify reimbursement review", requestID, map[string]interface{}{
+			"error":            err.Error(),
+			"reimbursement_id": id,
+		})
+	}
+
+	response := s.toResponse(*reimb)
+	return &response, nil
+}
+
+func (s *ReimbursementService) CreateCategory(ctx context.Context, req reimbursement.CreateCategoryRequest, createdBy uint) (*reimbursement.CategoryResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing create reimbursement category request", requestID, map[string]interface{}{
+		"code":       req.Code,
+		"name":       req.Name,
+		"created_by": createdBy,
+	})
+
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if _, err := s.reimbursementRepo.GetCategoryByCode(ctx, code); err == nil {
+		return nil, fmt.Errorf("category code %s already exists", code)
+	}
+
+	category := &reimbursement.ReimbursementCategory{
+		Code:                    code,
+		Name:                    req.Name,
+		Description:             req.Description,
+		PerClaimLimit:           req.PerClaimLimit,
+		MonthlyLimit:            req.MonthlyLimit,
+		YearlyLimit:             req.YearlyLimit,
+		EligibleGrades:          joinList(req.EligibleGrades),
+		EligibleEmploymentTypes: joinList(req.EligibleEmploymentTypes),
+		Status:                  constant.StatusActive,
+		CreatedBy:               createdBy,
+		CreatedAt:               time.Now(),
+	}
+
+	if err := s.reimbursementRepo.CreateCategory(ctx, category); err != nil {
+		s.logger.ErrorT("failed to create reimbursement category", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"code":  code,
+		})
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	s.logger.InfoT("reimbursement category created", requestID, map[string]interface{}{
+		"category_id": category.ID,
+		"code":        code,
+	})
+
+	response := s.toCategoryResponse(*category)
+	return &response, nil
+}
+
+func (s *ReimbursementService) UpdateCategory(ctx context.Context, id uint, req reimbursement.UpdateCategoryRequest, updatedBy uint) (*reimbursement.CategoryResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing update reimbursement category request", requestID, map[string]interface{}{
+		"category_id": id,
+		"updated_by":  updatedBy,
+	})
+
+	if _, err := s.reimbursementRepo.GetCategoryByID(ctx, id); err != nil {
+		return nil, errors.New("category not found")
+	}
+
+	// Prepare updates, a limit of 0 removes it
+	updates := make(map[string]interface{})
+	updates["updated_by"] = updatedBy
+	updates["updated_at"] = time.Now()
+
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.PerClaimLimit != nil {
+		updates["per_claim_limit"] = limitValue(*req.PerClaimLimit)
+	}
+	if req.MonthlyLimit != nil {
+		updates["monthly_limit"] = limitValue(*req.MonthlyLimit)
+	}
+	if req.YearlyLimit != nil {
+		updates["yearly_limit"] = limitValue(*req.YearlyLimit)
+	}
+	if req.EligibleGrades != nil {
+		updates["eligible_grades"] = joinList(*req.EligibleGrades)
+	}
+	if req.EligibleEmploymentTypes != nil {
+		updates["eligible_employment_types"] = joinList(*req.EligibleEmploymentTypes)
+	}
+	if req.Status != nil {
+		updates["status"] = *req.Status
+	}
+
+	if err := s.reimbursementRepo.UpdateCategory(ctx, id, updates); err != nil {
+		s.logger.ErrorT("failed to update reimbursement category", requestID, map[string]interface{}{
+			"error":       err.Error(),
+			"category_id": id,
+		})
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	category, err := s.reimbursementRepo.GetCategoryByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated category: %w", err)
+	}
+
+	response := s.toCategoryResponse(*category)
+	return &response, nil
+}
+
+func (s *ReimbursementService) ListCategories(ctx context.Context) ([]reimbursement.CategoryResponse, error) {
+	categories, err := s.reimbursementRepo.ListCategories(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	responses := make([]reimbursement.CategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		responses = append(responses, s.toCategoryResponse(category))
+	}
+	return responses, nil
+}
+
+func (s *ReimbursementService) GetBalance(ctx context.Context, userID uint) ([]reimbursement.CategoryBalance, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing get reimbursement balance request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	u, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	categories, err := s.reimbursementRepo.ListCategories(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	now := time.Now()
+	balances := make([]reimbursement.CategoryBalance, 0, len(categories))
+	for _, category := range categories {
+		if !eligible(category, u) {
+			continue
+		}
+
+		monthStart, monthEnd := monthRange(now)
+		monthlyUsed, err := s.reimbursementRepo.GetUsedAmount(ctx, userID, category.ID, monthStart, monthEnd, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get used amount: %w", err)
+		}
+		yearStart, yearEnd := yearRange(now)
+		yearlyUsed, err := s.reimbursementRepo.GetUsedAmount(ctx, userID, category.ID, yearStart, yearEnd, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get used amount: %w", err)
+		}
+
+		balances = append(balances, reimbursement.CategoryBalance{
+			CategoryID:       category.ID,
+			Code:             category.Code,
+			Name:             category.Name,
+			PerClaimLimit:    category.PerClaimLimit,
+			MonthlyLimit:     category.MonthlyLimit,
+			MonthlyUsed:      monthlyUsed,
+			MonthlyRemaining: remaining(category.MonthlyLimit, monthlyUsed),
+			YearlyLimit:      category.YearlyLimit,
+			YearlyUsed:       yearlyUsed,
+			YearlyRemaining:  remaining(category.YearlyLimit, yearlyUsed),
+		})
+	}
+
+	return balances, nil
+}
+
+// checkPolicy validates a claim against the category eligibility and limits.
+// The month and year follow the claim date, excludeID skips the claim being updated.
+func (s *ReimbursementService) checkPolicy(ctx context.Context, userID, categoryID uint, date time.Time, amount float64, excludeID uint) error {
+	category, err := s.reimbursementRepo.GetCategoryByID(ctx, categoryID)
+	if err != nil || category.Status != constant.StatusActive {
+		return errors.New("reimbursement category not found")
+	}
+
+	u, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+	if !eligible(*category, u) {
+		return fmt.Errorf("you are not eligible for %s reimbursements", category.Name)
+	}
+
+	if category.PerClaimLimit != nil && amount > *category.PerClaimLimit {
+		return fmt.Errorf("amount exceeds the %s limit of %.2f per claim", category.Name, *category.PerClaimLimit)
+	}
+
+	if category.MonthlyLimit != nil {
+		start, end := monthRange(date)
+		used, err := s.reimbursementRepo.GetUsedAmount(ctx, userID, categoryID, start, end, excludeID)
+		if err != nil {
+			return fmt.Errorf("failed to get used amount: %w", err)
+		}
+		if used+amount > *category.MonthlyLimit {
+			return fmt.Errorf("amount exceeds the %s monthly limit of %.2f for %s, remaining %.2f", category.Name, *category.MonthlyLimit, date.Format("2006-01"), *remaining(category.MonthlyLimit, used))
+		}
+	}
+
+	if category.YearlyLimit != nil {
+		start, end := yearRange(date)
+		used, err := s.reimbursementRepo.GetUsedAmount(ctx, userID, categoryID, start, end, excludeID)
+		if err != nil {
+			return fmt.Errorf("failed to get used amount: %w", err)
+		}
+		if used+amount > *category.YearlyLimit {
+			return fmt.Errorf("amount exceeds the %s yearly limit of %.2f for %d, remaining %.2f", category.Name, *category.YearlyLimit, date.Year(), *remaining(category.YearlyLimit, used))
+		}
+	}
+
+	return nil
+}
+
+// eligible reports whether the employee's grade and employment type are allowed by the category
+func eligible(category reimbursement.ReimbursementCategory, u user.User) bool {
+	return allowed(category.EligibleGrades, u.Grade) && allowed(category.EligibleEmploymentTypes, u.EmploymentType)
+}
+
+// allowed reports whether value is in the comma separated list, an empty list allows everything
+func allowed(list string, value *string) bool {
+	if list == "" {
+		return true
+	}
+	if value == nil {
+		return false
+	}
+	for _, item := range splitList(list) {
+		if strings.EqualFold(item, *value) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinList(items []string) string {
+	var cleaned []string
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			cleaned = append(cleaned, item)
+		}
+	}
+	return strings.Join(cleaned, ",")
+}
+
+func splitList(list string) []string {
+	if list == "" {
+		return []string{}
+	}
+	return strings.Split(list, ",")
+}
+
+// limitValue stores a limit of 0 as no limit
+func limitValue(limit float64) *float64 {
+	if limit == 0 {
+		return nil
+	}
+	return &limit
+}
+
+func remaining(limit *float64, used float64) *float64 {
+	if limit == nil {
+		return nil
+	}
+	left := *limit - used
+	if left < 0 {
+		left = 0
+	}
+	return &left
+}
+
+func monthRange(date time.Time) (time.Time, time.Time) {
+	start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	return start, start.AddDate(0, 1, -1)
+}
+
+func yearRange(date time.Time) (time.Time, time.Time) {
+	start := time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, date.Location())
+	return start, start.AddDate(1, 0, -1)
+}
+
+func (s *ReimbursementService) toCategoryResponse(category reimbursement.ReimbursementCategory) reimbursement.CategoryResponse {
+	return reimbursement.CategoryResponse{
+		ID:                      category.ID,
+		Code:                    category.Code,
+		Name:                    category.Name,
+		Description:             category.Description,
+		PerClaimLimit:           category.PerClaimLimit,
+		MonthlyLimit:            category.MonthlyLimit,
+		YearlyLimit:             category.YearlyLimit,
+		EligibleGrades:          splitList(category.EligibleGrades),
+		EligibleEmploymentTypes: splitList(category.EligibleEmploymentTypes),
+		Status:                  category.Status,
+		CreatedBy:               category.CreatedBy,
+		CreatedAt:               category.CreatedAt,
+		UpdatedBy:               category.UpdatedBy,
+		UpdatedAt:               category.UpdatedAt,
+	}
+}
+
+// readFile loads a stored receipt for download
+func (s *ReimbursementService) readFile(ctx context.Context, attachment reimbursement.ReimbursementAttachment) (*reimbursement.AttachmentFile, error) {
+	content, err := s.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		s.logger.ErrorT("failed to read receipt from storage", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error":         err.Error(),
+			"attachment_id": attachment.ID,
+		})
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, errors.New("attachment file not found")
+		}
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return &reimbursement.AttachmentFile{
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Content:     content,
+	}, nil
+}
+
+// getOwned returns the reimbursement when it belongs to the user
+func (s *ReimbursementService) getOwned(ctx context.Context, id, userID uint) (*reimbursement.Reimbursement, error) {
+	reimb, err := s.reimbursementRepo.GetByID(ctx, id)
+	if err != nil || reimb.UserID != userID {
+		return nil, errors.New("reimbursement not found")
+	}
+	return reimb, nil
+}
+
+func (s *ReimbursementService) getOwnedAttachment(ctx context.Context, reimbursementID, attachmentID, userID uint) (*reimbursement.ReimbursementAttachment, error) {
+	if _, err := s.getOwned(ctx, reimbursementID, userID); err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.reimbursementRepo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil || attachment.ReimbursementID != reimbursementID {
+		return nil, errors.New("attachment not found")
+	}
+	return attachment, nil
+}
+
+// removeFile deletes a stored receipt, failures only leave an orphaned file behind
+func (s *ReimbursementService) removeFile(ctx context.Context, attachment reimbursement.ReimbursementAttachment) {
+	if err := s.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		s.logger.WarningT("failed to delete receipt from storage", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error":         err.Error(),
+			"attachment_id": attachment.ID,
+			"storage_key":   attachment.StorageKey,
+		})
+	}
+}
+
 // Helper function to convert Reimbursement to ReimbursementResponse
 func (s *ReimbursementService) toResponse(reimb reimbursement.Reimbursement) reimbursement.ReimbursementResponse {
 	return reimbursement.ReimbursementResponse{
-		ID:          reimb.ID,
-		UserID:      reimb.UserID,
-		Title:       reimb.Title,
-		Date:        reimb.Date,
-		Amount:      reimb.Amount,
-		Description: reimb.Description,
-		CreatedBy:   reimb.CreatedBy,
-		CreatedAt:   reimb.CreatedAt,
-		UpdatedBy:   reimb.UpdatedBy,
-		UpdatedAt:   reimb.UpdatedAt,
+		ID:                 reimb.ID,
+		UserID:             reimb.UserID,
+		CategoryID:         reimb.CategoryID,
+		Title:              reimb.Title,
+		Date:               reimb.Date,
+		Amount:             reimb.Amount,
+		Description:        reimb.Description,
+		Status:             reimb.Status,
+		ApprovedAmount:     reimb.ApprovedAmount,
+		ReviewedBy:         reimb.ReviewedBy,
+		ReviewedAt:         reimb.ReviewedAt,
+		ReviewComment:      reimb.ReviewComment,
+		PeriodDetailID:     reimb.PeriodDetailID,
+		TerminationID:      reimb.TerminationID,
+		PaidAt:             reimb.PaidAt,
+		SuspectedDuplicate: reimb.SuspectedDuplicate,
+		CreatedBy:          reimb.CreatedBy,
+		CreatedAt:          reimb.CreatedAt,
+		UpdatedBy:          reimb.UpdatedBy,
+		UpdatedAt:          reimb.UpdatedAt,
 	}
 }
+
+// inReviewScope reports whether a reviewer limited to userIDs may see a claim of userID, nil allows every claim
+func inReviewScope(userIDs []uint, userID uint) bool {
+	return userIDs == nil || slices.Contains(userIDs, userID)
+}
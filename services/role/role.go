@@ -0,0 +1,486 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/role"
+	instanceRepo "github.com/riskykurniawan15/payrolls/repositories/instance"
+	roleRepo "github.com/riskykurniawan15/payrolls/repositories/role"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+type (
+	IRoleService interface {
+		ListPermissions(ctx context.Context) ([]role.Permission, error)
+		ListRoles(ctx context.Context) ([]role.Role, error)
+		GetRole(ctx context.Context, id uint) (*role.Role, error)
+		CreateRole(ctx context.Context, req role.CreateRoleRequest, createdBy uint) (*role.Role, error)
+		UpdateRole(ctx context.Context, id uint, req role.UpdateRoleRequest, updatedBy uint) (*role.Role, error)
+		DeleteRole(ctx context.Context, id uint, deletedBy uint) error
+		GetUserRoles(ctx context.Context, userID uint) (*role.UserRolesResponse, error)
+		SetUserRoles(ctx context.Context, userID uint, req role.SetUserRolesRequest, updatedBy uint) (*role.UserRolesResponse, error)
+		GetUserPermissions(ctx context.Context, userID uint) ([]string, error)
+	}
+
+	RoleService struct {
+		logger       logger.Logger
+		instanceRepo instanceRepo.IInstanceRepository
+		roleRepo     roleRepo.IRoleRepository
+		userRepo     userRepo.IUserRepository
+	}
+)
+
+func NewRoleService(logger logger.Logger, instanceRepo instanceRepo.IInstanceRepository, roleRepo roleRepo.IRoleRepository, userRepo userRepo.IUserRepository) IRoleService {
+	return &RoleService{
+		logger:       logger,
+		instanceRepo: instanceRepo,
+		roleRepo:     roleRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (s *RoleService) ListPermissions(ctx context.Context) ([]role.Permission, error) {
+	permissions, err := s.roleRepo.ListPermissions(ctx)
+	if err != nil {
+		s.logger.ErrorT("failed to list permissions", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+	return permissions, nil
+}
+
+func (s *RoleService) ListRoles(ctx context.Context) ([]role.Role, error) {
+	roles, err := s.roleRepo.ListRoles(ctx)
+	if err != nil {
+		s.logger.ErrorT("failed to list roles", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (s *RoleService) GetRole(ctx context.Context, id uint) (*role.Role, error) {
+	r, err := s.roleRepo.GetRoleByID(ctx, id)
+	if err != nil {
+		s.logger.WarningT("role not found", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"role_id": id,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("role not found")
+	}
+	return r, nil
+}
+
+func (s *RoleService) CreateRole(c context.Context, req role.CreateRoleRequest, createdBy uint) (*role.Role, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing create role request", requestID, map[string]interface{}{
+		"name":        req.Name,
+		"permissions": req.Permissions,
+		"created_by":  createdBy,
+	})
+
+	name := strings.TrimSpace(req.Name)
+	if _, err := s.roleRepo.GetRoleByName(c, name); err == nil {
+		return nil, errors.New("role name already exists")
+	}
+
+	permissionIDs, err := s.resolvePermissions(c, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		s.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	r := &role.Role{
+		Name:              name,
+		Description:       req.Description,
+		RequiresTwoFactor: req.RequiresTwoFactor,
+		CreatedBy:         &createdBy,
+		CreatedAt:         time.Now(),
+	}
+	if err := s.roleRepo.CreateRole(ctx, r); err != nil {
+		s.logger.ErrorT("failed to create role", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"name":  name,
+		})
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+	if err := s.roleRepo.SetRolePermissions(ctx, r.ID, permissionIDs); err != nil {
+		s.logger.ErrorT("failed to set role permissions", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"role_id": r.ID,
+		})
+		return nil, fmt.Errorf("failed to set role permissions: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.logger.ErrorT("failed to commit role", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to commit role: %w", err)
+	}
+
+	s.logger.InfoT("role created successfully", requestID, map[string]interface{}{
+		"role_id": r.ID,
+		"name":    r.Name,
+	})
+
+	return s.GetRole(c, r.ID)
+}
+
+func (s *RoleService) UpdateRole(c context.Context, id uint, req role.UpdateRoleRequest, updatedBy uint) (*role.Role, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing update role request", requestID, map[string]interface{}{
+		"role_id":     id,
+		"name":        req.Name,
+		"permissions": req.Permissions,
+		"updated_by":  updatedBy,
+	})
+
+	current, err := s.GetRole(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{
+		"updated_by": updatedBy,
+		"updated_at": time.Now(),
+	}
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		// System roles are matched against users.roles by name
+		if current.IsSystem && name != current.Name {
+			return nil, errors.New("cannot rename a system role")
+		}
+		if !strings.EqualFold(name, current.Name) {
+			if _, err := s.roleRepo.GetRoleByName(c, name); err == nil {
+				return nil, errors.New("role name already exists")
+			}
+		}
+		updates["name"] = name
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.RequiresTwoFactor != nil {
+		updates["requires_two_factor"] = *req.RequiresTwoFactor
+	}
+
+	var permissionIDs []uint
+	if req.Permissions != nil {
+		if permissionIDs, err = s.resolvePermissions(c, req.Permissions); err != nil {
+			return nil, err
+		}
+		if err := s.ensureKeepsRoleManage(c, updatedBy, id, req.Permissions); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		s.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := s.roleRepo.UpdateRole(ctx, id, updates); err != nil {
+		s.logger.ErrorT("failed to update role", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"role_id": id,
+		})
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+	if req.Permissions != nil {
+		if err := s.roleRepo.SetRolePermissions(ctx, id, permissionIDs); err != nil {
+			s.logger.ErrorT("failed to set role permissions", requestID, map[string]interface{}{
+				"error":   err.Error(),
+				"role_id": id,
+			})
+			return nil, fmt.Errorf("failed to set role permissions: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.logger.ErrorT("failed to commit role", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to commit role: %w", err)
+	}
+
+	s.logger.InfoT("role updated successfully", requestID, map[string]interface{}{
+		"role_id":              id,
+		"previous_name":        current.Name,
+		"previous_permissions": current.Permissions,
+	})
+
+	return s.GetRole(c, id)
+}
+
+func (s *RoleService) DeleteRole(ctx context.Context, id uint, deletedBy uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing delete role request", requestID, map[string]interface{}{
+		"role_id":    id,
+		"deleted_by": deletedBy,
+	})
+
+	current, err := s.GetRole(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current.IsSystem {
+		return errors.New("cannot delete a system role")
+	}
+	if err := s.ensureKeepsRoleManage(ctx, deletedBy, id, nil); err != nil {
+		return err
+	}
+
+	if err := s.roleRepo.DeleteRole(ctx, id); err != nil {
+		s.logger.ErrorT("failed to delete role", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"role_id": id,
+		})
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	s.logger.InfoT("role deleted successfully", requestID, map[string]interface{}{
+		"role_id":    id,
+		"name":       current.Name,
+		"deleted_by": deletedBy,
+	})
+
+	return nil
+}
+
+func (s *RoleService) GetUserRoles(ctx context.Context, userID uint) (*role.UserRolesResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		s.logger.WarningT("user not found", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+
+	roles, err := s.roleRepo.GetUserRoles(ctx, userID)
+	if err != nil {
+		s.logger.ErrorT("failed to get user roles", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	return &role.UserRolesResponse{
+		UserID:      userID,
+		Roles:       roles,
+		Permissions: combinePermissions(roles),
+	}, nil
+}
+
+func (s *RoleService) SetUserRoles(c context.Context, userID uint, req role.SetUserRolesRequest, updatedBy uint) (*role.UserRolesResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing set user roles request", requestID, map[string]interface{}{
+		"user_id":    userID,
+		"role_ids":   req.RoleIDs,
+		"updated_by": updatedBy,
+	})
+
+	if _, err := s.userRepo.GetUserByID(c, userID); err != nil {
+		s.logger.WarningT("user not found for role assignment", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+
+	roleIDs := uniqueIDs(req.RoleIDs)
+	roles := []role.Role{}
+	if len(roleIDs) > 0 {
+		var err error
+		if roles, err = s.roleRepo.GetRolesByIDs(c, roleIDs); err != nil {
+			return nil, fmt.Errorf("failed to get roles: %w", err)
+		}
+		if len(roles) != len(roleIDs) {
+			return nil, errors.New("one or more roles not found")
+		}
+	}
+	for _, r := range roles {
+		if r.IsSystem {
+			return nil, fmt.Errorf("system role %s follows the user role and cannot be assigned directly", r.Name)
+		}
+	}
+
+	if userID == updatedBy {
+		current, err := s.roleRepo.GetUserRoles(c, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user roles: %w", err)
+		}
+		// The system role is kept, only the additional roles are replaced
+		next := roles
+		for _, r := range current {
+			if r.IsSystem {
+				next = append(next, r)
+			}
+		}
+		if hasPermission(current, constant.PermissionRoleManage) && !hasPermission(next, constant.PermissionRoleManage) {
+			return nil, errors.New("cannot remove your own role management permission")
+		}
+	}
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		s.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := s.roleRepo.SetUserRoles(ctx, userID, roleIDs, updatedBy); err != nil {
+		s.logger.ErrorT("failed to set user roles", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID,
+		})
+		return nil, fmt.Errorf("failed to set user roles: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		s.logger.ErrorT("failed to commit user roles", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to commit user roles: %w", err)
+	}
+
+	s.logger.InfoT("user roles updated successfully", requestID, map[string]interface{}{
+		"user_id":  userID,
+		"role_ids": roleIDs,
+	})
+
+	return s.GetUserRoles(c, userID)
+}
+
+func (s *RoleService) GetUserPermissions(ctx context.Context, userID uint) ([]string, error) {
+	return s.roleRepo.GetUserPermissions(ctx, userID)
+}
+
+// resolvePermissions maps permission codes to their IDs and rejects unknown codes
+func (s *RoleService) resolvePermissions(ctx context.Context, codes []string) ([]uint, error) {
+	codes = uniqueCodes(codes)
+	permissions, err := s.roleRepo.GetPermissionsByCodes(ctx, codes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions: %w", err)
+	}
+
+	known := make(map[string]uint, len(permissions))
+	for _, p := range permissions {
+		known[p.Code] = p.ID
+	}
+
+	ids := make([]uint, 0, len(codes))
+	var unknown []string
+	for _, code := range codes {
+		id, ok := known[code]
+		if !ok {
+			unknown = append(unknown, code)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown permissions: %s", strings.Join(unknown, ", "))
+	}
+	return ids, nil
+}
+
+// ensureKeepsRoleManage stops a user from taking role management away from themselves
+// by changing or deleting a role they hold. A nil permissions slice means the role is removed
+func (s *RoleService) ensureKeepsRoleManage(ctx context.Context, userID, roleID uint, permissions []string) error {
+	current, err := s.roleRepo.GetUserRoles(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	next := make([]role.Role, 0, len(current))
+	for _, r := range current {
+		if r.ID == roleID {
+			if permissions == nil {
+				continue
+			}
+			r.Permissions = permissions
+		}
+		next = append(next, r)
+	}
+
+	if hasPermission(current, constant.PermissionRoleManage) && !hasPermission(next, constant.PermissionRoleManage) {
+		return errors.New("cannot remove your own role management permission")
+	}
+	return nil
+}
+
+func hasPermission(roles []role.Role, permission string) bool {
+	for _, r := range roles {
+		for _, p := range r.Permissions {
+			if p == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func combinePermissions(roles []role.Role) []string {
+	var codes []string
+	for _, r := range roles {
+		codes = append(codes, r.Permissions...)
+	}
+	codes = uniqueCodes(codes)
+	sort.Strings(codes)
+	return codes
+}
+
+func uniqueCodes(codes []string) []string {
+	seen := make(map[string]bool, len(codes))
+	result := make([]string, 0, len(codes))
+	for _, code := range codes {
+		code = strings.TrimSpace(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		result = append(result, code)
+	}
+	return result
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
@@ -0,0 +1,652 @@
+package termination
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/payslip"
+	"github.com/riskykurniawan15/payrolls/models/period_detail"
+	"github.com/riskykurniawan15/payrolls/models/termination"
+	attendanceRepo "github.com/riskykurniawan15/payrolls/repositories/attendance"
+	authSessionRepo "github.com/riskykurniawan15/payrolls/repositories/auth_session"
+	instanceRepo "github.com/riskykurniawan15/payrolls/repositories/instance"
+	overtimeRepo "github.com/riskykurniawan15/payrolls/repositories/overtime"
+	reimbursementRepo "github.com/riskykurniawan15/payrolls/repositories/reimbursement"
+	terminationRepo "github.com/riskykurniawan15/payrolls/repositories/termination"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/severance"
+)
+
+type (
+	ITerminationService interface {
+		CreateTermination(ctx context.Context, req termination.CreateTerminationRequest, createdBy uint) (*termination.Termination, error)
+		ListTerminations(ctx context.Context, req termination.ListTerminationsRequest) (*termination.ListTerminationsResponse, error)
+		GetTermination(ctx context.Context, id uint) (*termination.Termination, error)
+		UpdateTermination(ctx context.Context, id uint, req termination.UpdateTerminationRequest, updatedBy uint) (*termination.Termination, error)
+		CancelTermination(ctx context.Context, id uint, cancelledBy uint) error
+		SettleTermination(ctx context.Context, id uint, req termination.SettleTerminationRequest, settledBy uint) (*termination.Termination, error)
+		DeactivateDue(ctx context.Context, now time.Time) (int, error)
+	}
+
+	TerminationService struct {
+		logger            logger.Logger
+		config            config.Config
+		instanceRepo      instanceRepo.IInstanceRepository
+		terminationRepo   terminationRepo.ITerminationRepository
+		userRepo          userRepo.IUserRepository
+		authSessionRepo   authSessionRepo.IAuthSessionRepository
+		attendanceRepo    attendanceRepo.IAttendanceRepository
+		overtimeRepo      overtimeRepo.IOvertimeRepository
+		reimbursementRepo reimbursementRepo.IReimbursementRepository
+	}
+)
+
+func NewTerminationService(
+	logger logger.Logger,
+	config config.Config,
+	instanceRepo instanceRepo.IInstanceRepository,
+	terminationRepo terminationRepo.ITerminationRepository,
+	userRepo userRepo.IUserRepository,
+	authSessionRepo authSessionRepo.IAuthSessionRepository,
+	attendanceRepo attendanceRepo.IAttendanceRepository,
+	overtimeRepo overtimeRepo.IOvertimeRepository,
+	reimbursementRepo reimbursementRepo.IReimbursementRepository,
+) ITerminationService {
+	return &TerminationService{
+		logger:            logger,
+		config:            config,
+		instanceRepo:      instanceRepo,
+		terminationRepo:   terminationRepo,
+		userRepo:          userRepo,
+		authSessionRepo:   authSessionRepo,
+		attendanceRepo:    attendanceRepo,
+		overtimeRepo:      overtimeRepo,
+		reimbursementRepo: reimbursementRepo,
+	}
+}
+
+func (s *TerminationService) CreateTermination(ctx context.Context, req termination.CreateTerminationRequest, createdBy uint) (*termination.Termination, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing create termination request", requestID, map[string]interface{}{
+		"user_id":          req.UserID,
+		"last_working_day": req.LastWorkingDay,
+		"reason":           req.Reason,
+		"created_by":       createdBy,
+	})
+
+	lastWorkingDay, err := time.Parse("2006-01-02", req.LastWorkingDay)
+	if err != nil {
+		return nil, errors.New("invalid last working day")
+	}
+	if err := validateSeparationPay(req.Reason, req.SeparationPay); err != nil {
+		return nil, err
+	}
+	if req.UserID == createdBy {
+		return nil, errors.New("cannot terminate your own account")
+	}
+
+	userData, err := s.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		s.logger.WarningT("user not found", requestID, map[string]interface{}{
+			"user_id": req.UserID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+	if userData.Role != constant.EmployeeRole {
+		return nil, errors.New("only employees can be terminated")
+	}
+	if _, err := s.terminationRepo.GetByUserID(ctx, req.UserID); err == nil {
+		return nil, errors.New("employee already has a termination")
+	}
+
+	t := &termination.Termination{
+		UserID:          req.UserID,
+		LastWorkingDay:  lastWorkingDay,
+		Reason:          req.Reason,
+		Notes:           req.Notes,
+		UnusedLeaveDays: req.UnusedLeaveDays,
+		OutstandingLoan: req.OutstandingLoan,
+		SeparationPay:   req.SeparationPay,
+		Status:          constant.TerminationStatusScheduled,
+		CreatedBy:       createdBy,
+		CreatedAt:       time.Now(),
+	}
+	if err := s.terminationRepo.Create(ctx, t); err != nil {
+		s.logger.ErrorT("failed to create termination", requestID, map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": req.UserID,
+		})
+		return nil, fmt.Errorf("failed to create termination: %w", err)
+	}
+
+	s.logger.InfoT("termination created successfully", requestID, map[string]interface{}{
+		"termination_id":   t.ID,
+		"user_id":          t.UserID,
+		"last_working_day": req.LastWorkingDay,
+	})
+
+	// A termination recorded after the fact takes the login away right away instead of waiting for the job
+	if lastWorkingDay.Before(s.today(time.Now())) {
+		if err := s.deactivate(ctx, t); err != nil {
+			s.logger.ErrorT("failed to deactivate terminated user", requestID, map[string]interface{}{
+				"error":          err.Error(),
+				"termination_id": t.ID,
+				"user_id":        t.UserID,
+			})
+		}
+	}
+
+	return s.GetTermination(ctx, t.ID)
+}
+
+func (s *TerminationService) ListTerminations(ctx context.Context, req termination.ListTerminationsRequest) (*termination.ListTerminationsResponse, error) {
+	response, err := s.terminationRepo.List(ctx, req)
+	if err != nil {
+		s.logger.ErrorT("failed to list terminations", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list terminations: %w", err)
+	}
+	return response, nil
+}
+
+func (s *TerminationService) GetTermination(ctx context.Context, id uint) (*termination.Termination, error) {
+	t, err := s.terminationRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.WarningT("termination not found", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"termination_id": id,
+			"error":          err.Error(),
+		})
+		return nil, errors.New("termination not found")
+	}
+	return t, nil
+}
+
+func (s *TerminationService) UpdateTermination(ctx context.Context, id uint, req termination.UpdateTerminationRequest, updatedBy uint) (*termination.Termination, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	s.logger.InfoT("processing update termination request", requestID, map[string]interface{}{
+		"termination_id": id,
+		"updated_by":     updatedBy,
+	})
+
+	current, err := s.GetTermination(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != constant.TerminationStatusScheduled {
+		return nil, errors.New("only a scheduled termination can be changed")
+	}
+
+	updates := map[string]interface{}{
+		"updated_by": updatedBy,
+		"updated_at": time.Now(),
+	}
+	lastWorkingDay := current.LastWorkingDay
+	if req.LastWorkingDay != nil {
+		lastWorkingDay, err = time.Parse("2006-01-02", *req.LastWorkingDay)
+		if err != nil {
+			return nil, errors.New("invalid last working day")
+		}
+		updates["last_working_day"] = lastWorkingDay
+	}
+	reason := current.Reason
+	if req.Reason != nil {
+		reason = *req.Reason
+		updates["reason"] = reason
+	}
+	separationPay := current.SeparationPay
+	if req.SeparationPay != nil {
+		separationPay = *req.SeparationPay
+		updates["separation_pay"] = separationPay
+	}
+	if err := validateSeparationPay(reason, separationPay); err != nil {
+		return nil, err
+	}
+	if req.Notes != nil {
+		updates["notes"] = *req.Notes
+	}
+	if req.UnusedLeaveDays != nil {
+		updates["unused_leave_days"] = *req.UnusedLeaveDays
+	}
+	if req.OutstandingLoan != nil {
+		updates["outstanding_loan"] = *req.OutstandingLoan
+	}
+
+	if err := s.terminationRepo.Update(ctx, id, updates); err != nil {
+		s.logger.ErrorT("failed to update termination", requestID, map[string]interface{}{
+			"error":          err.Error(),
+			"termination_id": id,
+		})
+		return nil, fmt.Errorf("failed to update termination: %w", err)
+	}
+
+	// Moving the last working day across today gives the login back or takes it away
+	today := s.today(time.Now())
+	if current.DeactivatedAt != nil && !lastWorkingDay.Before(today) {
+		if err := s.reactivate(ctx, current, updatedBy); err != nil {
+			s.logger.ErrorT("failed to reactivate user", requestID, map[string]interface{}{
+				"error":          err.Error(),
+				"termination_id": id,
+				"user_id":        current.UserID,
+			})
+		}
+	} else if current.DeactivatedAt == nil && lastWorkingDay.Before(today) {
+		if err := s.deactivate(ctx, current); err != nil {
+			s.logger.ErrorT("failed to deactivate terminated user", requestID, map[string]interface{}{
+				"error":          err.Error(),
+				"termination_id": id,
+				"user_id":        current.UserID,
+			})
+		}
+	}
+
+	s.logger.InfoT("termination updated successfully", requestID, map[string]interface{}{
+		"termination_id": id,
+	})
+
+	return s.GetTermination(ctx, id)
+}
+
+func (s *TerminationService) CancelTermination(c context.Context, id uint, cancelledBy uint) error {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing cancel termination request", requestID, map[string]interface{}{
+		"termination_id": id,
+		"cancelled_by":   cancelledBy,
+	})
+
+	current, err := s.GetTermination(c, id)
+	if err != nil {
+		return err
+	}
+	if current.Status != constant.TerminationStatusScheduled {
+		return errors.New("a settled termination cannot be cancelled")
+	}
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.terminationRepo.Delete(ctx, id); err != nil {
+		s.logger.ErrorT("failed to delete termination", requestID, map[string]interface{}{
+			"error":          err.Error(),
+			"termination_id": id,
+		})
+		return fmt.Errorf("failed to cancel termination: %w", err)
+	}
+	// Only a login taken away by the termination is given back
+	if current.DeactivatedAt != nil {
+		if err := s.userRepo.UpdateUser(ctx, current.UserID, map[string]interface{}{
+			"status":     constant.StatusActive,
+			"updated_by": cancelledBy,
+			"updated_at": time.Now(),
+		}); err != nil {
+			return fmt.Errorf("failed to reactivate user: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.logger.InfoT("termination cancelled successfully", requestID, map[string]interface{}{
+		"termination_id": id,
+		"user_id":        current.UserID,
+		"reactivated":    current.DeactivatedAt != nil,
+	})
+
+	return nil
+}
+
+// SettleTermination calculates the final pay of the employee. A dry run returns the calculation at any time,
+// the real settlement is saved once after the last working day and pays out the open reimbursements.
+func (s *TerminationService) SettleTermination(c context.Context, id uint, req termination.SettleTerminationRequest, settledBy uint) (*termination.Termination, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	s.logger.InfoT("processing settle termination request", requestID, map[string]interface{}{
+		"termination_id": id,
+		"dry_run":        req.DryRun,
+		"settled_by":     settledBy,
+	})
+
+	t, err := s.GetTermination(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status != constant.TerminationStatusScheduled {
+		return nil, errors.New("termination is already settled")
+	}
+	if !req.DryRun && !t.LastWorkingDay.Before(s.today(time.Now())) {
+		return nil, errors.New("final settlement is available after the last working day")
+	}
+
+	reimbursementIDs, err := s.calculateSettlement(c, t, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return t, nil
+	}
+
+	ctx, tx, err := s.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":                constant.TerminationStatusSettled,
+		"settlement_start_date": t.SettlementStartDate,
+		"service_months":        t.ServiceMonths,
+		"monthly_wage":          t.MonthlyWage,
+		"daily_rate":            t.DailyRate,
+		"total_working":         t.TotalWorking,
+		"prorated_salary":       t.ProratedSalary,
+		"overtime":              t.Overtime,
+		"amount_overtime":       t.AmountOvertime,
+		"reimbursement":         t.Reimbursement,
+		"amount_reimbursement":  t.AmountReimbursement,
+		"leave_encashment":      t.LeaveEncashment,
+		"severance_months":      t.SeveranceMonths,
+		"severance_pay":         t.SeverancePay,
+		"service_award_months":  t.ServiceAwardMonths,
+		"service_award_pay":     t.ServiceAwardPay,
+		"compensation_months":   t.CompensationMonths,
+		"compensation_pay":      t.CompensationPay,
+		"loan_deduction":        t.LoanDeduction,
+		"take_home_pay":         t.TakeHomePay,
+		"settled_by":            settledBy,
+		"settled_at":            now,
+		"updated_by":            settledBy,
+		"updated_at":            now,
+	}
+	if err := s.terminationRepo.Update(ctx, id, updates); err != nil {
+		s.logger.ErrorT("failed to save final settlement", requestID, map[string]interface{}{
+			"error":          err.Error(),
+			"termination_id": id,
+		})
+		return nil, fmt.Errorf("failed to save final settlement: %w", err)
+	}
+	if err := s.reimbursementRepo.MarkPaidBySettlement(ctx, reimbursementIDs, id, now); err != nil {
+		return nil, fmt.Errorf("failed to mark reimbursements paid: %w", err)
+	}
+	// The deactivation job may not have run yet
+	if t.DeactivatedAt == nil {
+		if err := s.deactivate(ctx, t); err != nil {
+			return nil, fmt.Errorf("failed to deactivate user: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.logger.InfoT("final settlement saved successfully", requestID, map[string]interface{}{
+		"termination_id": id,
+		"user_id":        t.UserID,
+		"take_home_pay":  t.TakeHomePay,
+	})
+
+	return s.GetTermination(c, id)
+}
+
+// DeactivateDue signs out the employees whose last working day has passed, it runs as a background job
+func (s *TerminationService) DeactivateDue(ctx context.Context, now time.Time) (int, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	terminations, err := s.terminationRepo.ListDueForDeactivation(ctx, s.today(now))
+	if err != nil {
+		s.logger.ErrorT("failed to get terminations due for deactivation", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, err
+	}
+
+	deactivated := 0
+	for i := range terminations {
+		if err := s.deactivate(ctx, &terminations[i]); err != nil {
+			s.logger.ErrorT("failed to deactivate terminated user", requestID, map[string]interface{}{
+				"error":          err.Error(),
+				"termination_id": terminations[i].ID,
+				"user_id":        terminations[i].UserID,
+			})
+			continue
+		}
+		deactivated++
+	}
+
+	if deactivated > 0 {
+		s.logger.InfoT("terminated users deactivated", requestID, map[string]interface{}{
+			"deactivated": deactivated,
+		})
+	}
+
+	return deactivated, nil
+}
+
+// calculateSettlement fills the settlement columns of t and returns the reimbursements it pays
+func (s *TerminationService) calculateSettlement(ctx context.Context, t *termination.Termination, requestID string) ([]uint, error) {
+	userData, err := s.userRepo.GetUserByID(ctx, t.UserID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	profile, err := s.userRepo.GetEmployeeProfile(ctx, t.UserID)
+	if err != nil || profile.JoinDate == nil {
+		return nil, errors.New("join date of the employee is required to calculate severance")
+	}
+	joinDate := dateOnly(*profile.JoinDate)
+	lastWorkingDay := dateOnly(t.LastWorkingDay)
+
+	// Salary is owed from the day after the last paid payroll period, or from the start of the final month
+	startDate := time.Date(lastWorkingDay.Year(), lastWorkingDay.Month(), 1, 0, 0, 0, 0, time.UTC)
+	lastPaidEnd, err := s.terminationRepo.GetLastPaidPeriodEnd(ctx, t.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last paid period: %w", err)
+	}
+	if lastPaidEnd != nil {
+		startDate = dateOnly(*lastPaidEnd).AddDate(0, 0, 1)
+	} else if joinDate.After(startDate) {
+		startDate = joinDate
+	}
+
+	// Daily rate follows payroll, the monthly salary spread over the weekdays of the final month
+	monthStart := time.Date(lastWorkingDay.Year(), lastWorkingDay.Month(), 1, 0, 0, 0, 0, time.UTC)
+	dailyRate := userData.Salary / float64(countWeekdays(monthStart, monthStart.AddDate(0, 1, -1)))
+
+	totalWorking := 0
+	for date := startDate; !date.After(lastWorkingDay); date = date.AddDate(0, 0, 1) {
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			continue
+		}
+		attended, err := s.hasCheckedInAttendance(ctx, t.UserID, date)
+		if err != nil {
+			s.logger.ErrorT("failed to check attendance", requestID, map[string]interface{}{
+				"error":   err.Error(),
+				"user_id": t.UserID,
+				"date":    date.Format("2006-01-02"),
+			})
+			continue
+		}
+		if attended {
+			totalWorking++
+		}
+	}
+
+	overtimeData := []payslip.OvertimeData{}
+	amountOvertime := float64(0)
+	if !startDate.After(lastWorkingDay) {
+		overtimes, err := s.overtimeRepo.GetByUserAndDateRange(ctx, t.UserID, startDate, lastWorkingDay)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get overtime data: %w", err)
+		}
+		for _, ot := range overtimes {
+			// Overtime a payroll run already paid is not paid twice
+			if ot.Status != constant.ApprovalStatusApproved || ot.PeriodDetailID != nil {
+				continue
+			}
+			// Overtime rate is 2x hourly rate
+			amount := ot.TotalHoursTime * dailyRate / 8.0 * 2.0
+			amountOvertime += amount
+			overtimeData = append(overtimeData, payslip.OvertimeData{
+				ID:     ot.ID,
+				Date:   ot.OvertimesDate.Format("2006-01-02"),
+				Hours:  ot.TotalHoursTime,
+				Amount: amount,
+			})
+		}
+	}
+
+	reimbursements, err := s.reimbursementRepo.GetPayableByUser(ctx, t.UserID, lastWorkingDay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reimbursement data: %w", err)
+	}
+	reimbursementData := []payslip.ReimbursementData{}
+	reimbursementIDs := []uint{}
+	amountReimbursement := float64(0)
+	for _, reimb := range reimbursements {
+		amount := reimb.Amount
+		if reimb.ApprovedAmount != nil {
+			amount = *reimb.ApprovedAmount
+		}
+		amountReimbursement += amount
+		reimbursementIDs = append(reimbursementIDs, reimb.ID)
+		reimbursementData = append(reimbursementData, payslip.ReimbursementData{
+			ID:     reimb.ID,
+			Title:  reimb.Title,
+			Date:   reimb.Date.Format("2006-01-02"),
+			Amount: amount,
+		})
+	}
+
+	benefits, err := severance.Calculate(t.Reason, severance.ServiceMonths(joinDate, lastWorkingDay), userData.Salary)
+	if err != nil {
+		return nil, err
+	}
+
+	overtimeJSON, err := json.Marshal(overtimeData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal overtime data: %w", err)
+	}
+	reimbursementJSON, err := json.Marshal(reimbursementData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal reimbursement data: %w", err)
+	}
+
+	proratedSalary := dailyRate * float64(totalWorking)
+	leaveEncashment := t.UnusedLeaveDays * dailyRate
+	gross := proratedSalary + amountOvertime + amountReimbursement + leaveEncashment +
+		benefits.SeverancePay + benefits.ServiceAwardPay + benefits.CompensationPay + t.SeparationPay
+	// The loan is deducted up to what the settlement pays, any remainder is collected outside payroll
+	loanDeduction := min(t.OutstandingLoan, gross)
+
+	t.SettlementStartDate = &startDate
+	t.ServiceMonths = benefits.ServiceMonths
+	t.MonthlyWage = userData.Salary
+	t.DailyRate = dailyRate
+	t.TotalWorking = totalWorking
+	t.ProratedSalary = proratedSalary
+	t.Overtime = (*period_detail.JSON)(&overtimeJSON)
+	t.AmountOvertime = amountOvertime
+	t.Reimbursement = (*period_detail.JSON)(&reimbursementJSON)
+	t.AmountReimbursement = amountReimbursement
+	t.LeaveEncashment = leaveEncashment
+	t.SeveranceMonths = benefits.SeveranceMonths
+	t.SeverancePay = benefits.SeverancePay
+	t.ServiceAwardMonths = benefits.ServiceAwardMonths
+	t.ServiceAwardPay = benefits.ServiceAwardPay
+	t.CompensationMonths = benefits.CompensationMonths
+	t.CompensationPay = benefits.CompensationPay
+	t.LoanDeduction = loanDeduction
+	t.TakeHomePay = gross - loanDeduction
+
+	return reimbursementIDs, nil
+}
+
+// deactivate sets the user inactive, signs them out everywhere and records it on the termination
+func (s *TerminationService) deactivate(ctx context.Context, t *termination.Termination) error {
+	now := time.Now()
+	if err := s.userRepo.UpdateUser(ctx, t.UserID, map[string]interface{}{
+		"status":     constant.StatusInactive,
+		"updated_by": t.CreatedBy,
+		"updated_at": now,
+	}); err != nil {
+		return err
+	}
+	if _, err := s.authSessionRepo.RevokeByUserID(ctx, t.UserID, constant.SessionRevokedDeactivated); err != nil {
+		return err
+	}
+	if err := s.terminationRepo.Update(ctx, t.ID, map[string]interface{}{"deactivated_at": now}); err != nil {
+		return err
+	}
+	t.DeactivatedAt = &now
+	return nil
+}
+
+// reactivate gives back a login taken away by the termination
+func (s *TerminationService) reactivate(ctx context.Context, t *termination.Termination, updatedBy uint) error {
+	if err := s.userRepo.UpdateUser(ctx, t.UserID, map[string]interface{}{
+		"status":     constant.StatusActive,
+		"updated_by": updatedBy,
+		"updated_at": time.Now(),
+	}); err != nil {
+		return err
+	}
+	if err := s.terminationRepo.Update(ctx, t.ID, map[string]interface{}{"deactivated_at": nil}); err != nil {
+		return err
+	}
+	t.DeactivatedAt = nil
+	return nil
+}
+
+func (s *TerminationService) hasCheckedInAttendance(ctx context.Context, userID uint, date time.Time) (bool, error) {
+	attendance, err := s.attendanceRepo.GetByUserAndDate(ctx, userID, date)
+	if err != nil {
+		// If no attendance found, return false (not an error)
+		if err.Error() == "record not found" {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get attendance data: %w", err)
+	}
+	return attendance != nil, nil
+}
+
+// today is the current date in the timezone of the company
+func (s *TerminationService) today(now time.Time) time.Time {
+	loc, err := time.LoadLocation(s.config.PostgressDB.DBTimeZone)
+	if err != nil {
+		loc = time.Local
+	}
+	return dateOnly(now.In(loc))
+}
+
+// validateSeparationPay rejects a separation pay for a reason that pays severance instead
+func validateSeparationPay(reason string, amount float64) error {
+	factors, _ := severance.FactorsFor(reason)
+	if amount > 0 && !factors.SeparationPay {
+		return errors.New("separation pay only applies to resignation, absence and serious violation")
+	}
+	return nil
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func countWeekdays(start, end time.Time) int {
+	count := 0
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		if date.Weekday() != time.Saturday && date.Weekday() != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,355 @@
+package timesheet
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/attendance"
+	"github.com/riskykurniawan15/payrolls/models/timesheet"
+	"github.com/riskykurniawan15/payrolls/models/user"
+	attendanceRepo "github.com/riskykurniawan15/payrolls/repositories/attendance"
+	overtimeRepo "github.com/riskykurniawan15/payrolls/repositories/overtime"
+	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/spreadsheet"
+)
+
+var exportHeader = []string{"user_id", "username", "date", "weekday", "status", "check_in", "check_out", "hours_worked", "overtime_hours"}
+
+type (
+	ITimesheetService interface {
+		GetTimesheets(ctx context.Context, req timesheet.TimesheetRequest) ([]timesheet.Timesheet, error)
+		Export(ctx context.Context, req timesheet.TimesheetRequest) (*timesheet.TimesheetExport, error)
+	}
+
+	TimesheetService struct {
+		logger         logger.Logger
+		config         config.Config
+		attendanceRepo attendanceRepo.IAttendanceRepository
+		overtimeRepo   overtimeRepo.IOvertimeRepository
+		userRepo       userRepo.IUserRepository
+	}
+)
+
+func NewTimesheetService(
+	logger logger.Logger,
+	config config.Config,
+	attendanceRepo attendanceRepo.IAttendanceRepository,
+	overtimeRepo overtimeRepo.IOvertimeRepository,
+	userRepo userRepo.IUserRepository,
+) ITimesheetService {
+	return &TimesheetService{
+		logger:         logger,
+		config:         config,
+		attendanceRepo: attendanceRepo,
+		overtimeRepo:   overtimeRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// GetTimesheets builds the monthly timesheet of one employee, or of every employee when no user is given
+func (s *TimesheetService) GetTimesheets(ctx context.Context, req timesheet.TimesheetRequest) ([]timesheet.Timesheet, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	loc := s.location()
+
+	month, err := time.ParseInLocation("2006-01", req.Month, loc)
+	if err != nil {
+		return nil, errors.New("month must use the YYYY-MM format")
+	}
+
+	s.logger.InfoT("processing timesheet request", requestID, map[string]interface{}{
+		"user_id": req.UserID,
+		"month":   req.Month,
+	})
+
+	// Resolve the employees of the report
+	var employees []user.User
+	if req.UserID != 0 {
+		employee, err := s.userRepo.GetUserByID(ctx, req.UserID)
+		if err != nil {
+			s.logger.WarningT("employee not found", requestID, map[string]interface{}{
+				"user_id": req.UserID,
+				"error":   err.Error(),
+			})
+			return nil, errors.New("user not found")
+		}
+		employees = append(employees, employee)
+	} else {
+		employees, err = s.userRepo.GetEmployees(ctx)
+		if err != nil {
+			s.logger.ErrorT("failed to get employees", requestID, map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, fmt.Errorf("failed to get employees: %w", err)
+		}
+	}
+
+	startDate := month
+	endDate := month.AddDate(0, 1, 0).Add(-time.Second)
+	holidays := s.holidays(requestID)
+
+	timesheets := make([]timesheet.Timesheet, 0, len(employees))
+	for _, employee := range employees {
+		attendances, err := s.attendanceRepo.GetByUserAndDateRange(ctx, employee.ID, startDate, endDate)
+		if err != nil {
+			s.logger.ErrorT("failed to get attendances", requestID, map[string]interface{}{
+				"error":   err.Error(),
+				"user_id": employee.ID,
+			})
+			return nil, fmt.Errorf("failed to get attendances: %w", err)
+		}
+
+		overtimes, err := s.overtimeRepo.GetByUserAndDateRange(ctx, employee.ID, startDate, endDate)
+		if err != nil {
+			s.logger.ErrorT("failed to get overtimes", requestID, map[string]interface{}{
+				"error":   err.Error(),
+				"user_id": employee.ID,
+			})
+			return nil, fmt.Errorf("failed to get overtimes: %w", err)
+		}
+
+		overtimeHours := map[string]float64{}
+		for _, ot := range overtimes {
+			if ot.Status == constant.ApprovalStatusRejected {
+				continue
+			}
+			overtimeHours[ot.OvertimesDate.In(loc).Format("2006-01-02")] += ot.TotalHoursTime
+		}
+
+		timesheets = append(timesheets, s.buildTimesheet(employee, month, attendances, overtimeHours, holidays))
+	}
+
+	s.logger.InfoT("timesheet generated successfully", requestID, map[string]interface{}{
+		"user_id":   req.UserID,
+		"month":     req.Month,
+		"employees": len(timesheets),
+	})
+
+	return timesheets, nil
+}
+
+// Export renders the timesheets as a CSV or XLSX file
+func (s *TimesheetService) Export(ctx context.Context, req timesheet.TimesheetRequest) (*timesheet.TimesheetExport, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	timesheets, err := s.GetTimesheets(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := "timesheet-" + req.Month
+	if req.UserID != 0 && len(timesheets) == 1 {
+		fileName += "-" + timesheets[0].Username
+	}
+
+	var buf bytes.Buffer
+	export := &timesheet.TimesheetExport{}
+	switch req.Format {
+	case constant.TimesheetFormatCSV:
+		writer := csv.NewWriter(&buf)
+		if err := writer.Write(exportHeader); err != nil {
+			return nil, err
+		}
+		for _, row := range s.exportRows(timesheets) {
+			record := make([]string, len(row))
+			for i, value := range row {
+				record[i] = fmt.Sprint(value)
+			}
+			if err := writer.Write(record); err != nil {
+				return nil, err
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, err
+		}
+		export.FileName = fileName + ".csv"
+		export.ContentType = "text/csv"
+	case constant.TimesheetFormatXLSX:
+		if err := spreadsheet.WriteXLSX(&buf, s.exportSheets(timesheets)); err != nil {
+			s.logger.ErrorT("failed to write timesheet workbook", requestID, map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, fmt.Errorf("failed to write timesheet workbook: %w", err)
+		}
+		export.FileName = fileName + ".xlsx"
+		export.ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", req.Format)
+	}
+	export.Content = buf.Bytes()
+
+	s.logger.InfoT("timesheet exported successfully", requestID, map[string]interface{}{
+		"file_name": export.FileName,
+		"size":      len(export.Content),
+	})
+
+	return export, nil
+}
+
+func (s *TimesheetService) buildTimesheet(employee user.User, month time.Time, attendances []attendance.Attendance, overtimeHours map[string]float64, holidays map[string]bool) timesheet.Timesheet {
+	loc := month.Location()
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	// Group attendances by day, keeping the first check-in and the last check-out
+	type dayAttendance struct {
+		checkIn  time.Time
+		checkOut *time.Time
+		hours    float64
+	}
+	byDay := map[string]*dayAttendance{}
+	for _, att := range attendances {
+		checkIn := att.CheckInDate.In(loc)
+		key := checkIn.Format("2006-01-02")
+		day, ok := byDay[key]
+		if !ok {
+			day = &dayAttendance{checkIn: checkIn}
+			byDay[key] = day
+		}
+		if checkIn.Before(day.checkIn) {
+			day.checkIn = checkIn
+		}
+		if att.CheckOutDate != nil {
+			checkOut := att.CheckOutDate.In(loc)
+			if day.checkOut == nil || checkOut.After(*day.checkOut) {
+				day.checkOut = &checkOut
+			}
+			day.hours += checkOut.Sub(checkIn).Hours()
+		}
+	}
+
+	result := timesheet.Timesheet{
+		UserID:   employee.ID,
+		Username: employee.Username,
+		Month:    month.Format("2006-01"),
+	}
+
+	for date := month; date.Month() == month.Month(); date = date.AddDate(0, 0, 1) {
+		key := date.Format("2006-01-02")
+		day := timesheet.TimesheetDay{
+			Date:          key,
+			Weekday:       date.Weekday().String(),
+			OvertimeHours: roundHours(overtimeHours[key]),
+		}
+
+		if worked, ok := byDay[key]; ok {
+			checkIn := worked.checkIn
+			day.Status = constant.TimesheetStatusPresent
+			day.CheckInDate = &checkIn
+			day.CheckOutDate = worked.checkOut
+			day.HoursWorked = roundHours(worked.hours)
+		} else if !attendance.IsWeekday(date) {
+			day.Status = constant.TimesheetStatusWeekend
+		} else if holidays[key] {
+			day.Status = constant.TimesheetStatusHoliday
+		} else if date.Before(today) {
+			day.Status = constant.TimesheetStatusAbsent
+		}
+		// Today and upcoming days without attendance are left without status
+
+		switch day.Status {
+		case constant.TimesheetStatusPresent:
+			result.Summary.PresentDays++
+		case constant.TimesheetStatusAbsent:
+			result.Summary.AbsentDays++
+		case constant.TimesheetStatusLeave:
+			result.Summary.LeaveDays++
+		case constant.TimesheetStatusHoliday:
+			result.Summary.HolidayDays++
+		case constant.TimesheetStatusWeekend:
+			result.Summary.WeekendDays++
+		}
+		result.Summary.HoursWorked += day.HoursWorked
+		result.Summary.OvertimeHours += day.OvertimeHours
+
+		result.Days = append(result.Days, day)
+	}
+
+	result.Summary.HoursWorked = roundHours(result.Summary.HoursWorked)
+	result.Summary.OvertimeHours = roundHours(result.Summary.OvertimeHours)
+
+	return result
+}
+
+func (s *TimesheetService) exportRows(timesheets []timesheet.Timesheet) [][]interface{} {
+	var rows [][]interface{}
+	for _, ts := range timesheets {
+		for _, day := range ts.Days {
+			checkIn, checkOut := "", ""
+			if day.CheckInDate != nil {
+				checkIn = day.CheckInDate.Format("15:04")
+			}
+			if day.CheckOutDate != nil {
+				checkOut = day.CheckOutDate.Format("15:04")
+			}
+			rows = append(rows, []interface{}{
+				ts.UserID, ts.Username, day.Date, day.Weekday, day.Status,
+				checkIn, checkOut, day.HoursWorked, day.OvertimeHours,
+			})
+		}
+	}
+	return rows
+}
+
+func (s *TimesheetService) exportSheets(timesheets []timesheet.Timesheet) []spreadsheet.Sheet {
+	header := make([]interface{}, len(exportHeader))
+	for i, column := range exportHeader {
+		header[i] = column
+	}
+	days := spreadsheet.Sheet{Name: "Timesheet", Rows: append([][]interface{}{header}, s.exportRows(timesheets)...)}
+
+	summary := spreadsheet.Sheet{
+		Name: "Summary",
+		Rows: [][]interface{}{{"user_id", "username", "month", "present_days", "absent_days", "leave_days", "holiday_days", "weekend_days", "hours_worked", "overtime_hours"}},
+	}
+	for _, ts := range timesheets {
+		summary.Rows = append(summary.Rows, []interface{}{
+			ts.UserID, ts.Username, ts.Month,
+			ts.Summary.PresentDays, ts.Summary.AbsentDays, ts.Summary.LeaveDays, ts.Summary.HolidayDays, ts.Summary.WeekendDays,
+			ts.Summary.HoursWorked, ts.Summary.OvertimeHours,
+		})
+	}
+
+	return []spreadsheet.Sheet{days, summary}
+}
+
+// holidays parses the HOLIDAYS configuration, invalid dates are skipped
+func (s *TimesheetService) holidays(requestID string) map[string]bool {
+	holidays := map[string]bool{}
+	for _, value := range strings.Split(s.config.Attendance.Holidays, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			s.logger.WarningT("invalid holiday date skipped", requestID, map[string]interface{}{
+				"date": value,
+			})
+			continue
+		}
+		holidays[value] = true
+	}
+	return holidays
+}
+
+func (s *TimesheetService) location() *time.Location {
+	loc, err := time.LoadLocation(s.config.PostgressDB.DBTimeZone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+func roundHours(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
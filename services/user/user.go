@@ -3,45 +3,150 @@ package user
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
 	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/models/auth_session"
+	"github.com/riskykurniawan15/payrolls/models/login_attempt"
 	"github.com/riskykurniawan15/payrolls/models/user"
+	authSessionRepo "github.com/riskykurniawan15/payrolls/repositories/auth_session"
+	departmentRepo "github.com/riskykurniawan15/payrolls/repositories/department"
+	instanceRepo "github.com/riskykurniawan15/payrolls/repositories/instance"
+	loginAttemptRepo "github.com/riskykurniawan15/payrolls/repositories/login_attempt"
+	roleRepo "github.com/riskykurniawan15/payrolls/repositories/role"
 	userRepo "github.com/riskykurniawan15/payrolls/repositories/user"
 	"github.com/riskykurniawan15/payrolls/utils/bcrypt"
+	"github.com/riskykurniawan15/payrolls/utils/identity"
 	"github.com/riskykurniawan15/payrolls/utils/jwt"
 	"github.com/riskykurniawan15/payrolls/utils/logger"
+	"github.com/riskykurniawan15/payrolls/utils/oidc"
+	"github.com/riskykurniawan15/payrolls/utils/throttle"
+	"github.com/riskykurniawan15/payrolls/utils/totp"
 )
 
 type (
 	IUserService interface {
 		Login(ctx context.Context, req user.LoginRequest) (user.LoginResponse, error)
 		Profile(ctx context.Context, userID uint) (user.ProfileResponse, error)
-		CreateUser(ctx context.Context, req user.CreateUserRequest) (user.CreateUserResponse, error)
+		CreateUser(ctx context.Context, req user.CreateUserRequest, createdBy uint) (user.CreateUserResponse, error)
+		ListUsers(ctx context.Context, req user.ListUsersRequest) (*user.ListUsersResponse, error)
+		GetUser(ctx context.Context, id uint) (*user.UserResponse, error)
+		UpdateUser(ctx context.Context, id uint, req user.UpdateUserRequest, updatedBy uint) (*user.UserResponse, error)
+		Deactivate(ctx context.Context, id uint, updatedBy uint) (*user.UserResponse, error)
+		Activate(ctx context.Context, id uint, updatedBy uint) (*user.UserResponse, error)
+		Unlock(ctx context.Context, id uint, updatedBy uint) (*user.UserResponse, error)
+		ResetPassword(ctx context.Context, id uint, updatedBy uint) (*user.PasswordResetTokenResponse, error)
+		RedeemPasswordReset(ctx context.Context, req user.ResetPasswordRequest) error
+		ChangePassword(ctx context.Context, userID uint, req user.ChangePasswordRequest) (user.LoginResponse, error)
+		GetEmployeeProfile(ctx context.Context, userID uint, unmasked bool) (*user.EmployeeProfileResponse, error)
+		SaveEmployeeProfile(ctx context.Context, userID uint, req user.SaveEmployeeProfileRequest, updatedBy uint) (*user.EmployeeProfileResponse, error)
+		UpdateOwnProfile(ctx context.Context, userID uint, req user.UpdateOwnProfileRequest) (*user.EmployeeProfileResponse, error)
+		Refresh(ctx context.Context, req user.RefreshTokenRequest) (user.LoginResponse, error)
+		Logout(ctx context.Context, tokenID string) error
+		RevokeSessions(ctx context.Context, userID uint, revokedBy uint) (*user.RevokeSessionsResponse, error)
+		IsTokenActive(ctx context.Context, tokenID string) bool
+		VerifyTwoFactor(ctx context.Context, req user.VerifyTwoFactorRequest) (user.LoginResponse, error)
+		TwoFactorStatus(ctx context.Context, userID uint) (*user.TwoFactorStatusResponse, error)
+		SetupTwoFactor(ctx context.Context, userID uint, req user.SetupTwoFactorRequest) (*user.TwoFactorSetupResponse, error)
+		EnableTwoFactor(ctx context.Context, userID uint, req user.TwoFactorCodeRequest) (*user.TwoFactorRecoveryCodesResponse, error)
+		DisableTwoFactor(ctx context.Context, userID uint, req user.DisableTwoFactorRequest) error
+		RegenerateRecoveryCodes(ctx context.Context, userID uint, req user.TwoFactorCodeRequest) (*user.TwoFactorRecoveryCodesResponse, error)
+		ResetTwoFactor(ctx context.Context, id uint, resetBy uint) (*user.UserResponse, error)
+		OIDCLogin(ctx context.Context) (*user.OIDCLoginResponse, error)
+		OIDCCallback(ctx context.Context, req user.OIDCCallbackRequest) (user.LoginResponse, error)
 	}
 
 	UserService struct {
-		config   config.Config
-		userRepo userRepo.IUserRepository
-		logger   logger.Logger
+		config           config.Config
+		userRepo         userRepo.IUserRepository
+		authSessionRepo  authSessionRepo.IAuthSessionRepository
+		loginAttemptRepo loginAttemptRepo.ILoginAttemptRepository
+		roleRepo         roleRepo.IRoleRepository
+		departmentRepo   departmentRepo.IDepartmentRepository
+		instanceRepo     instanceRepo.IInstanceRepository
+		oidcProvider     *oidc.Provider
+		logger           logger.Logger
+	}
+
+	// LoginBlockedError is returned when a login is refused before the password is checked
+	LoginBlockedError struct {
+		Reason     string
+		RetryAfter time.Duration
 	}
 )
 
-func NewUserService(config config.Config, userRepo userRepo.IUserRepository, logger logger.Logger) IUserService {
+// ErrSingleSignOnDisabled is returned by the single sign-on flow when no identity provider is configured
+var ErrSingleSignOnDisabled = errors.New("single sign-on is not enabled")
+
+// ErrProtectedUser is returned when the caller may manage users but the target holds access the caller does not
+var ErrProtectedUser = errors.New("user holds permissions you do not have, this requires the " + constant.PermissionRoleManage + " permission")
+
+// selfServicePermissions only act on the holder's own records, holding them gives no power over other users
+var selfServicePermissions = []string{
+	constant.PermissionAttendanceSelf,
+	constant.PermissionOvertimeSelf,
+	constant.PermissionReimbursementSelf,
+	constant.PermissionPayslipSelf,
+}
+
+// usernameInvalidChars are stripped from provider usernames before they become a username here
+var usernameInvalidChars = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+func NewUserService(config config.Config, userRepo userRepo.IUserRepository, authSessionRepo authSessionRepo.IAuthSessionRepository, loginAttemptRepo loginAttemptRepo.ILoginAttemptRepository, roleRepo roleRepo.IRoleRepository, departmentRepo departmentRepo.IDepartmentRepository, instanceRepo instanceRepo.IInstanceRepository, logger logger.Logger) IUserService {
+	var provider *oidc.Provider
+	if config.OIDC.Enabled {
+		provider = oidc.NewProvider(oidc.Config{
+			IssuerURL:    config.OIDC.IssuerURL,
+			ClientID:     config.OIDC.ClientID,
+			ClientSecret: config.OIDC.ClientSecret,
+			RedirectURL:  config.OIDC.RedirectURL,
+			Scopes:       config.OIDC.Scopes,
+			Timeout:      time.Duration(config.OIDC.Timeout) * time.Second,
+		})
+	}
+
 	return &UserService{
-		config:   config,
-		userRepo: userRepo,
-		logger:   logger,
+		config:           config,
+		userRepo:         userRepo,
+		authSessionRepo:  authSessionRepo,
+		loginAttemptRepo: loginAttemptRepo,
+		roleRepo:         roleRepo,
+		departmentRepo:   departmentRepo,
+		instanceRepo:     instanceRepo,
+		oidcProvider:     provider,
+		logger:           logger,
+	}
+}
+
+func (e *LoginBlockedError) Error() string {
+	if e.Reason == constant.LoginAttemptAccountLocked {
+		return "account is locked due to too many failed login attempts"
 	}
+	return "too many login attempts, please try again later"
 }
 
 func (service *UserService) Login(ctx context.Context, req user.LoginRequest) (response user.LoginResponse, err error) {
 	requestID := middleware.GetRequestIDFromContext(ctx)
+	now := time.Now()
 
 	service.logger.InfoT("starting login process", requestID, map[string]interface{}{
 		"username": req.Username,
+		"ip":       req.IP,
 	})
 
+	// Throttle by IP first so guessing across many usernames is slowed down as well
+	if blocked := service.checkIPThrottle(ctx, req.IP, now); blocked != nil {
+		service.recordLoginAttempt(ctx, req, nil, blocked.Reason)
+		return response, blocked
+	}
+
 	// Get user by username
 	userData, err := service.userRepo.GetUserByUsername(ctx, req.Username)
 	if err != nil {
@@ -49,9 +154,23 @@ func (service *UserService) Login(ctx context.Context, req user.LoginRequest) (r
 			"username": req.Username,
 			"error":    err.Error(),
 		})
+		service.recordLoginAttempt(ctx, req, nil, constant.LoginAttemptInvalidCredentials)
 		return response, errors.New("invalid username or password")
 	}
 
+	// Locked and throttled accounts are rejected before bcrypt runs
+	if blocked := service.checkAccountThrottle(userData, now); blocked != nil {
+		service.logger.WarningT("login rejected by account throttle", requestID, map[string]interface{}{
+			"username":     req.Username,
+			"user_id":      userData.ID,
+			"reason":       blocked.Reason,
+			"retry_after":  blocked.RetryAfter.Seconds(),
+			"failed_count": userData.FailedLoginCount,
+		})
+		service.recordLoginAttempt(ctx, req, &userData.ID, blocked.Reason)
+		return response, blocked
+	}
+
 	service.logger.InfoT("user found, verifying password", requestID, map[string]interface{}{
 		"username": req.Username,
 		"user_id":  userData.ID,
@@ -66,163 +185,2050 @@ func (service *UserService) Login(ctx context.Context, req user.LoginRequest) (r
 			"user_id":  userData.ID,
 			"error":    err.Error(),
 		})
+		service.recordLoginAttempt(ctx, req, &userData.ID, constant.LoginAttemptInvalidCredentials)
+		service.recordFailedLogin(ctx, userData, now)
 		return response, errors.New("invalid username or password")
 	}
 
+	// Deactivated users keep their credentials but may not log in
+	if userData.Status != constant.StatusActive {
+		service.logger.WarningT("login rejected for deactivated user", requestID, map[string]interface{}{
+			"username": req.Username,
+			"user_id":  userData.ID,
+		})
+		service.recordLoginAttempt(ctx, req, &userData.ID, constant.LoginAttemptDeactivated)
+		return response, errors.New("account is deactivated")
+	}
+
+	// A correct password clears the failures of the account, a wrong second factor counts again
+	service.resetFailedLogins(ctx, userData)
+
+	if userData.TwoFactorEnabled {
+		service.recordLoginAttempt(ctx, req, &userData.ID, constant.LoginAttemptTwoFactorChallenge)
+		return service.startTwoFactorChallenge(ctx, userData, req.IP, req.UserAgent)
+	}
+
+	service.recordLoginAttempt(ctx, req, &userData.ID, constant.LoginAttemptSuccess)
+
 	service.logger.InfoT("password verified, generating JWT token", requestID, map[string]interface{}{
 		"username": req.Username,
 		"user_id":  userData.ID,
 		"role":     userData.Role,
 	})
 
-	// Generate JWT token
-	jwtConfig := jwt.JWTConfig{
-		SecretKey: service.config.JWT.SecretKey,
-		Expired:   service.config.JWT.Expired,
+	// Every login starts a new session family
+	return service.issueSession(ctx, userData, uuid.NewString(), req.IP, req.UserAgent)
+}
+
+// resetFailedLogins clears the failure counters and lock of the account after a successful authentication step
+func (service *UserService) resetFailedLogins(ctx context.Context, userData user.User) {
+	if userData.FailedLoginCount == 0 && userData.LockedUntil == nil {
+		return
+	}
+	if err := service.userRepo.UpdateUser(ctx, userData.ID, map[string]interface{}{
+		"failed_login_count":   0,
+		"last_failed_login_at": nil,
+		"locked_until":         nil,
+	}); err != nil {
+		service.logger.ErrorT("failed to reset failed login count", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+	}
+}
+
+// checkIPThrottle blocks an IP after too many failures within the window and enforces a growing wait between failures
+func (service *UserService) checkIPThrottle(ctx context.Context, ip string, now time.Time) *LoginBlockedError {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	limit := service.config.LoginLimit
+	window := time.Duration(limit.Window) * time.Minute
+
+	stats, err := service.loginAttemptRepo.GetFailureStatsByIP(ctx, ip, now.Add(-window))
+	if err != nil {
+		// Failing open keeps login available when the attempt log cannot be read, account lockout still applies
+		service.logger.ErrorT("failed to read login attempts", requestID, map[string]interface{}{
+			"ip":    ip,
+			"error": err.Error(),
+		})
+		return nil
+	}
+	if stats.Count == 0 || stats.First == nil || stats.Last == nil {
+		return nil
+	}
+
+	if limit.IPMaxAttempts > 0 && stats.Count >= int64(limit.IPMaxAttempts) {
+		// The block lifts as soon as the oldest counted failure leaves the window
+		blocked := &LoginBlockedError{
+			Reason:     constant.LoginAttemptIPBlocked,
+			RetryAfter: throttle.RetryAfter(*stats.First, window, now),
+		}
+		service.logger.WarningT("login blocked for ip", requestID, map[string]interface{}{
+			"ip":          ip,
+			"failures":    stats.Count,
+			"retry_after": blocked.RetryAfter.Seconds(),
+		})
+		return blocked
+	}
+
+	delay := throttle.ProgressiveDelay(int(stats.Count), time.Duration(limit.DelayBase)*time.Second, time.Duration(limit.DelayMax)*time.Second)
+	if wait := throttle.RetryAfter(*stats.Last, delay, now); wait > 0 {
+		return &LoginBlockedError{Reason: constant.LoginAttemptIPThrottled, RetryAfter: wait}
+	}
+	return nil
+}
+
+// checkAccountThrottle rejects locked accounts and attempts made before the delay after the last failure passed
+func (service *UserService) checkAccountThrottle(userData user.User, now time.Time) *LoginBlockedError {
+	if userData.IsLockedAt(now) {
+		return &LoginBlockedError{
+			Reason:     constant.LoginAttemptAccountLocked,
+			RetryAfter: userData.LockedUntil.Sub(now),
+		}
 	}
 
-	token, expiresAt, err := jwt.GenerateToken(jwtConfig, userData.ID, userData.Username, userData.Role)
+	limit := service.config.LoginLimit
+	if userData.LastFailedLoginAt == nil || now.Sub(*userData.LastFailedLoginAt) > time.Duration(limit.Window)*time.Minute {
+		return nil
+	}
+
+	delay := throttle.ProgressiveDelay(userData.FailedLoginCount, time.Duration(limit.DelayBase)*time.Second, time.Duration(limit.DelayMax)*time.Second)
+	if wait := throttle.RetryAfter(*userData.LastFailedLoginAt, delay, now); wait > 0 {
+		return &LoginBlockedError{Reason: constant.LoginAttemptAccountThrottled, RetryAfter: wait}
+	}
+	return nil
+}
+
+// recordFailedLogin counts a wrong password against the account and locks it once the limit is reached
+func (service *UserService) recordFailedLogin(ctx context.Context, userData user.User, now time.Time) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	limit := service.config.LoginLimit
+
+	count, err := service.userRepo.RecordFailedLogin(ctx, userData.ID, now.Add(-time.Duration(limit.Window)*time.Minute))
 	if err != nil {
-		service.logger.ErrorT("failed to generate JWT token", requestID, map[string]interface{}{
+		service.logger.ErrorT("failed to record failed login", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if limit.MaxAttempts <= 0 || count < limit.MaxAttempts {
+		return
+	}
+
+	lockedUntil := now.Add(time.Duration(limit.LockoutTime) * time.Minute)
+	if err := service.userRepo.UpdateUser(ctx, userData.ID, map[string]interface{}{
+		"locked_until": lockedUntil,
+	}); err != nil {
+		service.logger.ErrorT("failed to lock account", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	service.logger.WarningT("account locked after failed logins", requestID, map[string]interface{}{
+		"user_id":      userData.ID,
+		"username":     userData.Username,
+		"failed_count": count,
+		"locked_until": lockedUntil,
+	})
+}
+
+func (service *UserService) recordLoginAttempt(ctx context.Context, req user.LoginRequest, userID *uint, reason string) {
+	attempt := &login_attempt.LoginAttempt{
+		Username: req.Username,
+		UserID:   userID,
+		IP:       req.IP,
+		Success:  reason == constant.LoginAttemptSuccess,
+		Reason:   reason,
+	}
+	if req.UserAgent != "" {
+		attempt.UserAgent = &req.UserAgent
+	}
+
+	if err := service.loginAttemptRepo.Create(ctx, attempt); err != nil {
+		service.logger.ErrorT("failed to record login attempt", middleware.GetRequestIDFromContext(ctx), map[string]interface{}{
 			"username": req.Username,
-			"user_id":  userData.ID,
 			"error":    err.Error(),
 		})
-		return response, err
 	}
+}
 
-	service.logger.InfoT("JWT token generated successfully", requestID, map[string]interface{}{
-		"username":   req.Username,
-		"user_id":    userData.ID,
-		"role":       userData.Role,
-		"expires_at": expiresAt,
-	})
+// OIDCLogin starts a sign-in at the identity provider, the state, nonce and PKCE verifier are kept until the callback
+func (service *UserService) OIDCLogin(ctx context.Context) (*user.OIDCLoginResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	if service.oidcProvider == nil {
+		return nil, ErrSingleSignOnDisabled
+	}
 
-	return user.LoginResponse{
-		Token:     token,
-		ExpiresAt: expiresAt,
-		User: user.UserInfo{
-			ID:       userData.ID,
-			Username: userData.Username,
-			Role:     userData.Role,
-		},
+	state, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		return nil, errors.New("failed to start single sign-on")
+	}
+	nonce, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		return nil, errors.New("failed to start single sign-on")
+	}
+	verifier, err := oidc.GenerateVerifier()
+	if err != nil {
+		return nil, errors.New("failed to start single sign-on")
+	}
+
+	authorizationURL, err := service.oidcProvider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		service.logger.ErrorT("failed to reach identity provider", requestID, map[string]interface{}{
+			"issuer": service.config.OIDC.IssuerURL,
+			"error":  err.Error(),
+		})
+		return nil, errors.New("identity provider is unavailable")
+	}
+
+	loginState := &user.OIDCLoginState{
+		StateHash:    jwt.HashToken(state),
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    time.Now().Add(time.Duration(service.config.OIDC.StateTTL) * time.Minute),
+	}
+	if err := service.userRepo.CreateOIDCLoginState(ctx, loginState); err != nil {
+		service.logger.ErrorT("failed to store single sign-on state", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, errors.New("failed to start single sign-on")
+	}
+
+	return &user.OIDCLoginResponse{
+		AuthorizationURL: authorizationURL,
+		ExpiresAt:        loginState.ExpiresAt,
 	}, nil
 }
 
-func (service *UserService) Profile(ctx context.Context, userID uint) (response user.ProfileResponse, err error) {
+// OIDCCallback finishes a sign-in at the identity provider and opens a session for the matching user
+func (service *UserService) OIDCCallback(ctx context.Context, req user.OIDCCallbackRequest) (response user.LoginResponse, err error) {
 	requestID := middleware.GetRequestIDFromContext(ctx)
+	if service.oidcProvider == nil {
+		return response, ErrSingleSignOnDisabled
+	}
 
-	service.logger.InfoT("starting profile retrieval", requestID, map[string]interface{}{
-		"user_id": userID,
-	})
+	// The state is redeemed before anything else so a callback can only be handled once
+	loginState, err := service.userRepo.GetOIDCLoginStateByHash(ctx, jwt.HashToken(req.State))
+	if err != nil || !loginState.IsUsableAt(time.Now()) {
+		service.logger.WarningT("single sign-on state is invalid or expired", requestID, map[string]interface{}{
+			"ip": req.IP,
+		})
+		return response, errors.New("invalid or expired sign-in")
+	}
+	used, err := service.userRepo.UseOIDCLoginState(ctx, loginState.ID)
+	if err != nil {
+		service.logger.ErrorT("failed to redeem single sign-on state", requestID, map[string]interface{}{
+			"state_id": loginState.ID,
+			"error":    err.Error(),
+		})
+		return response, errors.New("failed to complete sign-in")
+	}
+	if !used {
+		return response, errors.New("invalid or expired sign-in")
+	}
 
-	// Get user data from database
-	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if req.Error != "" {
+		service.logger.WarningT("identity provider rejected the sign-in", requestID, map[string]interface{}{
+			"error":       req.Error,
+			"description": req.ErrorDescription,
+		})
+		return response, errors.New("sign-in was rejected by the identity provider")
+	}
+	if req.Code == "" {
+		return response, errors.New("authorization code is missing")
+	}
+
+	claims, err := service.oidcProvider.Exchange(ctx, req.Code, loginState.CodeVerifier, loginState.Nonce)
 	if err != nil {
-		service.logger.WarningT("user not found in database", requestID, map[string]interface{}{
-			"user_id": userID,
+		service.logger.WarningT("failed to verify single sign-on", requestID, map[string]interface{}{
+			"ip":    req.IP,
+			"error": err.Error(),
+		})
+		return response, errors.New("sign-in could not be verified")
+	}
+
+	attempt := user.LoginRequest{Username: claims.Subject, IP: req.IP, UserAgent: req.UserAgent}
+	if claims.Email != "" {
+		attempt.Username = claims.Email
+	}
+
+	userData, err := service.findSingleSignOnUser(ctx, claims)
+	if err != nil {
+		service.logger.WarningT("no user for single sign-on", requestID, map[string]interface{}{
+			"subject": claims.Subject,
+			"email":   claims.Email,
 			"error":   err.Error(),
 		})
-		return response, errors.New("user not found")
+		service.recordLoginAttempt(ctx, attempt, nil, constant.LoginAttemptSSOUnknownUser)
+		return response, err
 	}
+	attempt.Username = userData.Username
 
-	service.logger.InfoT("user profile retrieved successfully", requestID, map[string]interface{}{
-		"user_id":    userID,
-		"username":   userData.Username,
-		"role":       userData.Role,
-		"created_at": userData.CreatedAt,
-		"updated_at": userData.UpdatedAt,
+	if userData.Status != constant.StatusActive {
+		service.logger.WarningT("single sign-on rejected for deactivated user", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+		})
+		service.recordLoginAttempt(ctx, attempt, &userData.ID, constant.LoginAttemptDeactivated)
+		return response, errors.New("account is deactivated")
+	}
+
+	// Accounts with an authenticator still need it, the identity provider only replaces the password
+	if userData.TwoFactorEnabled {
+		service.recordLoginAttempt(ctx, attempt, &userData.ID, constant.LoginAttemptTwoFactorChallenge)
+		return service.startTwoFactorChallenge(ctx, userData, req.IP, req.UserAgent)
+	}
+
+	service.recordLoginAttempt(ctx, attempt, &userData.ID, constant.LoginAttemptSuccess)
+
+	service.logger.InfoT("single sign-on verified, generating JWT token", requestID, map[string]interface{}{
+		"user_id": userData.ID,
+		"subject": claims.Subject,
 	})
 
-	return user.ProfileResponse{
-		ID:        userData.ID,
-		Username:  userData.Username,
-		Role:      userData.Role,
-		CreatedAt: userData.CreatedAt,
-		UpdatedAt: userData.UpdatedAt,
-	}, nil
+	return service.issueSession(ctx, userData, uuid.NewString(), req.IP, req.UserAgent)
 }
 
-func (service *UserService) CreateUser(ctx context.Context, req user.CreateUserRequest) (response user.CreateUserResponse, err error) {
+// findSingleSignOnUser returns the user linked to the subject, otherwise the user with the verified email
+// who is linked on the way, and provisions a new user when that is enabled
+func (service *UserService) findSingleSignOnUser(ctx context.Context, claims *oidc.Claims) (user.User, error) {
 	requestID := middleware.GetRequestIDFromContext(ctx)
 
-	service.logger.InfoT("starting user creation process", requestID, map[string]interface{}{
-		"username": req.Username,
-		"role":     req.Role,
-	})
+	if userData, err := service.userRepo.GetUserByOIDCSubject(ctx, claims.Subject); err == nil {
+		return userData, nil
+	}
 
-	// Check if username already exists
-	existingUser, _ := service.userRepo.GetUserByUsername(ctx, req.Username)
-	if existingUser.ID != 0 {
-		service.logger.WarningT("username already exists", requestID, map[string]interface{}{
-			"username":      req.Username,
-			"existing_id":   existingUser.ID,
-			"existing_role": existingUser.Role,
-		})
-		return response, errors.New("username already exists")
+	// An unverified email could be set by anyone at the provider, it is never trusted to pick an account
+	if service.config.OIDC.MatchClaim == constant.OIDCMatchEmail && claims.Email != "" && claims.EmailVerified {
+		userData, err := service.userRepo.GetUserByEmail(ctx, claims.Email)
+		if err == nil {
+			if userData.OIDCSubject != nil {
+				return user.User{}, errors.New("account is linked to another identity")
+			}
+			if err := service.userRepo.UpdateUser(ctx, userData.ID, map[string]interface{}{
+				"oidc_subject": claims.Subject,
+				"updated_at":   time.Now(),
+			}); err != nil {
+				service.logger.ErrorT("failed to link single sign-on identity", requestID, map[string]interface{}{
+					"user_id": userData.ID,
+					"error":   err.Error(),
+				})
+				return user.User{}, errors.New("failed to complete sign-in")
+			}
+
+			service.logger.InfoT("single sign-on identity linked", requestID, map[string]interface{}{
+				"user_id": userData.ID,
+				"subject": claims.Subject,
+			})
+			userData.OIDCSubject = &claims.Subject
+			return userData, nil
+		}
 	}
 
-	service.logger.InfoT("username is available, validating password", requestID, map[string]interface{}{
-		"username": req.Username,
-	})
+	if !service.config.OIDC.AutoProvision {
+		return user.User{}, errors.New("no account is linked to this identity")
+	}
+	return service.provisionSingleSignOnUser(ctx, claims)
+}
 
-	// Validate password strength
-	if !bcrypt.IsValidPassword(req.Password, 6) {
-		service.logger.WarningT("password validation failed", requestID, map[string]interface{}{
-			"username": req.Username,
-			"error":    "password must be at least 6 characters long",
+// provisionSingleSignOnUser creates the user of a first sign-in with the configured default role,
+// the random password is never handed out so the account signs in through the identity provider only
+func (service *UserService) provisionSingleSignOnUser(ctx context.Context, claims *oidc.Claims) (user.User, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	defaultRole, err := service.roleRepo.GetRoleByName(ctx, service.config.OIDC.DefaultRole)
+	if err != nil {
+		service.logger.ErrorT("default role for provisioned users not found", requestID, map[string]interface{}{
+			"role":  service.config.OIDC.DefaultRole,
+			"error": err.Error(),
 		})
-		return response, errors.New("password must be at least 6 characters long")
+		return user.User{}, errors.New("failed to provision account")
 	}
 
-	service.logger.InfoT("password validated, hashing password", requestID, map[string]interface{}{
-		"username": req.Username,
-	})
+	username, err := service.availableUsername(ctx, claims)
+	if err != nil {
+		return user.User{}, err
+	}
 
-	// Hash password using environment cost
-	hashedPassword, err := bcrypt.HashPasswordWithEnvCost(req.Password)
+	secret, err := jwt.GenerateRefreshToken()
 	if err != nil {
-		service.logger.ErrorT("failed to hash password", requestID, map[string]interface{}{
-			"username": req.Username,
+		return user.User{}, errors.New("failed to provision account")
+	}
+	hashedPassword, err := bcrypt.HashPasswordWithEnvCost(secret)
+	if err != nil {
+		return user.User{}, errors.New("failed to provision account")
+	}
+
+	// users.roles only knows the system roles, custom roles are granted on top of the employee role
+	systemRole := constant.EmployeeRole
+	if defaultRole.IsSystem {
+		systemRole = defaultRole.Name
+	}
+
+	userData := user.User{
+		Username:    username,
+		Password:    hashedPassword,
+		Role:        systemRole,
+		OIDCSubject: &claims.Subject,
+		Status:      constant.StatusActive,
+	}
+	if claims.Email != "" && claims.EmailVerified {
+		userData.Email = &claims.Email
+	}
+
+	createdUser, err := service.userRepo.CreateUser(ctx, userData)
+	if err != nil {
+		service.logger.ErrorT("failed to provision single sign-on user", requestID, map[string]interface{}{
+			"username": username,
+			"subject":  claims.Subject,
 			"error":    err.Error(),
 		})
-		return response, errors.New("failed to hash password")
+		return user.User{}, errors.New("failed to provision account")
 	}
 
-	service.logger.InfoT("password hashed successfully, creating user in database", requestID, map[string]interface{}{
-		"username": req.Username,
-		"role":     req.Role,
+	if !defaultRole.IsSystem {
+		if err := service.roleRepo.SetUserRoles(ctx, createdUser.ID, []uint{defaultRole.ID}, createdUser.ID); err != nil {
+			service.logger.ErrorT("failed to grant default role to provisioned user", requestID, map[string]interface{}{
+				"user_id": createdUser.ID,
+				"role":    defaultRole.Name,
+				"error":   err.Error(),
+			})
+			return user.User{}, errors.New("failed to provision account")
+		}
+	}
+
+	service.logger.InfoT("single sign-on user provisioned", requestID, map[string]interface{}{
+		"user_id":  createdUser.ID,
+		"username": createdUser.Username,
+		"role":     defaultRole.Name,
+		"subject":  claims.Subject,
 	})
+	return createdUser, nil
+}
 
-	// Create user data
-	userData := user.User{
-		Username: req.Username,
-		Password: hashedPassword,
-		Role:     req.Role,
+// availableUsername derives a username from the preferred username or email, a number is appended when it is taken
+func (service *UserService) availableUsername(ctx context.Context, claims *oidc.Claims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+	base = usernameInvalidChars.ReplaceAllString(strings.ToLower(base), "")
+	if len(base) > 40 {
+		base = base[:40]
+	}
+	if len(base) < 3 {
+		base = "sso-user"
 	}
 
-	// Save user to database
-	createdUser, err := service.userRepo.CreateUser(ctx, userData)
+	for i := 1; i <= 20; i++ {
+		candidate := base
+		if i > 1 {
+			candidate = fmt.Sprintf("%s-%d", base, i)
+		}
+		if existing, _ := service.userRepo.GetUserByUsername(ctx, candidate); existing.ID == 0 {
+			return candidate, nil
+		}
+	}
+	return "", errors.New("failed to provision account")
+}
+
+func (service *UserService) Refresh(ctx context.Context, req user.RefreshTokenRequest) (response user.LoginResponse, err error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	session, err := service.authSessionRepo.GetByRefreshTokenHash(ctx, jwt.HashToken(req.RefreshToken))
 	if err != nil {
-		service.logger.ErrorT("failed to create user in database", requestID, map[string]interface{}{
-			"username": req.Username,
-			"role":     req.Role,
+		service.logger.WarningT("refresh token not found", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return response, errors.New("invalid refresh token")
+	}
+
+	// A rotated token presented again means it was copied, sign the whole family out
+	if session.RevokedAt != nil {
+		if session.RevokedReason != nil && *session.RevokedReason == constant.SessionRevokedRotated {
+			revoked, _ := service.authSessionRepo.RevokeFamily(ctx, session.FamilyID, constant.SessionRevokedReuse)
+			service.logger.WarningT("refresh token reuse detected", requestID, map[string]interface{}{
+				"user_id":   session.UserID,
+				"family_id": session.FamilyID,
+				"revoked":   revoked,
+			})
+		}
+		return response, errors.New("invalid refresh token")
+	}
+
+	if !session.IsActiveAt(time.Now()) {
+		return response, errors.New("refresh token expired")
+	}
+
+	userData, err := service.userRepo.GetUserByID(ctx, session.UserID)
+	if err != nil || userData.Status != constant.StatusActive {
+		service.authSessionRepo.RevokeFamily(ctx, session.FamilyID, constant.SessionRevokedDeactivated)
+		return response, errors.New("account is deactivated")
+	}
+
+	// Revoking first makes a concurrent refresh with the same token lose the race
+	active, err := service.authSessionRepo.Revoke(ctx, session.ID, constant.SessionRevokedRotated)
+	if err != nil {
+		service.logger.ErrorT("failed to rotate refresh token", requestID, map[string]interface{}{
+			"session_id": session.ID,
+			"error":      err.Error(),
+		})
+		return response, errors.New("failed to refresh token")
+	}
+	if !active {
+		return response, errors.New("invalid refresh token")
+	}
+
+	service.logger.InfoT("refresh token rotated", requestID, map[string]interface{}{
+		"user_id":   userData.ID,
+		"family_id": session.FamilyID,
+	})
+
+	return service.issueSession(ctx, userData, session.FamilyID, req.IP, req.UserAgent)
+}
+
+func (service *UserService) Logout(ctx context.Context, tokenID string) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	session, err := service.authSessionRepo.GetByTokenID(ctx, tokenID)
+	if err != nil {
+		service.logger.WarningT("session not found for logout", requestID, map[string]interface{}{
+			"token_id": tokenID,
 			"error":    err.Error(),
 		})
-		return response, errors.New("failed to create user")
+		return errors.New("session not found")
 	}
 
-	service.logger.InfoT("user created successfully", requestID, map[string]interface{}{
-		"user_id":    createdUser.ID,
-		"username":   createdUser.Username,
-		"role":       createdUser.Role,
-		"created_at": createdUser.CreatedAt,
+	// Logging out ends the session on this device, including refresh tokens rotated from it
+	revoked, err := service.authSessionRepo.RevokeFamily(ctx, session.FamilyID, constant.SessionRevokedLogout)
+	if err != nil {
+		service.logger.ErrorT("failed to revoke session", requestID, map[string]interface{}{
+			"user_id": session.UserID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to logout: %w", err)
+	}
+
+	service.logger.InfoT("user logged out", requestID, map[string]interface{}{
+		"user_id": session.UserID,
+		"revoked": revoked,
 	})
 
-	return user.CreateUserResponse{
+	return nil
+}
+
+func (service *UserService) RevokeSessions(ctx context.Context, userID uint, revokedBy uint) (*user.RevokeSessionsResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if err := service.checkManageable(ctx, userData, revokedBy); err != nil {
+		return nil, err
+	}
+
+	revoked, err := service.authSessionRepo.RevokeByUserID(ctx, userID, constant.SessionRevokedByAdmin)
+	if err != nil {
+		service.logger.ErrorT("failed to revoke user sessions", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	service.logger.InfoT("user sessions revoked by admin", requestID, map[string]interface{}{
+		"user_id":    userID,
+		"revoked_by": revokedBy,
+		"revoked":    revoked,
+	})
+
+	return &user.RevokeSessionsResponse{
+		UserID:  userID,
+		Revoked: revoked,
+	}, nil
+}
+
+// IsTokenActive is used by the JWT middleware to reject access tokens of revoked sessions
+func (service *UserService) IsTokenActive(ctx context.Context, tokenID string) bool {
+	if tokenID == "" {
+		return false
+	}
+	session, err := service.authSessionRepo.GetByTokenID(ctx, tokenID)
+	if err != nil {
+		return false
+	}
+	return session.IsActiveAt(time.Now())
+}
+
+// issueSession signs a short-lived access token and stores a new refresh token in the given family
+func (service *UserService) issueSession(ctx context.Context, userData user.User, familyID, ip, userAgent string) (response user.LoginResponse, err error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Holders of a role that requires two-factor get a token limited to enrolment until they enable it
+	setupRequired := false
+	if !userData.TwoFactorEnabled {
+		if setupRequired, err = service.roleRepo.UserRequiresTwoFactor(ctx, userData.ID); err != nil {
+			service.logger.ErrorT("failed to check two-factor policy", requestID, map[string]interface{}{
+				"user_id": userData.ID,
+				"error":   err.Error(),
+			})
+			return response, errors.New("failed to create session")
+		}
+	}
+
+	tokenID := uuid.NewString()
+	accessTTL := time.Duration(service.config.JWT.AccessExpired) * time.Minute
+	token, expiresAt, err := jwt.GenerateTokenWithID(service.config.JWT.SecretKey, accessTTL, tokenID, userData.ID, userData.Username, userData.Role, userData.MustChangePassword, setupRequired)
+	if err != nil {
+		service.logger.ErrorT("failed to generate JWT token", requestID, map[string]interface{}{
+			"username": userData.Username,
+			"user_id":  userData.ID,
+			"error":    err.Error(),
+		})
+		return response, err
+	}
+
+	refreshToken, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		service.logger.ErrorT("failed to generate refresh token", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return response, err
+	}
+
+	session := &auth_session.AuthSession{
+		UserID:           userData.ID,
+		FamilyID:         familyID,
+		TokenID:          tokenID,
+		RefreshTokenHash: jwt.HashToken(refreshToken),
+		ExpiresAt:        time.Now().Add(time.Duration(service.config.JWT.RefreshExpired) * time.Hour),
+	}
+	if ip != "" {
+		session.IP = &ip
+	}
+	if userAgent != "" {
+		session.UserAgent = &userAgent
+	}
+
+	if err := service.authSessionRepo.Create(ctx, session); err != nil {
+		service.logger.ErrorT("failed to store session", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return response, errors.New("failed to create session")
+	}
+
+	service.logger.InfoT("JWT token generated successfully", requestID, map[string]interface{}{
+		"username":   userData.Username,
+		"user_id":    userData.ID,
+		"role":       userData.Role,
+		"expires_at": expiresAt,
+	})
+
+	return user.LoginResponse{
+		Token:                  token,
+		ExpiresAt:              expiresAt,
+		RefreshToken:           refreshToken,
+		RefreshExpiresAt:       session.ExpiresAt,
+		MustChangePassword:     userData.MustChangePassword,
+		TwoFactorSetupRequired: setupRequired,
+		User: user.UserInfo{
+			ID:       userData.ID,
+			Username: userData.Username,
+			Role:     userData.Role,
+		},
+	}, nil
+}
+
+func (service *UserService) Profile(ctx context.Context, userID uint) (response user.ProfileResponse, err error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	service.logger.InfoT("starting profile retrieval", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// Get user data from database
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		service.logger.WarningT("user not found in database", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return response, errors.New("user not found")
+	}
+
+	service.logger.InfoT("user profile retrieved successfully", requestID, map[string]interface{}{
+		"user_id":    userID,
+		"username":   userData.Username,
+		"role":       userData.Role,
+		"created_at": userData.CreatedAt,
+		"updated_at": userData.UpdatedAt,
+	})
+
+	return user.ProfileResponse{
+		ID:        userData.ID,
+		Username:  userData.Username,
+		Role:      userData.Role,
+		CreatedAt: userData.CreatedAt,
+		UpdatedAt: userData.UpdatedAt,
+	}, nil
+}
+
+func (service *UserService) CreateUser(ctx context.Context, req user.CreateUserRequest, createdBy uint) (response user.CreateUserResponse, err error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	service.logger.InfoT("starting user creation process", requestID, map[string]interface{}{
+		"username": req.Username,
+		"role":     req.Role,
+	})
+
+	// Check if username already exists
+	existingUser, _ := service.userRepo.GetUserByUsername(ctx, req.Username)
+	if existingUser.ID != 0 {
+		service.logger.WarningT("username already exists", requestID, map[string]interface{}{
+			"username":      req.Username,
+			"existing_id":   existingUser.ID,
+			"existing_role": existingUser.Role,
+		})
+		return response, errors.New("username already exists")
+	}
+
+	// The email links the corporate single sign-on account, it has to be unique as well
+	if req.Email != nil && *req.Email != "" {
+		if existing, _ := service.userRepo.GetUserByEmail(ctx, *req.Email); existing.ID != 0 {
+			return response, errors.New("email already in use")
+		}
+	} else {
+		req.Email = nil
+	}
+
+	service.logger.InfoT("username is available, validating password", requestID, map[string]interface{}{
+		"username": req.Username,
+	})
+
+	// Validate password strength
+	if !bcrypt.IsValidPassword(req.Password, 6) {
+		service.logger.WarningT("password validation failed", requestID, map[string]interface{}{
+			"username": req.Username,
+			"error":    "password must be at least 6 characters long",
+		})
+		return response, errors.New("password must be at least 6 characters long")
+	}
+
+	service.logger.InfoT("password validated, hashing password", requestID, map[string]interface{}{
+		"username": req.Username,
+	})
+
+	// Hash password using environment cost
+	hashedPassword, err := bcrypt.HashPasswordWithEnvCost(req.Password)
+	if err != nil {
+		service.logger.ErrorT("failed to hash password", requestID, map[string]interface{}{
+			"username": req.Username,
+			"error":    err.Error(),
+		})
+		return response, errors.New("failed to hash password")
+	}
+
+	service.logger.InfoT("password hashed successfully, creating user in database", requestID, map[string]interface{}{
+		"username": req.Username,
+		"role":     req.Role,
+	})
+
+	// Create user data
+	userData := user.User{
+		Username:       req.Username,
+		Password:       hashedPassword,
+		Email:          req.Email,
+		Role:           req.Role,
+		Salary:         req.Salary,
+		Grade:          req.Grade,
+		EmploymentType: req.EmploymentType,
+		Status:         constant.StatusActive,
+		CreatedBy:      &createdBy,
+		// The admin chose this password, the user replaces it on first login
+		MustChangePassword: true,
+	}
+
+	// Save user to database
+	createdUser, err := service.userRepo.CreateUser(ctx, userData)
+	if err != nil {
+		service.logger.ErrorT("failed to create user in database", requestID, map[string]interface{}{
+			"username": req.Username,
+			"role":     req.Role,
+			"error":    err.Error(),
+		})
+		return response, errors.New("failed to create user")
+	}
+
+	service.logger.InfoT("user created successfully", requestID, map[string]interface{}{
+		"user_id":    createdUser.ID,
+		"username":   createdUser.Username,
+		"role":       createdUser.Role,
+		"created_at": createdUser.CreatedAt,
+	})
+
+	return user.CreateUserResponse{
 		ID:        createdUser.ID,
 		Username:  createdUser.Username,
 		Role:      createdUser.Role,
 		CreatedAt: createdUser.CreatedAt,
 	}, nil
 }
+
+func (service *UserService) ListUsers(ctx context.Context, req user.ListUsersRequest) (*user.ListUsersResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	// Set default values if not provided
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	service.logger.InfoT("processing list users request", requestID, map[string]interface{}{
+		"page":   req.Page,
+		"limit":  req.Limit,
+		"search": req.Search,
+		"role":   req.Role,
+		"status": req.Status,
+	})
+
+	response, err := service.userRepo.ListUsers(ctx, req)
+	if err != nil {
+		service.logger.ErrorT("failed to list users", requestID, map[string]interface{}{
+			"error": err.Error(),
+			"page":  req.Page,
+			"limit": req.Limit,
+		})
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	service.logger.InfoT("users listed successfully", requestID, map[string]interface{}{
+		"total_count":  response.Pagination.Total,
+		"total_pages":  response.Pagination.TotalPages,
+		"current_page": response.Pagination.Page,
+		"data_count":   len(response.Data),
+	})
+
+	return response, nil
+}
+
+func (service *UserService) GetUser(ctx context.Context, id uint) (*user.UserResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		service.logger.WarningT("user not found", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+
+	response := userData.ToResponse()
+	return &response, nil
+}
+
+func (service *UserService) UpdateUser(ctx context.Context, id uint, req user.UpdateUserRequest, updatedBy uint) (*user.UserResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	service.logger.InfoT("processing update user request", requestID, map[string]interface{}{
+		"user_id":    id,
+		"role":       req.Role,
+		"salary":     req.Salary,
+		"updated_by": updatedBy,
+	})
+
+	userData, err := service.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		service.logger.WarningT("user not found for update", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+
+	// An admin demoting themselves could leave nobody able to manage users
+	if req.Role != nil && *req.Role != userData.Role && id == updatedBy {
+		return nil, errors.New("cannot change your own role")
+	}
+
+	// The email and identity link the single sign-on login, changing them hands over the account
+	if req.Email != nil || req.OIDCSubject != nil {
+		if err := service.checkManageable(ctx, userData, updatedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	// Prepare updates
+	updates := make(map[string]interface{})
+	updates["updated_by"] = updatedBy
+	updates["updated_at"] = time.Now()
+
+	if req.Role != nil {
+		updates["roles"] = *req.Role
+	}
+	if req.Salary != nil {
+		updates["salary"] = *req.Salary
+	}
+	if req.Grade != nil {
+		updates["grade"] = *req.Grade
+	}
+	if req.EmploymentType != nil {
+		updates["employment_type"] = *req.EmploymentType
+	}
+	if req.WorkStartTime != nil {
+		updates["work_start_time"] = *req.WorkStartTime
+	}
+	if req.WorkEndTime != nil {
+		updates["work_end_time"] = *req.WorkEndTime
+	}
+	if req.Email != nil {
+		if *req.Email == "" {
+			updates["email"] = nil
+		} else if existing, _ := service.userRepo.GetUserByEmail(ctx, *req.Email); existing.ID != 0 && existing.ID != id {
+			return nil, errors.New("email already in use")
+		} else {
+			updates["email"] = *req.Email
+		}
+	}
+	if req.OIDCSubject != nil {
+		if *req.OIDCSubject == "" {
+			updates["oidc_subject"] = nil
+		} else if existing, _ := service.userRepo.GetUserByOIDCSubject(ctx, *req.OIDCSubject); existing.ID != 0 && existing.ID != id {
+			return nil, errors.New("identity is already linked to another user")
+		} else {
+			updates["oidc_subject"] = *req.OIDCSubject
+		}
+	}
+	if req.DepartmentID != nil {
+		if *req.DepartmentID == 0 {
+			updates["department_id"] = nil
+		} else if _, err := service.departmentRepo.GetDepartmentByID(ctx, *req.DepartmentID); err != nil {
+			return nil, errors.New("department not found")
+		} else {
+			updates["department_id"] = *req.DepartmentID
+		}
+	}
+	if req.ManagerID != nil {
+		if *req.ManagerID == 0 {
+			updates["manager_id"] = nil
+		} else if err := service.checkManager(ctx, id, *req.ManagerID); err != nil {
+			return nil, err
+		} else {
+			updates["manager_id"] = *req.ManagerID
+		}
+	}
+
+	if err := service.userRepo.UpdateUser(ctx, id, updates); err != nil {
+		service.logger.ErrorT("failed to update user", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	service.logger.InfoT("user updated successfully", requestID, map[string]interface{}{
+		"user_id":         id,
+		"previous_role":   userData.Role,
+		"previous_salary": userData.Salary,
+	})
+
+	return service.GetUser(ctx, id)
+}
+
+// checkManageable guards changes that hand over or cut off a user's account. Without role:manage the caller,
+// using the permissions from the request context, may only touch plain employees whose permissions they hold
+// themselves, otherwise user:manage could be used to take over an admin account.
+func (service *UserService) checkManageable(ctx context.Context, target user.User, callerID uint) error {
+	callerPermissions := middleware.GetPermissionsFromContext(ctx)
+	if target.ID == callerID || slices.Contains(callerPermissions, constant.PermissionRoleManage) {
+		return nil
+	}
+	if target.Role != constant.EmployeeRole {
+		return ErrProtectedUser
+	}
+
+	targetPermissions, err := service.roleRepo.GetUserPermissions(ctx, target.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get user permissions: %w", err)
+	}
+	for _, permission := range targetPermissions {
+		if !slices.Contains(selfServicePermissions, permission) && !slices.Contains(callerPermissions, permission) {
+			return ErrProtectedUser
+		}
+	}
+	return nil
+}
+
+// checkManager makes sure managerID can manage the user without closing a loop in the reporting line
+func (service *UserService) checkManager(ctx context.Context, id, managerID uint) error {
+	if managerID == id {
+		return errors.New("user cannot be their own manager")
+	}
+
+	manager, err := service.userRepo.GetUserByID(ctx, managerID)
+	if err != nil {
+		return errors.New("manager not found")
+	}
+	if manager.Status != constant.StatusActive {
+		return errors.New("manager is not active")
+	}
+
+	reports, err := service.departmentRepo.GetReportIDs(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to load reports: %w", err)
+	}
+	if slices.Contains(reports, managerID) {
+		return errors.New("manager already reports to this user")
+	}
+	return nil
+}
+
+func (service *UserService) Deactivate(ctx context.Context, id uint, updatedBy uint) (*user.UserResponse, error) {
+	if id == updatedBy {
+		return nil, errors.New("cannot deactivate your own account")
+	}
+	return service.setStatus(ctx, id, constant.StatusInactive, updatedBy)
+}
+
+func (service *UserService) Activate(ctx context.Context, id uint, updatedBy uint) (*user.UserResponse, error) {
+	return service.setStatus(ctx, id, constant.StatusActive, updatedBy)
+}
+
+// Unlock clears the failed logins of a user so a locked account can log in again right away
+func (service *UserService) Unlock(ctx context.Context, id uint, updatedBy uint) (*user.UserResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		service.logger.WarningT("user not found for unlock", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+
+	updates := map[string]interface{}{
+		"failed_login_count":   0,
+		"last_failed_login_at": nil,
+		"locked_until":         nil,
+		"updated_by":           updatedBy,
+		"updated_at":           time.Now(),
+	}
+	if err := service.userRepo.UpdateUser(ctx, id, updates); err != nil {
+		service.logger.ErrorT("failed to unlock user", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to unlock user: %w", err)
+	}
+
+	service.logger.InfoT("user unlocked", requestID, map[string]interface{}{
+		"user_id":      id,
+		"failed_count": userData.FailedLoginCount,
+		"locked_until": userData.LockedUntil,
+		"unlocked_by":  updatedBy,
+	})
+
+	return service.GetUser(ctx, id)
+}
+
+// setStatus activates or deactivates a user, deactivated users cannot log in and are left out of payroll.
+// Deactivation revokes the sessions in the same transaction, it fails rather than leave the user signed in
+func (service *UserService) setStatus(c context.Context, id uint, status int8, updatedBy uint) (*user.UserResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(c)
+	service.logger.InfoT("processing user status change", requestID, map[string]interface{}{
+		"user_id":    id,
+		"status":     status,
+		"updated_by": updatedBy,
+	})
+
+	userData, err := service.userRepo.GetUserByID(c, id)
+	if err != nil {
+		service.logger.WarningT("user not found for status change", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+	if err := service.checkManageable(c, userData, updatedBy); err != nil {
+		return nil, err
+	}
+
+	if userData.Status == status {
+		response := userData.ToResponse()
+		return &response, nil
+	}
+
+	ctx, tx, err := service.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		service.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to change user status: %w", err)
+	}
+	defer tx.Rollback()
+
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_by": updatedBy,
+		"updated_at": time.Now(),
+	}
+	if err := service.userRepo.UpdateUser(ctx, id, updates); err != nil {
+		service.logger.ErrorT("failed to change user status", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to change user status: %w", err)
+	}
+
+	// Deactivation signs the user out everywhere
+	if status != constant.StatusActive {
+		if _, err := service.authSessionRepo.RevokeByUserID(ctx, id, constant.SessionRevokedDeactivated); err != nil {
+			service.logger.ErrorT("failed to revoke sessions of deactivated user", requestID, map[string]interface{}{
+				"user_id": id,
+				"error":   err.Error(),
+			})
+			return nil, fmt.Errorf("failed to change user status: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		service.logger.ErrorT("failed to commit user status change", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to change user status: %w", err)
+	}
+
+	service.logger.InfoT("user status changed successfully", requestID, map[string]interface{}{
+		"user_id":         id,
+		"previous_status": userData.Status,
+		"status":          status,
+	})
+
+	return service.GetUser(c, id)
+}
+
+// ResetPassword issues a one-time token the user redeems at POST /auth/reset-password, earlier unused tokens stop working
+func (service *UserService) ResetPassword(ctx context.Context, id uint, updatedBy uint) (*user.PasswordResetTokenResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	service.logger.InfoT("processing password reset by admin", requestID, map[string]interface{}{
+		"user_id":    id,
+		"updated_by": updatedBy,
+	})
+
+	userData, err := service.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		service.logger.WarningT("user not found for password reset", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+
+	if err := service.checkManageable(ctx, userData, updatedBy); err != nil {
+		return nil, err
+	}
+	if userData.Status != constant.StatusActive {
+		return nil, errors.New("account is deactivated")
+	}
+
+	if err := service.userRepo.InvalidatePasswordResetTokens(ctx, id); err != nil {
+		service.logger.ErrorT("failed to invalidate previous reset tokens", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to reset password: %w", err)
+	}
+
+	resetToken, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		service.logger.ErrorT("failed to generate reset token", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("failed to generate reset token")
+	}
+
+	token := &user.PasswordResetToken{
+		UserID:    id,
+		TokenHash: jwt.HashToken(resetToken),
+		ExpiresAt: time.Now().Add(time.Duration(service.config.Password.ResetTokenTTL) * time.Minute),
+		CreatedBy: &updatedBy,
+	}
+	if err := service.userRepo.CreatePasswordResetToken(ctx, token); err != nil {
+		service.logger.ErrorT("failed to store reset token", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to reset password: %w", err)
+	}
+
+	service.logger.InfoT("password reset token issued", requestID, map[string]interface{}{
+		"user_id":    id,
+		"updated_by": updatedBy,
+		"expires_at": token.ExpiresAt,
+	})
+
+	return &user.PasswordResetTokenResponse{
+		UserID:     id,
+		ResetToken: resetToken,
+		ExpiresAt:  token.ExpiresAt,
+	}, nil
+}
+
+// RedeemPasswordReset sets a new password with a reset token and signs the user out everywhere
+func (service *UserService) RedeemPasswordReset(ctx context.Context, req user.ResetPasswordRequest) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	token, err := service.userRepo.GetPasswordResetTokenByHash(ctx, jwt.HashToken(req.Token))
+	if err != nil || !token.IsUsableAt(time.Now()) {
+		service.logger.WarningT("invalid password reset token", requestID, nil)
+		return errors.New("invalid or expired reset token")
+	}
+
+	userData, err := service.userRepo.GetUserByID(ctx, token.UserID)
+	if err != nil || userData.Status != constant.StatusActive {
+		return errors.New("account is deactivated")
+	}
+
+	if err := bcrypt.ValidatePasswordPolicy(req.NewPassword, service.passwordPolicy()); err != nil {
+		return err
+	}
+
+	// Marking the token used first makes a concurrent redeem of the same token lose the race
+	used, err := service.userRepo.UsePasswordResetToken(ctx, token.ID)
+	if err != nil {
+		service.logger.ErrorT("failed to redeem reset token", requestID, map[string]interface{}{
+			"user_id": token.UserID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+	if !used {
+		return errors.New("invalid or expired reset token")
+	}
+
+	if err := service.setPassword(ctx, userData.ID, req.NewPassword, userData.ID, constant.SessionRevokedPasswordReset); err != nil {
+		return err
+	}
+
+	service.logger.InfoT("password reset with token", requestID, map[string]interface{}{
+		"user_id": userData.ID,
+	})
+
+	return nil
+}
+
+// ChangePassword replaces the password of the signed in user and returns a fresh session, other sessions are revoked
+func (service *UserService) ChangePassword(ctx context.Context, userID uint, req user.ChangePasswordRequest) (response user.LoginResponse, err error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	service.logger.InfoT("processing password change", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return response, errors.New("user not found")
+	}
+
+	if err := bcrypt.VerifyPassword(userData.Password, req.CurrentPassword); err != nil {
+		service.logger.WarningT("current password verification failed", requestID, map[string]interface{}{
+			"user_id": userID,
+		})
+		return response, errors.New("current password is incorrect")
+	}
+
+	if bcrypt.VerifyPassword(userData.Password, req.NewPassword) == nil {
+		return response, errors.New("new password must be different from the current password")
+	}
+
+	if err := bcrypt.ValidatePasswordPolicy(req.NewPassword, service.passwordPolicy()); err != nil {
+		return response, err
+	}
+
+	if err := service.setPassword(ctx, userID, req.NewPassword, userID, constant.SessionRevokedPasswordChange); err != nil {
+		return response, err
+	}
+
+	service.logger.InfoT("password changed successfully", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// The current session was revoked with the others, hand back a new one so the user stays signed in
+	userData.MustChangePassword = false
+	return service.issueSession(ctx, userData, uuid.NewString(), req.IP, req.UserAgent)
+}
+
+// setPassword stores the hash of a new password, lifts the forced change and revokes every session of the user.
+// Both happen in one transaction, the password is not changed when the old sessions cannot be revoked
+func (service *UserService) setPassword(c context.Context, userID uint, password string, updatedBy uint, revokeReason string) error {
+	requestID := middleware.GetRequestIDFromContext(c)
+
+	hashedPassword, err := bcrypt.HashPasswordWithEnvCost(password)
+	if err != nil {
+		service.logger.ErrorT("failed to hash password", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return errors.New("failed to hash password")
+	}
+
+	ctx, tx, err := service.instanceRepo.BeginTransactionWithContext(c)
+	if err != nil {
+		service.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	defer tx.Rollback()
+
+	updates := map[string]interface{}{
+		"password":             hashedPassword,
+		"must_change_password": false,
+		"updated_by":           updatedBy,
+		"updated_at":           time.Now(),
+	}
+	if err := service.userRepo.UpdateUser(ctx, userID, updates); err != nil {
+		service.logger.ErrorT("failed to update password", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	// Sessions opened with the old password must not survive the change
+	if _, err := service.authSessionRepo.RevokeByUserID(ctx, userID, revokeReason); err != nil {
+		service.logger.ErrorT("failed to revoke sessions after password change", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		service.logger.ErrorT("failed to commit password change", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	return nil
+}
+
+func (service *UserService) passwordPolicy() bcrypt.PasswordPolicy {
+	return bcrypt.PasswordPolicy{
+		MinLength:     service.config.Password.MinLength,
+		RequireUpper:  service.config.Password.RequireUpper,
+		RequireLower:  service.config.Password.RequireLower,
+		RequireDigit:  service.config.Password.RequireDigit,
+		RequireSymbol: service.config.Password.RequireSymbol,
+	}
+}
+
+// GetEmployeeProfile returns the profile of a user, identity and bank numbers are masked unless unmasked is set
+func (service *UserService) GetEmployeeProfile(ctx context.Context, userID uint, unmasked bool) (*user.EmployeeProfileResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		service.logger.WarningT("user not found", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+
+	profile, err := service.userRepo.GetEmployeeProfile(ctx, userID)
+	if err != nil {
+		service.logger.WarningT("employee profile not found", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("employee profile not found")
+	}
+
+	return toProfileResponse(*profile, userData.Username, unmasked), nil
+}
+
+func (service *UserService) SaveEmployeeProfile(ctx context.Context, userID uint, req user.SaveEmployeeProfileRequest, updatedBy uint) (*user.EmployeeProfileResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	service.logger.InfoT("processing save employee profile request", requestID, map[string]interface{}{
+		"user_id":         userID,
+		"employee_number": req.EmployeeNumber,
+		"updated_by":      updatedBy,
+	})
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		service.logger.WarningT("user not found for employee profile", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+	if err := service.checkManageable(ctx, userData, updatedBy); err != nil {
+		return nil, err
+	}
+
+	// Employee numbers identify people on payslips so they must stay unique
+	if existing, err := service.userRepo.GetEmployeeProfileByNumber(ctx, req.EmployeeNumber); err == nil && existing.UserID != userID {
+		return nil, errors.New("employee number already used by another user")
+	}
+
+	var joinDate *time.Time
+	if req.JoinDate != nil {
+		parsed, err := time.Parse("2006-01-02", *req.JoinDate)
+		if err != nil {
+			return nil, errors.New("invalid join_date format, use YYYY-MM-DD")
+		}
+		joinDate = &parsed
+	}
+
+	var npwp *string
+	if req.NPWP != nil {
+		normalized := identity.NormalizeNPWP(*req.NPWP)
+		npwp = &normalized
+	}
+
+	profile := &user.EmployeeProfile{
+		UserID:    userID,
+		CreatedBy: &updatedBy,
+	}
+	if existing, err := service.userRepo.GetEmployeeProfile(ctx, userID); err == nil {
+		now := time.Now()
+		profile = existing
+		profile.UpdatedBy = &updatedBy
+		profile.UpdatedAt = &now
+	}
+
+	profile.FullName = req.FullName
+	profile.EmployeeNumber = req.EmployeeNumber
+	profile.NIK = req.NIK
+	profile.NPWP = npwp
+	profile.BankName = req.BankName
+	profile.BankAccountNumber = req.BankAccountNumber
+	profile.BankAccountName = req.BankAccountName
+	profile.Department = req.Department
+	profile.Position = req.Position
+	profile.JoinDate = joinDate
+	profile.Phone = req.Phone
+	profile.PersonalEmail = req.PersonalEmail
+	profile.Address = req.Address
+	profile.EmergencyContactName = req.EmergencyContactName
+	profile.EmergencyContactPhone = req.EmergencyContactPhone
+
+	if err := service.userRepo.SaveEmployeeProfile(ctx, profile); err != nil {
+		service.logger.ErrorT("failed to save employee profile", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to save employee profile: %w", err)
+	}
+
+	service.logger.InfoT("employee profile saved successfully", requestID, map[string]interface{}{
+		"user_id":    userID,
+		"profile_id": profile.ID,
+	})
+
+	return toProfileResponse(*profile, userData.Username, true), nil
+}
+
+func (service *UserService) UpdateOwnProfile(ctx context.Context, userID uint, req user.UpdateOwnProfileRequest) (*user.EmployeeProfileResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	service.logger.InfoT("processing update own profile request", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	// The profile itself is created by HR, employees only keep their contact details current
+	if _, err := service.userRepo.GetEmployeeProfile(ctx, userID); err != nil {
+		service.logger.WarningT("employee profile not found for update", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("employee profile not found")
+	}
+
+	updates := make(map[string]interface{})
+	updates["updated_by"] = userID
+	updates["updated_at"] = time.Now()
+
+	if req.Phone != nil {
+		updates["phone"] = *req.Phone
+	}
+	if req.PersonalEmail != nil {
+		updates["personal_email"] = *req.PersonalEmail
+	}
+	if req.Address != nil {
+		updates["address"] = *req.Address
+	}
+	if req.EmergencyContactName != nil {
+		updates["emergency_contact_name"] = *req.EmergencyContactName
+	}
+	if req.EmergencyContactPhone != nil {
+		updates["emergency_contact_phone"] = *req.EmergencyContactPhone
+	}
+
+	if err := service.userRepo.UpdateEmployeeProfile(ctx, userID, updates); err != nil {
+		service.logger.ErrorT("failed to update own profile", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to update employee profile: %w", err)
+	}
+
+	service.logger.InfoT("own profile updated successfully", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	return service.GetEmployeeProfile(ctx, userID, false)
+}
+
+// toProfileResponse masks NIK, NPWP and bank account numbers unless the viewer may read them
+func toProfileResponse(profile user.EmployeeProfile, username string, unmasked bool) *user.EmployeeProfileResponse {
+	if !unmasked {
+		profile.NIK = maskValue(profile.NIK)
+		profile.NPWP = maskValue(profile.NPWP)
+		profile.BankAccountNumber = maskValue(profile.BankAccountNumber)
+	}
+	return &user.EmployeeProfileResponse{
+		EmployeeProfile: profile,
+		Username:        username,
+	}
+}
+
+func maskValue(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	masked := identity.Mask(*value, 4)
+	return &masked
+}
+
+// startTwoFactorChallenge answers a correct password of a user with two-factor enabled with a short-lived challenge
+// instead of a session, the challenge is completed at VerifyTwoFactor
+func (service *UserService) startTwoFactorChallenge(ctx context.Context, userData user.User, ip, userAgent string) (response user.LoginResponse, err error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	challengeToken, err := jwt.GenerateRefreshToken()
+	if err != nil {
+		service.logger.ErrorT("failed to generate two-factor challenge", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return response, errors.New("failed to start two-factor challenge")
+	}
+
+	challenge := &user.TwoFactorChallenge{
+		UserID:    userData.ID,
+		TokenHash: jwt.HashToken(challengeToken),
+		ExpiresAt: time.Now().Add(time.Duration(service.config.TwoFactor.ChallengeTTL) * time.Minute),
+	}
+	if ip != "" {
+		challenge.IP = &ip
+	}
+	if userAgent != "" {
+		challenge.UserAgent = &userAgent
+	}
+	if err := service.userRepo.CreateTwoFactorChallenge(ctx, challenge); err != nil {
+		service.logger.ErrorT("failed to store two-factor challenge", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return response, errors.New("failed to start two-factor challenge")
+	}
+
+	service.logger.InfoT("password verified, two-factor code required", requestID, map[string]interface{}{
+		"user_id":    userData.ID,
+		"expires_at": challenge.ExpiresAt,
+	})
+
+	response.TwoFactorChallenge = &user.TwoFactorChallengeResponse{
+		TwoFactorRequired: true,
+		ChallengeToken:    challengeToken,
+		ExpiresAt:         challenge.ExpiresAt,
+	}
+	return response, nil
+}
+
+// VerifyTwoFactor completes a login challenge with an authenticator or recovery code and opens a session
+func (service *UserService) VerifyTwoFactor(ctx context.Context, req user.VerifyTwoFactorRequest) (response user.LoginResponse, err error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	now := time.Now()
+
+	if blocked := service.checkIPThrottle(ctx, req.IP, now); blocked != nil {
+		return response, blocked
+	}
+
+	challenge, err := service.userRepo.GetTwoFactorChallengeByHash(ctx, jwt.HashToken(req.ChallengeToken))
+	if err != nil || !challenge.IsUsableAt(now, service.config.TwoFactor.MaxAttempts) {
+		service.logger.WarningT("invalid two-factor challenge", requestID, nil)
+		return response, errors.New("invalid or expired two-factor challenge")
+	}
+
+	userData, err := service.userRepo.GetUserByID(ctx, challenge.UserID)
+	if err != nil || userData.Status != constant.StatusActive {
+		return response, errors.New("account is deactivated")
+	}
+
+	attempt := user.LoginRequest{Username: userData.Username, IP: req.IP, UserAgent: req.UserAgent}
+	if blocked := service.checkAccountThrottle(userData, now); blocked != nil {
+		service.recordLoginAttempt(ctx, attempt, &userData.ID, blocked.Reason)
+		return response, blocked
+	}
+
+	valid, err := service.verifySecondFactor(ctx, userData, req.Code, true)
+	if err != nil {
+		return response, err
+	}
+	if !valid {
+		service.logger.WarningT("two-factor verification failed", requestID, map[string]interface{}{
+			"user_id":  userData.ID,
+			"attempts": challenge.Attempts + 1,
+		})
+		if err := service.userRepo.RecordTwoFactorChallengeFailure(ctx, challenge.ID); err != nil {
+			service.logger.ErrorT("failed to record two-factor failure", requestID, map[string]interface{}{
+				"user_id": userData.ID,
+				"error":   err.Error(),
+			})
+		}
+		service.recordLoginAttempt(ctx, attempt, &userData.ID, constant.LoginAttemptInvalidTwoFactor)
+		service.recordFailedLogin(ctx, userData, now)
+		return response, errors.New("invalid two-factor code")
+	}
+
+	// Marking the challenge used makes a concurrent verify of the same challenge lose the race
+	used, err := service.userRepo.UseTwoFactorChallenge(ctx, challenge.ID)
+	if err != nil {
+		service.logger.ErrorT("failed to complete two-factor challenge", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return response, fmt.Errorf("failed to verify two-factor code: %w", err)
+	}
+	if !used {
+		return response, errors.New("invalid or expired two-factor challenge")
+	}
+
+	service.recordLoginAttempt(ctx, attempt, &userData.ID, constant.LoginAttemptSuccess)
+	service.resetFailedLogins(ctx, userData)
+
+	service.logger.InfoT("two-factor verified, generating JWT token", requestID, map[string]interface{}{
+		"user_id": userData.ID,
+	})
+
+	return service.issueSession(ctx, userData, uuid.NewString(), req.IP, req.UserAgent)
+}
+
+func (service *UserService) TwoFactorStatus(ctx context.Context, userID uint) (*user.TwoFactorStatusResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	required, err := service.roleRepo.UserRequiresTwoFactor(ctx, userID)
+	if err != nil {
+		service.logger.ErrorT("failed to check two-factor policy", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to get two-factor status: %w", err)
+	}
+
+	response := &user.TwoFactorStatusResponse{
+		Enabled:   userData.TwoFactorEnabled,
+		EnabledAt: userData.TwoFactorEnabledAt,
+		Required:  required,
+	}
+	if userData.TwoFactorEnabled {
+		if response.RecoveryCodesRemaining, err = service.userRepo.CountUnusedRecoveryCodes(ctx, userID); err != nil {
+			service.logger.ErrorT("failed to count recovery codes", requestID, map[string]interface{}{
+				"user_id": userID,
+				"error":   err.Error(),
+			})
+			return nil, fmt.Errorf("failed to get two-factor status: %w", err)
+		}
+	}
+
+	return response, nil
+}
+
+// SetupTwoFactor issues a new TOTP secret, two-factor stays off until a code from it is confirmed with EnableTwoFactor
+func (service *UserService) SetupTwoFactor(ctx context.Context, userID uint, req user.SetupTwoFactorRequest) (*user.TwoFactorSetupResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if userData.TwoFactorEnabled {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+
+	if err := bcrypt.VerifyPassword(userData.Password, req.Password); err != nil {
+		service.logger.WarningT("password verification failed for two-factor setup", requestID, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, errors.New("current password is incorrect")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		service.logger.ErrorT("failed to generate two-factor secret", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("failed to generate two-factor secret")
+	}
+
+	sealed, err := totp.Seal(secret, service.config.TwoFactor.EncryptionKey)
+	if err != nil {
+		service.logger.ErrorT("failed to encrypt two-factor secret", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("failed to generate two-factor secret")
+	}
+
+	if err := service.userRepo.UpdateUser(ctx, userID, map[string]interface{}{
+		"two_factor_secret":    sealed,
+		"two_factor_last_step": 0,
+	}); err != nil {
+		service.logger.ErrorT("failed to store two-factor secret", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to setup two-factor authentication: %w", err)
+	}
+
+	service.logger.InfoT("two-factor setup started", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	return &user.TwoFactorSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(service.config.TwoFactor.Issuer, userData.Username, secret),
+	}, nil
+}
+
+// EnableTwoFactor turns two-factor on once a code from the new secret is confirmed and returns the recovery codes,
+// the client refreshes its token afterwards to drop the enrolment restriction
+func (service *UserService) EnableTwoFactor(ctx context.Context, userID uint, req user.TwoFactorCodeRequest) (*user.TwoFactorRecoveryCodesResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if userData.TwoFactorEnabled {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+	if userData.TwoFactorSecret == nil {
+		return nil, errors.New("two-factor setup has not been started")
+	}
+
+	valid, err := service.verifySecondFactor(ctx, userData, req.Code, false)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, errors.New("invalid two-factor code")
+	}
+
+	codes, err := service.replaceRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.userRepo.UpdateUser(ctx, userID, map[string]interface{}{
+		"two_factor_enabled":    true,
+		"two_factor_enabled_at": time.Now(),
+	}); err != nil {
+		service.logger.ErrorT("failed to enable two-factor", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to enable two-factor authentication: %w", err)
+	}
+
+	service.logger.InfoT("two-factor enabled", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	return &user.TwoFactorRecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableTwoFactor turns two-factor off for users whose roles do not require it
+func (service *UserService) DisableTwoFactor(ctx context.Context, userID uint, req user.DisableTwoFactorRequest) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if !userData.TwoFactorEnabled {
+		return errors.New("two-factor authentication is not enabled")
+	}
+
+	required, err := service.roleRepo.UserRequiresTwoFactor(ctx, userID)
+	if err != nil {
+		service.logger.ErrorT("failed to check two-factor policy", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+	if required {
+		return errors.New("two-factor authentication is required for your role")
+	}
+
+	if err := bcrypt.VerifyPassword(userData.Password, req.Password); err != nil {
+		return errors.New("current password is incorrect")
+	}
+
+	valid, err := service.verifySecondFactor(ctx, userData, req.Code, true)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errors.New("invalid two-factor code")
+	}
+
+	// The secret and the recovery codes go together, a half cleared factor would keep old codes usable
+	txCtx, tx, err := service.instanceRepo.BeginTransactionWithContext(ctx)
+	if err != nil {
+		service.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := service.clearTwoFactor(txCtx, userID, userID); err != nil {
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		service.logger.ErrorT("failed to commit two-factor change", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+
+	service.logger.InfoT("two-factor disabled", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces every recovery code of the user, the previous ones stop working
+func (service *UserService) RegenerateRecoveryCodes(ctx context.Context, userID uint, req user.TwoFactorCodeRequest) (*user.TwoFactorRecoveryCodesResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if !userData.TwoFactorEnabled {
+		return nil, errors.New("two-factor authentication is not enabled")
+	}
+
+	valid, err := service.verifySecondFactor(ctx, userData, req.Code, false)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, errors.New("invalid two-factor code")
+	}
+
+	codes, err := service.replaceRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	service.logger.InfoT("recovery codes regenerated", requestID, map[string]interface{}{
+		"user_id": userID,
+	})
+
+	return &user.TwoFactorRecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// ResetTwoFactor is used by an admin when a user lost both the authenticator and the recovery codes,
+// the user is signed out everywhere and has to enrol again
+func (service *UserService) ResetTwoFactor(ctx context.Context, id uint, resetBy uint) (*user.UserResponse, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	userData, err := service.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		service.logger.WarningT("user not found for two-factor reset", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("user not found")
+	}
+
+	if err := service.checkManageable(ctx, userData, resetBy); err != nil {
+		return nil, err
+	}
+	if !userData.TwoFactorEnabled && userData.TwoFactorSecret == nil {
+		return nil, errors.New("two-factor authentication is not enabled")
+	}
+
+	// Sessions opened under the removed factor are revoked with it, the reset fails if any step does
+	txCtx, tx, err := service.instanceRepo.BeginTransactionWithContext(ctx)
+	if err != nil {
+		service.logger.ErrorT("failed to begin transaction", requestID, map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to reset two-factor authentication: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := service.clearTwoFactor(txCtx, id, resetBy); err != nil {
+		return nil, err
+	}
+
+	if _, err := service.authSessionRepo.RevokeByUserID(txCtx, id, constant.SessionRevokedTwoFactorReset); err != nil {
+		service.logger.ErrorT("failed to revoke sessions after two-factor reset", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to reset two-factor authentication: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		service.logger.ErrorT("failed to commit two-factor reset", requestID, map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to reset two-factor authentication: %w", err)
+	}
+
+	service.logger.InfoT("two-factor reset by admin", requestID, map[string]interface{}{
+		"user_id":  id,
+		"reset_by": resetBy,
+	})
+
+	return service.GetUser(ctx, id)
+}
+
+// verifySecondFactor checks a TOTP code, a code's time step is accepted only once so an observed code cannot be
+// replayed. With allowRecovery a one-time recovery code is accepted as well.
+func (service *UserService) verifySecondFactor(ctx context.Context, userData user.User, code string, allowRecovery bool) (bool, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+	if userData.TwoFactorSecret == nil {
+		return false, nil
+	}
+
+	secret, err := totp.Open(*userData.TwoFactorSecret, service.config.TwoFactor.EncryptionKey)
+	if err != nil {
+		service.logger.ErrorT("failed to decrypt two-factor secret", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return false, errors.New("failed to verify two-factor code")
+	}
+
+	if step, ok := totp.Validate(secret, code, time.Now(), service.config.TwoFactor.Skew); ok {
+		used, err := service.userRepo.UseTwoFactorStep(ctx, userData.ID, step)
+		if err != nil {
+			service.logger.ErrorT("failed to record two-factor step", requestID, map[string]interface{}{
+				"user_id": userData.ID,
+				"error":   err.Error(),
+			})
+			return false, fmt.Errorf("failed to verify two-factor code: %w", err)
+		}
+		return used, nil
+	}
+
+	if !allowRecovery {
+		return false, nil
+	}
+
+	used, err := service.userRepo.UseRecoveryCode(ctx, userData.ID, jwt.HashToken(totp.NormalizeRecoveryCode(code)))
+	if err != nil {
+		service.logger.ErrorT("failed to redeem recovery code", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+			"error":   err.Error(),
+		})
+		return false, fmt.Errorf("failed to verify two-factor code: %w", err)
+	}
+	if used {
+		service.logger.WarningT("recovery code used", requestID, map[string]interface{}{
+			"user_id": userData.ID,
+		})
+	}
+	return used, nil
+}
+
+// replaceRecoveryCodes generates a new set of recovery codes and stores their hashes
+func (service *UserService) replaceRecoveryCodes(ctx context.Context, userID uint) ([]string, error) {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	codes, err := totp.GenerateRecoveryCodes(service.config.TwoFactor.RecoveryCodes)
+	if err != nil {
+		service.logger.ErrorT("failed to generate recovery codes", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, errors.New("failed to generate recovery codes")
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, jwt.HashToken(totp.NormalizeRecoveryCode(code)))
+	}
+	if err := service.userRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		service.logger.ErrorT("failed to store recovery codes", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return nil, fmt.Errorf("failed to store recovery codes: %w", err)
+	}
+
+	return codes, nil
+}
+
+// clearTwoFactor removes the secret and recovery codes of the user, callers run it inside a transaction
+func (service *UserService) clearTwoFactor(ctx context.Context, userID uint, updatedBy uint) error {
+	requestID := middleware.GetRequestIDFromContext(ctx)
+
+	if err := service.userRepo.UpdateUser(ctx, userID, map[string]interface{}{
+		"two_factor_secret":     nil,
+		"two_factor_enabled":    false,
+		"two_factor_enabled_at": nil,
+		"two_factor_last_step":  0,
+		"updated_by":            updatedBy,
+		"updated_at":            time.Now(),
+	}); err != nil {
+		service.logger.ErrorT("failed to clear two-factor", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+
+	if err := service.userRepo.DeleteRecoveryCodes(ctx, userID); err != nil {
+		service.logger.ErrorT("failed to delete recovery codes", requestID, map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"github.com/riskykurniawan15/payrolls/config"
+	"github.com/riskykurniawan15/payrolls/constant"
+	"github.com/riskykurniawan15/payrolls/infrastructure/http/middleware"
+	"github.com/riskykurniawan15/payrolls/mocks"
+	"github.com/riskykurniawan15/payrolls/models/user"
+	"github.com/riskykurniawan15/payrolls/utils/logger"
+)
+
+func newTestUserService(userRepo *mocks.MockIUserRepository, roleRepo *mocks.MockIRoleRepository) IUserService {
+	cfg := config.Config{}
+	cfg.Password.ResetTokenTTL = 60
+	return NewUserService(cfg, userRepo, nil, nil, roleRepo, nil, nil, logger.Logger{Log: zap.NewNop().Sugar()})
+}
+
+func TestUserService_ResetPassword(t *testing.T) {
+	userManager := []string{constant.PermissionUserManage, constant.PermissionEmployeeReadSensitive}
+
+	t.Run("admin target without role manage", func(t *testing.T) {
+		// Setup mock
+		userRepo := &mocks.MockIUserRepository{}
+		roleRepo := &mocks.MockIRoleRepository{}
+		service := newTestUserService(userRepo, roleRepo)
+
+		// Setup expectations
+		userRepo.On("GetUserByID", mock.Anything, uint(1)).Return(user.User{ID: 1, Role: constant.AdminRole, Status: constant.StatusActive}, nil)
+
+		// Execute
+		ctx := middleware.AddPermissionsToContext(context.Background(), userManager)
+		response, err := service.ResetPassword(ctx, 1, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrProtectedUser)
+		assert.Nil(t, response)
+
+		// Verify expectations
+		userRepo.AssertExpectations(t)
+		userRepo.AssertNotCalled(t, "CreatePasswordResetToken", mock.Anything, mock.Anything)
+	})
+
+	t.Run("employee with a permission the caller lacks", func(t *testing.T) {
+		// Setup mock
+		userRepo := &mocks.MockIUserRepository{}
+		roleRepo := &mocks.MockIRoleRepository{}
+		service := newTestUserService(userRepo, roleRepo)
+
+		// Setup expectations
+		userRepo.On("GetUserByID", mock.Anything, uint(3)).Return(user.User{ID: 3, Role: constant.EmployeeRole, Status: constant.StatusActive}, nil)
+		roleRepo.On("GetUserPermissions", mock.Anything, uint(3)).Return([]string{constant.PermissionAttendanceSelf, constant.PermissionPayrollRun}, nil)
+
+		// Execute
+		ctx := middleware.AddPermissionsToContext(context.Background(), userManager)
+		response, err := service.ResetPassword(ctx, 3, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrProtectedUser)
+		assert.Nil(t, response)
+
+		// Verify expectations
+		userRepo.AssertExpectations(t)
+		roleRepo.AssertExpectations(t)
+	})
+
+	t.Run("plain employee", func(t *testing.T) {
+		// Setup mock
+		userRepo := &mocks.MockIUserRepository{}
+		roleRepo := &mocks.MockIRoleRepository{}
+		service := newTestUserService(userRepo, roleRepo)
+
+		// Setup expectations
+		userRepo.On("GetUserByID", mock.Anything, uint(4)).Return(user.User{ID: 4, Role: constant.EmployeeRole, Status: constant.StatusActive}, nil)
+		roleRepo.On("GetUserPermissions", mock.Anything, uint(4)).Return([]string{constant.PermissionAttendanceSelf, constant.PermissionPayslipSelf}, nil)
+		userRepo.On("InvalidatePasswordResetTokens", mock.Anything, uint(4)).Return(nil)
+		userRepo.On("CreatePasswordResetToken", mock.Anything, mock.AnythingOfType("*user.PasswordResetToken")).Return(nil)
+
+		// Execute
+		ctx := middleware.AddPermissionsToContext(context.Background(), userManager)
+		response, err := service.ResetPassword(ctx, 4, 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.Equal(t, uint(4), response.UserID)
+		assert.NotEmpty(t, response.ResetToken)
+
+		// Verify expectations
+		userRepo.AssertExpectations(t)
+		roleRepo.AssertExpectations(t)
+	})
+
+	t.Run("admin target with role manage", func(t *testing.T) {
+		// Setup mock
+		userRepo := &mocks.MockIUserRepository{}
+		roleRepo := &mocks.MockIRoleRepository{}
+		service := newTestUserService(userRepo, roleRepo)
+
+		// Setup expectations
+		userRepo.On("GetUserByID", mock.Anything, uint(1)).Return(user.User{ID: 1, Role: constant.AdminRole, Status: constant.StatusActive}, nil)
+		userRepo.On("InvalidatePasswordResetTokens", mock.Anything, uint(1)).Return(nil)
+		userRepo.On("CreatePasswordResetToken", mock.Anything, mock.AnythingOfType("*user.PasswordResetToken")).Return(nil)
+
+		// Execute
+		ctx := middleware.AddPermissionsToContext(context.Background(), append(userManager, constant.PermissionRoleManage))
+		response, err := service.ResetPassword(ctx, 1, 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+
+		// Verify expectations
+		userRepo.AssertExpectations(t)
+		roleRepo.AssertNotCalled(t, "GetUserPermissions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_UpdateUser(t *testing.T) {
+	t.Run("linking single sign-on of an admin without role manage", func(t *testing.T) {
+		// Setup mock
+		userRepo := &mocks.MockIUserRepository{}
+		roleRepo := &mocks.MockIRoleRepository{}
+		service := newTestUserService(userRepo, roleRepo)
+
+		// Test data
+		email := "attacker@example.com"
+		req := user.UpdateUserRequest{Email: &email}
+
+		// Setup expectations
+		userRepo.On("GetUserByID", mock.Anything, uint(1)).Return(user.User{ID: 1, Role: constant.AdminRole, Status: constant.StatusActive}, nil)
+
+		// Execute
+		ctx := middleware.AddPermissionsToContext(context.Background(), []string{constant.PermissionUserManage})
+		response, err := service.UpdateUser(ctx, 1, req, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrProtectedUser)
+		assert.Nil(t, response)
+
+		// Verify expectations
+		userRepo.AssertExpectations(t)
+		userRepo.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
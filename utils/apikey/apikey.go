@@ -0,0 +1,44 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// Scheme starts every key so leaked keys are easy to recognise in logs and secret scanners
+	Scheme = "pk"
+	// PrefixLength is the number of hex characters that identify a key in listings
+	PrefixLength = 8
+
+	secretBytes = 32
+)
+
+// Generate returns a new key of the form pk_<prefix>_<secret> and its display prefix pk_<prefix>
+func Generate() (key, prefix string, err error) {
+	idBuf := make([]byte, PrefixLength/2)
+	if _, err = rand.Read(idBuf); err != nil {
+		return "", "", err
+	}
+	secretBuf := make([]byte, secretBytes)
+	if _, err = rand.Read(secretBuf); err != nil {
+		return "", "", err
+	}
+
+	prefix = Scheme + "_" + hex.EncodeToString(idBuf)
+	return prefix + "_" + base64.RawURLEncoding.EncodeToString(secretBuf), prefix, nil
+}
+
+// Prefix returns the display prefix of a key, it reports false when the key is not well formed
+func Prefix(key string) (string, bool) {
+	parts := strings.SplitN(strings.TrimSpace(key), "_", 3)
+	if len(parts) != 3 || parts[0] != Scheme || len(parts[1]) != PrefixLength || parts[2] == "" {
+		return "", false
+	}
+	if _, err := hex.DecodeString(parts[1]); err != nil {
+		return "", false
+	}
+	return parts[0] + "_" + parts[1], true
+}
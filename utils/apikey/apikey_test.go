@@ -0,0 +1,50 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	key, prefix, err := Generate()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, prefix+"_"))
+	assert.Len(t, prefix, len(Scheme)+1+PrefixLength)
+
+	parsed, ok := Prefix(key)
+	assert.True(t, ok)
+	assert.Equal(t, prefix, parsed)
+
+	other, _, err := Generate()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		expected string
+		ok       bool
+	}{
+		{"valid", "pk_1a2b3c4d_c2VjcmV0", "pk_1a2b3c4d", true},
+		{"secret with separators", "pk_1a2b3c4d_se_cr-et", "pk_1a2b3c4d", true},
+		{"surrounding spaces", "  pk_1a2b3c4d_secret ", "pk_1a2b3c4d", true},
+		{"wrong scheme", "sk_1a2b3c4d_secret", "", false},
+		{"short prefix", "pk_1a2b_secret", "", false},
+		{"prefix not hex", "pk_zzzzzzzz_secret", "", false},
+		{"missing secret", "pk_1a2b3c4d_", "", false},
+		{"jwt", "eyJhbGciOiJIUzI1NiJ9.e30.sig", "", false},
+		{"empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, ok := Prefix(tt.key)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, prefix)
+		})
+	}
+}
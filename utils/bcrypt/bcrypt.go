@@ -1,8 +1,13 @@
 package bcrypt
 
 import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
 	"os"
 	"strconv"
+	"unicode"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -65,6 +70,50 @@ func IsValidPassword(password string, minLength int) bool {
 	return true
 }
 
+// PasswordPolicy describes the rules a new password must satisfy
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// ValidatePasswordPolicy returns an error describing the first rule the password breaks
+func ValidatePasswordPolicy(password string, policy PasswordPolicy) error {
+	if !IsValidPassword(password, policy.MinLength) {
+		return fmt.Errorf("password must be at least %d characters long", policy.MinLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+
+	if policy.RequireUpper && !hasUpper {
+		return errors.New("password must contain an uppercase letter")
+	}
+	if policy.RequireLower && !hasLower {
+		return errors.New("password must contain a lowercase letter")
+	}
+	if policy.RequireDigit && !hasDigit {
+		return errors.New("password must contain a digit")
+	}
+	if policy.RequireSymbol && !hasSymbol {
+		return errors.New("password must contain a symbol")
+	}
+	return nil
+}
+
 // GetPasswordStrength returns password strength level
 func GetPasswordStrength(password string) string {
 	if len(password) < 6 {
@@ -75,3 +124,55 @@ func GetPasswordStrength(password string) string {
 		return "strong"
 	}
 }
+
+// temporaryPasswordMinLength keeps generated passwords long even when the policy allows short ones
+const temporaryPasswordMinLength = 12
+
+const (
+	upperChars  = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	lowerChars  = "abcdefghijkmnopqrstuvwxyz"
+	digitChars  = "23456789"
+	symbolChars = "!@#$%*?"
+)
+
+// GenerateTemporaryPassword returns a random password that satisfies the policy,
+// look-alike characters are left out because the password is handed over by hand
+func GenerateTemporaryPassword(policy PasswordPolicy) (string, error) {
+	length := max(policy.MinLength, temporaryPasswordMinLength)
+
+	// every character class is included once so the policy holds whatever it requires
+	password := make([]byte, 0, length)
+	for _, chars := range []string{upperChars, lowerChars, digitChars, symbolChars} {
+		c, err := randomChar(chars)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	all := upperChars + lowerChars + digitChars
+	for len(password) < length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func randomChar(chars string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+	return chars[n.Int64()], nil
+}
@@ -291,3 +291,122 @@ func TestGetPasswordStrength(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePasswordPolicy(t *testing.T) {
+	strict := PasswordPolicy{
+		MinLength:     8,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		policy   PasswordPolicy
+		wantErr  bool
+	}{
+		{
+			name:     "satisfies every rule",
+			password: "Secret123!",
+			policy:   strict,
+			wantErr:  false,
+		},
+		{
+			name:     "too short",
+			password: "Se1!",
+			policy:   strict,
+			wantErr:  true,
+		},
+		{
+			name:     "missing uppercase",
+			password: "secret123!",
+			policy:   strict,
+			wantErr:  true,
+		},
+		{
+			name:     "missing lowercase",
+			password: "SECRET123!",
+			policy:   strict,
+			wantErr:  true,
+		},
+		{
+			name:     "missing digit",
+			password: "SecretPass!",
+			policy:   strict,
+			wantErr:  true,
+		},
+		{
+			name:     "missing symbol",
+			password: "Secret1234",
+			policy:   strict,
+			wantErr:  true,
+		},
+		{
+			name:     "length only policy",
+			password: "password",
+			policy:   PasswordPolicy{MinLength: 8},
+			wantErr:  false,
+		},
+		{
+			name:     "empty password",
+			password: "",
+			policy:   PasswordPolicy{MinLength: 0},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePasswordPolicy(tt.password, tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePasswordPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateTemporaryPassword(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     PasswordPolicy
+		wantLength int
+	}{
+		{
+			name:       "short policy uses the minimum temporary length",
+			policy:     PasswordPolicy{MinLength: 6},
+			wantLength: 12,
+		},
+		{
+			name:       "strict policy",
+			policy:     PasswordPolicy{MinLength: 8, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true},
+			wantLength: 12,
+		},
+		{
+			name:       "long policy",
+			policy:     PasswordPolicy{MinLength: 20, RequireSymbol: true},
+			wantLength: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			password, err := GenerateTemporaryPassword(tt.policy)
+			if err != nil {
+				t.Fatalf("GenerateTemporaryPassword() error = %v", err)
+			}
+			if len(password) != tt.wantLength {
+				t.Errorf("GenerateTemporaryPassword() length = %d, want %d", len(password), tt.wantLength)
+			}
+			if err := ValidatePasswordPolicy(password, tt.policy); err != nil {
+				t.Errorf("GenerateTemporaryPassword() = %q breaks the policy: %v", password, err)
+			}
+
+			other, _ := GenerateTemporaryPassword(tt.policy)
+			if other == password {
+				t.Errorf("GenerateTemporaryPassword() returned the same password twice")
+			}
+		})
+	}
+}
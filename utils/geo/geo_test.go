@@ -0,0 +1,55 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistanceInMeters(t *testing.T) {
+	tests := []struct {
+		name      string
+		lat1      float64
+		lng1      float64
+		lat2      float64
+		lng2      float64
+		want      float64
+		tolerance float64
+	}{
+		{
+			name:      "same point",
+			lat1:      -6.2088,
+			lng1:      106.8456,
+			lat2:      -6.2088,
+			lng2:      106.8456,
+			want:      0,
+			tolerance: 0.001,
+		},
+		{
+			name:      "monas to bundaran hi",
+			lat1:      -6.175392,
+			lng1:      106.827153,
+			lat2:      -6.194935,
+			lng2:      106.823056,
+			want:      2220,
+			tolerance: 20,
+		},
+		{
+			name:      "jakarta to bandung",
+			lat1:      -6.2088,
+			lng1:      106.8456,
+			lat2:      -6.9175,
+			lng2:      107.6191,
+			want:      116000,
+			tolerance: 1500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DistanceInMeters(tt.lat1, tt.lng1, tt.lat2, tt.lng2)
+			if math.Abs(got-tt.want) > tt.tolerance {
+				t.Errorf("DistanceInMeters() = %v, want %v (+/- %v)", got, tt.want, tt.tolerance)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package identity
+
+import "strings"
+
+// IsValidNIK checks an Indonesian NIK: 16 digits holding the region code, birth date
+// (day plus 40 for women) and birth month
+func IsValidNIK(nik string) bool {
+	if len(nik) != 16 || !isDigits(nik) {
+		return false
+	}
+
+	// Province codes start at 11 (Aceh)
+	if toInt(nik[0:2]) < 11 {
+		return false
+	}
+
+	day := toInt(nik[6:8])
+	if day > 40 {
+		day -= 40
+	}
+	if day < 1 || day > 31 {
+		return false
+	}
+
+	month := toInt(nik[8:10])
+	return month >= 1 && month <= 12
+}
+
+// NormalizeNPWP strips the dots, dashes and spaces used when writing an NPWP
+func NormalizeNPWP(npwp string) string {
+	return strings.NewReplacer(".", "", "-", "", " ", "").Replace(npwp)
+}
+
+// IsValidNPWP accepts the 15 digit NPWP (with or without separators) and the 16 digit
+// format introduced in 2024
+func IsValidNPWP(npwp string) bool {
+	normalized := NormalizeNPWP(npwp)
+	return (len(normalized) == 15 || len(normalized) == 16) && isDigits(normalized)
+}
+
+// Mask hides all but the last visible characters of a value
+func Mask(value string, visible int) string {
+	if len(value) <= visible {
+		return value
+	}
+	return strings.Repeat("*", len(value)-visible) + value[len(value)-visible:]
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func toInt(s string) int {
+	n := 0
+	for _, r := range s {
+		n = n*10 + int(r-'0')
+	}
+	return n
+}
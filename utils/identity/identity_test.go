@@ -0,0 +1,78 @@
+package identity
+
+import "testing"
+
+func TestIsValidNIK(t *testing.T) {
+	tests := []struct {
+		name string
+		nik  string
+		want bool
+	}{
+		{name: "valid male", nik: "3174011505900001", want: true},
+		{name: "valid female day plus forty", nik: "3174015505900002", want: true},
+		{name: "too short", nik: "317401150590001", want: false},
+		{name: "contains letters", nik: "31740115059000A1", want: false},
+		{name: "invalid province", nik: "0974011505900001", want: false},
+		{name: "invalid day", nik: "3174013505900001", want: false},
+		{name: "invalid month", nik: "3174011513900001", want: false},
+		{name: "empty", nik: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidNIK(tt.nik); got != tt.want {
+				t.Errorf("IsValidNIK(%q) = %v, want %v", tt.nik, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidNPWP(t *testing.T) {
+	tests := []struct {
+		name string
+		npwp string
+		want bool
+	}{
+		{name: "formatted 15 digits", npwp: "09.254.294.3-407.000", want: true},
+		{name: "plain 15 digits", npwp: "092542943407000", want: true},
+		{name: "16 digits", npwp: "3174011505900001", want: true},
+		{name: "too short", npwp: "09.254.294.3-407.00", want: false},
+		{name: "contains letters", npwp: "09.254.294.3-407.00A", want: false},
+		{name: "empty", npwp: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidNPWP(tt.npwp); got != tt.want {
+				t.Errorf("IsValidNPWP(%q) = %v, want %v", tt.npwp, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeNPWP(t *testing.T) {
+	if got := NormalizeNPWP("09.254.294.3-407.000"); got != "092542943407000" {
+		t.Errorf("NormalizeNPWP() = %q, want %q", got, "092542943407000")
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		visible int
+		want    string
+	}{
+		{name: "keeps last four", value: "3174011505900001", visible: 4, want: "************0001"},
+		{name: "shorter than visible", value: "123", visible: 4, want: "123"},
+		{name: "empty", value: "", visible: 4, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mask(tt.value, tt.visible); got != tt.want {
+				t.Errorf("Mask(%q, %d) = %q, want %q", tt.value, tt.visible, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type (
@@ -13,33 +18,53 @@ type (
 	}
 
 	JWTClaims struct {
-		UserID   uint   `json:"user_id"`
-		Username string `json:"username"`
-		Role     string `json:"role"`
+		UserID             uint   `json:"user_id"`
+		Username           string `json:"username"`
+		Role               string `json:"role"`
+		MustChangePassword bool   `json:"must_change_password,omitempty"`
+		// TwoFactorSetupRequired marks tokens of users who must enrol in two-factor authentication first
+		TwoFactorSetupRequired bool `json:"two_factor_setup_required,omitempty"`
 		jwt.RegisteredClaims
 	}
 
 	UserContext struct {
-		UserID   uint   `json:"user_id"`
-		Username string `json:"username"`
-		Role     string `json:"role"`
+		UserID                 uint   `json:"user_id"`
+		Username               string `json:"username"`
+		Role                   string `json:"role"`
+		TokenID                string `json:"token_id"`
+		MustChangePassword     bool   `json:"must_change_password"`
+		TwoFactorSetupRequired bool   `json:"two_factor_setup_required"`
 	}
 )
 
 // GenerateToken generates a new JWT token for the given user data
 func GenerateToken(config JWTConfig, userID uint, username, role string) (string, time.Time, error) {
-	expiresAt := time.Now().Add(time.Duration(config.Expired) * time.Hour)
+	return GenerateTokenWithID(config.SecretKey, time.Duration(config.Expired)*time.Hour, uuid.NewString(), userID, username, role, false, false)
+}
+
+// GenerateTokenWithID generates a JWT token carrying tokenID as its jti claim so it can be revoked server-side,
+// mustChangePassword marks tokens that may only be used to choose a new password and
+// twoFactorSetupRequired marks tokens that may only be used to enrol in two-factor authentication
+func GenerateTokenWithID(secretKey string, ttl time.Duration, tokenID string, userID uint, username, role string, mustChangePassword, twoFactorSetupRequired bool) (string, time.Time, error) {
+	expiresAt := time.Now().Add(ttl)
 
 	claims := jwt.MapClaims{
 		"user_id":  userID,
 		"username": username,
 		"role":     role,
+		"jti":      tokenID,
 		"exp":      expiresAt.Unix(),
 		"iat":      time.Now().Unix(),
 	}
+	if mustChangePassword {
+		claims["must_change_password"] = true
+	}
+	if twoFactorSetupRequired {
+		claims["two_factor_setup_required"] = true
+	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(config.SecretKey))
+	tokenString, err := token.SignedString([]byte(secretKey))
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -72,8 +97,26 @@ func ParseToken(tokenString, secretKey string) (*JWTClaims, error) {
 // ExtractUserContext extracts user context from JWT claims
 func ExtractUserContext(claims *JWTClaims) UserContext {
 	return UserContext{
-		UserID:   claims.UserID,
-		Username: claims.Username,
-		Role:     claims.Role,
+		UserID:                 claims.UserID,
+		Username:               claims.Username,
+		Role:                   claims.Role,
+		TokenID:                claims.ID,
+		MustChangePassword:     claims.MustChangePassword,
+		TwoFactorSetupRequired: claims.TwoFactorSetupRequired,
 	}
 }
+
+// GenerateRefreshToken returns a random opaque refresh token, only its hash should be stored
+func GenerateRefreshToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashToken returns the hex encoded SHA-256 of a token
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -291,6 +291,123 @@ func TestJWTExpiration(t *testing.T) {
 	// a very short expiration time for testing or mock the time.
 }
 
+func TestGenerateTokenWithID(t *testing.T) {
+	secretKey := "token_id_test_secret"
+
+	token, expiresAt, err := GenerateTokenWithID(secretKey, 15*time.Minute, "session-token-id", 123, "testuser", "employee", false, false)
+	if err != nil {
+		t.Fatalf("GenerateTokenWithID() failed: %v", err)
+	}
+
+	if expiresAt.After(time.Now().Add(16 * time.Minute)) {
+		t.Errorf("GenerateTokenWithID() expiresAt too far in the future: %v", expiresAt)
+	}
+
+	claims, err := ParseToken(token, secretKey)
+	if err != nil {
+		t.Fatalf("ParseToken() failed: %v", err)
+	}
+
+	userContext := ExtractUserContext(claims)
+	if userContext.TokenID != "session-token-id" {
+		t.Errorf("TokenID mismatch: got %v, want %v", userContext.TokenID, "session-token-id")
+	}
+	if userContext.UserID != 123 {
+		t.Errorf("UserID mismatch: got %v, want %v", userContext.UserID, 123)
+	}
+	if userContext.MustChangePassword {
+		t.Errorf("MustChangePassword should be false for a regular token")
+	}
+	if userContext.TwoFactorSetupRequired {
+		t.Errorf("TwoFactorSetupRequired should be false for a regular token")
+	}
+}
+
+func TestGenerateTokenWithID_MustChangePassword(t *testing.T) {
+	secretKey := "token_id_test_secret"
+
+	token, _, err := GenerateTokenWithID(secretKey, 15*time.Minute, "session-token-id", 123, "testuser", "employee", true, false)
+	if err != nil {
+		t.Fatalf("GenerateTokenWithID() failed: %v", err)
+	}
+
+	claims, err := ParseToken(token, secretKey)
+	if err != nil {
+		t.Fatalf("ParseToken() failed: %v", err)
+	}
+
+	if !ExtractUserContext(claims).MustChangePassword {
+		t.Errorf("MustChangePassword should be carried by the token")
+	}
+}
+
+func TestGenerateTokenWithID_TwoFactorSetupRequired(t *testing.T) {
+	secretKey := "token_id_test_secret"
+
+	token, _, err := GenerateTokenWithID(secretKey, 15*time.Minute, "session-token-id", 1, "admin", "admin", false, true)
+	if err != nil {
+		t.Fatalf("GenerateTokenWithID() failed: %v", err)
+	}
+
+	claims, err := ParseToken(token, secretKey)
+	if err != nil {
+		t.Fatalf("ParseToken() failed: %v", err)
+	}
+
+	userContext := ExtractUserContext(claims)
+	if !userContext.TwoFactorSetupRequired {
+		t.Errorf("TwoFactorSetupRequired should be carried by the token")
+	}
+	if userContext.MustChangePassword {
+		t.Errorf("MustChangePassword should stay false")
+	}
+}
+
+func TestGenerateRefreshToken(t *testing.T) {
+	first, err := GenerateRefreshToken()
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken() failed: %v", err)
+	}
+	second, err := GenerateRefreshToken()
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken() failed: %v", err)
+	}
+
+	if len(first) != 43 {
+		t.Errorf("GenerateRefreshToken() length = %d, want 43", len(first))
+	}
+	if first == second {
+		t.Errorf("GenerateRefreshToken() returned the same token twice")
+	}
+}
+
+func TestHashToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{
+			name:  "known value",
+			token: "test",
+			want:  "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		},
+		{
+			name:  "empty token",
+			token: "",
+			want:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HashToken(tt.token); got != tt.want {
+				t.Errorf("HashToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestJWTClaimsStructure(t *testing.T) {
 	// Test that JWTClaims embeds jwt.RegisteredClaims correctly
 	claims := &JWTClaims{
@@ -0,0 +1,360 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// ChallengeMethod is the only PKCE method sent to the provider
+	ChallengeMethod = "S256"
+
+	// maxBodySize caps the responses read from the provider
+	maxBodySize = 1 << 20
+	// keyRefreshInterval keeps tokens with an unknown kid from hammering the JWKS endpoint
+	keyRefreshInterval = time.Minute
+	// leeway tolerates clock drift between the provider and this server
+	leeway = time.Minute
+)
+
+var (
+	ErrNonceMismatch = errors.New("id token nonce does not match")
+	ErrUnknownKey    = errors.New("id token is signed with an unknown key")
+)
+
+type (
+	// Config describes the client registered at the provider
+	Config struct {
+		IssuerURL    string
+		ClientID     string
+		ClientSecret string // empty for public clients, PKCE protects the exchange
+		RedirectURL  string
+		Scopes       []string
+		Timeout      time.Duration
+	}
+
+	// Metadata is the part of the discovery document the login flow needs
+	Metadata struct {
+		Issuer                string   `json:"issuer"`
+		AuthorizationEndpoint string   `json:"authorization_endpoint"`
+		TokenEndpoint         string   `json:"token_endpoint"`
+		JWKSURI               string   `json:"jwks_uri"`
+		ChallengeMethods      []string `json:"code_challenge_methods_supported"`
+	}
+
+	// Claims are the ID token claims used to find or provision the user
+	Claims struct {
+		jwt.RegisteredClaims
+		AuthorizedParty   string `json:"azp,omitempty"`
+		Nonce             string `json:"nonce,omitempty"`
+		Email             string `json:"email,omitempty"`
+		EmailVerified     bool   `json:"email_verified,omitempty"`
+		Name              string `json:"name,omitempty"`
+		PreferredUsername string `json:"preferred_username,omitempty"`
+	}
+
+	tokenResponse struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	}
+
+	// Provider talks to one OpenID Connect provider, discovery and signing keys are fetched lazily and cached
+	Provider struct {
+		config Config
+		client *http.Client
+
+		mu            sync.Mutex
+		metadata      *Metadata
+		keys          map[string]*rsa.PublicKey
+		keysFetchedAt time.Time
+	}
+)
+
+func NewProvider(config Config) *Provider {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	config.IssuerURL = strings.TrimSuffix(config.IssuerURL, "/")
+
+	return &Provider{
+		config: config,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// GenerateVerifier returns a PKCE code verifier of 43 characters
+func GenerateVerifier() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Challenge returns the S256 code challenge of a verifier
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Metadata returns the discovery document, it is fetched once and kept for the lifetime of the provider
+func (p *Provider) Metadata(ctx context.Context) (*Metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	var metadata Metadata
+	if err := p.getJSON(ctx, p.config.IssuerURL+"/.well-known/openid-configuration", &metadata); err != nil {
+		return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+
+	// A document served for another issuer would let that issuer's tokens in
+	if strings.TrimSuffix(metadata.Issuer, "/") != p.config.IssuerURL {
+		return nil, fmt.Errorf("discovery issuer %q does not match %q", metadata.Issuer, p.config.IssuerURL)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing endpoints")
+	}
+	if len(metadata.ChallengeMethods) > 0 && !contains(metadata.ChallengeMethods, ChallengeMethod) {
+		return nil, errors.New("provider does not support S256 code challenges")
+	}
+
+	p.metadata = &metadata
+	return p.metadata, nil
+}
+
+// AuthCodeURL returns the URL the browser is sent to, state and nonce are echoed back by the provider
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(p.scopes(), " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", Challenge(verifier))
+	query.Set("code_challenge_method", ChallengeMethod)
+
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return metadata.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeems an authorization code and returns the verified claims of its ID token
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Claims, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("code_verifier", verifier)
+	form.Set("client_id", p.config.ClientID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token tokenResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxBodySize)).Decode(&token); err != nil {
+		return nil, fmt.Errorf("invalid token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || token.Error != "" {
+		return nil, fmt.Errorf("token request rejected: %s %s", token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token response has no id token")
+	}
+
+	return p.VerifyIDToken(ctx, token.IDToken, nonce)
+}
+
+// VerifyIDToken checks the signature, issuer, audience, expiry and nonce of an ID token
+func (p *Provider) VerifyIDToken(ctx context.Context, raw, nonce string) (*Claims, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &Claims{}
+	_, err = jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	// With several audiences the token must have been issued to this client
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, errors.New("invalid id token: authorized party does not match")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("invalid id token: subject is missing")
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, ErrNonceMismatch
+	}
+
+	return claims, nil
+}
+
+// key returns the signing key with the given kid, the key set is refetched when the kid is unknown
+func (p *Provider) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if !p.keysFetchedAt.IsZero() && time.Since(p.keysFetchedAt) < keyRefreshInterval {
+		return nil, ErrUnknownKey
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, p.metadata.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, jwk := range set.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// lookupKey finds a cached key, a token without kid is accepted when the set holds a single key
+func (p *Provider) lookupKey(kid string) (*rsa.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *Provider) getJSON(ctx context.Context, endpoint string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, endpoint)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxBodySize)).Decode(out)
+}
+
+func (p *Provider) scopes() []string {
+	scopes := p.config.Scopes
+	if !contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+	return scopes
+}
+
+func (jwk jsonWebKey) publicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid rsa key")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
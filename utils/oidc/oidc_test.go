@@ -0,0 +1,343 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testClientID     = "payrolls"
+	testClientSecret = "secret"
+	testRedirectURL  = "http://localhost:8080/auth/oidc/callback"
+	testCode         = "auth-code"
+	testVerifier     = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	testNonce        = "nonce-value"
+)
+
+// mockProvider is a minimal OpenID Connect provider serving discovery, keys and the token endpoint
+type mockProvider struct {
+	server   *httptest.Server
+	key      *rsa.PrivateKey
+	kid      string
+	claims   Claims
+	jwksHits int
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	m := &mockProvider{key: key, kid: "key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Metadata{
+			Issuer:                m.server.URL,
+			AuthorizationEndpoint: m.server.URL + "/auth",
+			TokenEndpoint:         m.server.URL + "/token",
+			JWKSURI:               m.server.URL + "/keys",
+			ChallengeMethods:      []string{"plain", "S256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		m.jwksHits++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []jsonWebKey{{
+				Kty: "RSA",
+				Kid: m.kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		id, secret, _ := r.BasicAuth()
+		if r.Form.Get("code") != testCode || id != testClientID || secret != testClientSecret ||
+			Challenge(r.Form.Get("code_verifier")) != Challenge(testVerifier) {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": m.sign(t, m.claims)})
+	})
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+
+	m.claims = Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.server.URL,
+			Subject:   "f3b1c2d4",
+			Audience:  jwt.ClaimStrings{testClientID},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+		Nonce:             testNonce,
+		Email:             "jane@example.com",
+		EmailVerified:     true,
+		PreferredUsername: "jane",
+	}
+	return m
+}
+
+func (m *mockProvider) sign(t *testing.T, claims Claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.kid
+	signed, err := token.SignedString(m.key)
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+	return signed
+}
+
+func (m *mockProvider) provider() *Provider {
+	return NewProvider(Config{
+		IssuerURL:    m.server.URL + "/",
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"email", "profile"},
+	})
+}
+
+func TestChallenge(t *testing.T) {
+	// Example from RFC 7636 appendix B
+	if got := Challenge(testVerifier); got != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Errorf("Challenge() = %v", got)
+	}
+}
+
+func TestGenerateVerifier(t *testing.T) {
+	first, err := GenerateVerifier()
+	if err != nil {
+		t.Fatalf("GenerateVerifier() error = %v", err)
+	}
+	second, _ := GenerateVerifier()
+
+	if len(first) != 43 {
+		t.Errorf("GenerateVerifier() length = %d, want 43", len(first))
+	}
+	if first == second {
+		t.Errorf("GenerateVerifier() returned the same verifier twice")
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	m := newMockProvider(t)
+
+	got, err := m.provider().AuthCodeURL(context.Background(), "state-value", testNonce, testVerifier)
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+
+	parsed, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	if !strings.HasPrefix(got, m.server.URL+"/auth?") {
+		t.Errorf("AuthCodeURL() = %v, want the authorization endpoint", got)
+	}
+
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             testClientID,
+		"redirect_uri":          testRedirectURL,
+		"scope":                 "openid email profile",
+		"state":                 "state-value",
+		"nonce":                 testNonce,
+		"code_challenge":        Challenge(testVerifier),
+		"code_challenge_method": "S256",
+	}
+	for key, value := range want {
+		if parsed.Query().Get(key) != value {
+			t.Errorf("AuthCodeURL() %s = %v, want %v", key, parsed.Query().Get(key), value)
+		}
+	}
+}
+
+func TestMetadataIssuerMismatch(t *testing.T) {
+	m := newMockProvider(t)
+	provider := NewProvider(Config{IssuerURL: m.server.URL + "/realms/other", ClientID: testClientID})
+
+	// The mock serves its discovery document at the root only
+	if _, err := provider.Metadata(context.Background()); err == nil {
+		t.Errorf("Metadata() expected an error for a foreign issuer")
+	}
+}
+
+func TestExchange(t *testing.T) {
+	m := newMockProvider(t)
+
+	claims, err := m.provider().Exchange(context.Background(), testCode, testVerifier, testNonce)
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if claims.Subject != "f3b1c2d4" || claims.Email != "jane@example.com" || !claims.EmailVerified {
+		t.Errorf("Exchange() claims = %+v", claims)
+	}
+}
+
+func TestExchangeRejected(t *testing.T) {
+	m := newMockProvider(t)
+
+	tests := []struct {
+		name     string
+		code     string
+		verifier string
+	}{
+		{name: "wrong code", code: "other", verifier: testVerifier},
+		{name: "wrong verifier", code: testCode, verifier: "another-verifier-that-does-not-match-at-all"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := m.provider().Exchange(context.Background(), tt.code, tt.verifier, testNonce); err == nil {
+				t.Errorf("Exchange() expected an error")
+			}
+		})
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	m := newMockProvider(t)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		token   func() string
+		nonce   string
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			token: func() string { return m.sign(t, m.claims) },
+			nonce: testNonce,
+		},
+		{
+			name:    "wrong nonce",
+			token:   func() string { return m.sign(t, m.claims) },
+			nonce:   "other",
+			wantErr: true,
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				claims := m.claims
+				claims.Audience = jwt.ClaimStrings{"another-client"}
+				return m.sign(t, claims)
+			},
+			nonce:   testNonce,
+			wantErr: true,
+		},
+		{
+			name: "several audiences without authorized party",
+			token: func() string {
+				claims := m.claims
+				claims.Audience = jwt.ClaimStrings{testClientID, "another-client"}
+				return m.sign(t, claims)
+			},
+			nonce:   testNonce,
+			wantErr: true,
+		},
+		{
+			name: "wrong issuer",
+			token: func() string {
+				claims := m.claims
+				claims.Issuer = "https://evil.example.com"
+				return m.sign(t, claims)
+			},
+			nonce:   testNonce,
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			token: func() string {
+				claims := m.claims
+				claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				return m.sign(t, claims)
+			},
+			nonce:   testNonce,
+			wantErr: true,
+		},
+		{
+			name: "signed with another key",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, m.claims)
+				token.Header["kid"] = m.kid
+				signed, _ := token.SignedString(other)
+				return signed
+			},
+			nonce:   testNonce,
+			wantErr: true,
+		},
+		{
+			name: "hmac algorithm",
+			token: func() string {
+				signed, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, m.claims).SignedString([]byte("secret"))
+				return signed
+			},
+			nonce:   testNonce,
+			wantErr: true,
+		},
+	}
+
+	provider := m.provider()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := provider.VerifyIDToken(context.Background(), tt.token(), tt.nonce)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifyIDToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyIDTokenKeyRotation(t *testing.T) {
+	m := newMockProvider(t)
+	provider := m.provider()
+
+	if _, err := provider.VerifyIDToken(context.Background(), m.sign(t, m.claims), testNonce); err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+
+	// The provider rotates its key, the new kid is only picked up once the refresh interval passed
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	m.key, m.kid = key, "key-2"
+
+	if _, err := provider.VerifyIDToken(context.Background(), m.sign(t, m.claims), testNonce); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("VerifyIDToken() error = %v, want %v", err, ErrUnknownKey)
+	}
+
+	provider.keysFetchedAt = time.Now().Add(-keyRefreshInterval)
+	if _, err := provider.VerifyIDToken(context.Background(), m.sign(t, m.claims), testNonce); err != nil {
+		t.Errorf("VerifyIDToken() after refresh error = %v", err)
+	}
+	if m.jwksHits != 2 {
+		t.Errorf("jwks fetched %d times, want 2", m.jwksHits)
+	}
+}
@@ -0,0 +1,233 @@
+package punch_log
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DuplicateWindow is the window in which repeated punches of the same user are treated as one
+const DuplicateWindow = time.Minute
+
+type (
+	// Punch is a single fingerprint scan exported from a terminal
+	Punch struct {
+		DeviceUserID string
+		Time         time.Time
+		Line         int
+	}
+
+	// ParseError describes a line that could not be parsed
+	ParseError struct {
+		Line    int    `json:"line"`
+		Content string `json:"content"`
+		Reason  string `json:"reason"`
+	}
+
+	// DailyPunch is the first and last punch of a device user on one day
+	DailyPunch struct {
+		DeviceUserID string
+		Date         time.Time
+		CheckIn      time.Time
+		CheckOut     *time.Time
+		TotalPunches int
+	}
+)
+
+var timeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006/01/02 15:04:05",
+	"2006/01/02 15:04",
+	"02/01/2006 15:04:05",
+	"02/01/2006 15:04",
+	"02-01-2006 15:04:05",
+	"02-01-2006 15:04",
+}
+
+var (
+	userIDHeaders   = []string{"user_id", "userid", "user id", "ac-no.", "ac-no", "ac no", "enroll_number", "enroll number", "pin", "badgenumber"}
+	dateTimeHeaders = []string{"datetime", "date_time", "date time", "checktime", "check_time", "punch_time", "time"}
+	dateHeaders     = []string{"date"}
+)
+
+// Parse reads a punch log export. Files with a .csv extension are read as CSV with a header row,
+// anything else is read as a ZKTeco attlog (.dat) where each line starts with user ID and timestamp.
+func Parse(r io.Reader, fileName string, loc *time.Location) ([]Punch, []ParseError, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	if strings.EqualFold(filepath.Ext(fileName), ".csv") {
+		return parseCSV(r, loc)
+	}
+	return parseDat(r, loc)
+}
+
+func parseDat(r io.Reader, loc *time.Location) ([]Punch, []ParseError, error) {
+	var punches []Punch
+	var parseErrors []ParseError
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+		if line == "" {
+			continue
+		}
+
+		var userID, stamp string
+		if strings.Contains(line, "\t") {
+			fields := strings.Split(line, "\t")
+			if len(fields) >= 2 {
+				userID = strings.TrimSpace(fields[0])
+				stamp = strings.TrimSpace(fields[1])
+			}
+		} else {
+			fields := strings.Fields(line)
+			if len(fields) >= 3 {
+				userID = fields[0]
+				stamp = fields[1] + " " + fields[2]
+			}
+		}
+
+		if userID == "" || stamp == "" {
+			parseErrors = append(parseErrors, ParseError{Line: lineNo, Content: line, Reason: "expected user ID and timestamp"})
+			continue
+		}
+
+		punchTime, err := parseTime(stamp, loc)
+		if err != nil {
+			parseErrors = append(parseErrors, ParseError{Line: lineNo, Content: line, Reason: err.Error()})
+			continue
+		}
+
+		punches = append(punches, Punch{DeviceUserID: userID, Time: punchTime, Line: lineNo})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read punch log: %w", err)
+	}
+
+	return punches, parseErrors, nil
+}
+
+func parseCSV(r io.Reader, loc *time.Location) ([]Punch, []ParseError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read csv: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("csv file is empty")
+	}
+
+	// Locate columns from the header row
+	header := records[0]
+	userCol, dateTimeCol, dateCol := -1, -1, -1
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		switch {
+		case userCol < 0 && contains(userIDHeaders, name):
+			userCol = i
+		case dateTimeCol < 0 && contains(dateTimeHeaders, name):
+			dateTimeCol = i
+		case dateCol < 0 && contains(dateHeaders, name):
+			dateCol = i
+		}
+	}
+	if userCol < 0 || dateTimeCol < 0 {
+		return nil, nil, fmt.Errorf("csv header must contain a user ID column and a time column")
+	}
+
+	var punches []Punch
+	var parseErrors []ParseError
+	for i, record := range records[1:] {
+		lineNo := i + 2
+		if len(record) <= userCol || len(record) <= dateTimeCol {
+			parseErrors = append(parseErrors, ParseError{Line: lineNo, Content: strings.Join(record, ","), Reason: "missing columns"})
+			continue
+		}
+
+		userID := strings.TrimSpace(record[userCol])
+		stamp := strings.TrimSpace(record[dateTimeCol])
+		if userID == "" {
+			parseErrors = append(parseErrors, ParseError{Line: lineNo, Content: strings.Join(record, ","), Reason: "empty user ID"})
+			continue
+		}
+
+		punchTime, err := parseTime(stamp, loc)
+		// Exports with separate date and time columns
+		if err != nil && dateCol >= 0 && len(record) > dateCol {
+			punchTime, err = parseTime(strings.TrimSpace(record[dateCol])+" "+stamp, loc)
+		}
+		if err != nil {
+			parseErrors = append(parseErrors, ParseError{Line: lineNo, Content: strings.Join(record, ","), Reason: err.Error()})
+			continue
+		}
+
+		punches = append(punches, Punch{DeviceUserID: userID, Time: punchTime, Line: lineNo})
+	}
+
+	return punches, parseErrors, nil
+}
+
+// GroupDaily pairs punches per device user and day. The first punch of the day is the check-in
+// and the last one the check-out; punches inside DuplicateWindow of the previous one are ignored.
+func GroupDaily(punches []Punch) []DailyPunch {
+	sorted := make([]Punch, len(punches))
+	copy(sorted, punches)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].DeviceUserID != sorted[j].DeviceUserID {
+			return sorted[i].DeviceUserID < sorted[j].DeviceUserID
+		}
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	var result []DailyPunch
+	var last time.Time
+	for _, p := range sorted {
+		day := time.Date(p.Time.Year(), p.Time.Month(), p.Time.Day(), 0, 0, 0, 0, p.Time.Location())
+		if len(result) == 0 || result[len(result)-1].DeviceUserID != p.DeviceUserID || !result[len(result)-1].Date.Equal(day) {
+			result = append(result, DailyPunch{DeviceUserID: p.DeviceUserID, Date: day, CheckIn: p.Time, TotalPunches: 1})
+			last = p.Time
+			continue
+		}
+		current := &result[len(result)-1]
+
+		if p.Time.Sub(last) < DuplicateWindow {
+			continue
+		}
+		checkOut := p.Time
+		current.CheckOut = &checkOut
+		current.TotalPunches++
+		last = p.Time
+	}
+
+	return result
+}
+
+func parseTime(value string, loc *time.Location) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported time format %q", value)
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package punch_log
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileName    string
+		content     string
+		wantPunches int
+		wantErrors  int
+		wantErr     bool
+	}{
+		{
+			name:     "zkteco attlog with tabs",
+			fileName: "attlog.dat",
+			content: "        1\t2024-01-15 08:01:23\t1\t0\t1\t0\n" +
+				"        2\t2024-01-15 08:05:00\t1\t0\t1\t0\n" +
+				"        1\t2024-01-15 17:02:11\t1\t1\t1\t0\n",
+			wantPunches: 3,
+			wantErrors:  0,
+		},
+		{
+			name:        "attlog separated by spaces",
+			fileName:    "attlog.dat",
+			content:     "1 2024-01-15 08:01:23 1 0\n1 2024-01-15 17:02:11 1 1\n",
+			wantPunches: 2,
+			wantErrors:  0,
+		},
+		{
+			name:        "attlog with invalid lines",
+			fileName:    "attlog.dat",
+			content:     "1\t2024-01-15 08:01:23\n\nbroken\n1\tnot-a-date\n",
+			wantPunches: 1,
+			wantErrors:  2,
+		},
+		{
+			name:        "csv with datetime column",
+			fileName:    "export.csv",
+			content:     "No.,AC-No.,Name,Time,State\n1,1,John,15/01/2024 08:01,C/In\n2,1,John,15/01/2024 17:00,C/Out\n",
+			wantPunches: 2,
+			wantErrors:  0,
+		},
+		{
+			name:        "csv with separate date and time columns",
+			fileName:    "export.CSV",
+			content:     "User ID,Date,Time\n7,2024-01-15,08:00:00\n7,2024-01-15,17:30:00\n,2024-01-15,18:00:00\n",
+			wantPunches: 2,
+			wantErrors:  1,
+		},
+		{
+			name:     "csv without user column",
+			fileName: "export.csv",
+			content:  "Name,Time\nJohn,2024-01-15 08:00\n",
+			wantErr:  true,
+		},
+		{
+			name:     "empty csv",
+			fileName: "export.csv",
+			content:  "",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			punches, parseErrors, err := Parse(strings.NewReader(tt.content), tt.fileName, time.UTC)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(punches) != tt.wantPunches {
+				t.Errorf("Parse() punches = %v, want %v", len(punches), tt.wantPunches)
+			}
+			if len(parseErrors) != tt.wantErrors {
+				t.Errorf("Parse() parse errors = %v, want %v", len(parseErrors), tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestGroupDaily(t *testing.T) {
+	at := func(day, hour, minute, second int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, second, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name         string
+		punches      []Punch
+		wantDays     int
+		wantCheckIn  time.Time
+		wantCheckOut *time.Time
+	}{
+		{
+			name: "check-in and check-out",
+			punches: []Punch{
+				{DeviceUserID: "1", Time: at(15, 17, 0, 0)},
+				{DeviceUserID: "1", Time: at(15, 8, 0, 0)},
+				{DeviceUserID: "1", Time: at(15, 12, 0, 0)},
+			},
+			wantDays:     1,
+			wantCheckIn:  at(15, 8, 0, 0),
+			wantCheckOut: func() *time.Time { v := at(15, 17, 0, 0); return &v }(),
+		},
+		{
+			name: "single punch has no check-out",
+			punches: []Punch{
+				{DeviceUserID: "1", Time: at(15, 8, 0, 0)},
+			},
+			wantDays:    1,
+			wantCheckIn: at(15, 8, 0, 0),
+		},
+		{
+			name: "double scan within duplicate window",
+			punches: []Punch{
+				{DeviceUserID: "1", Time: at(15, 8, 0, 0)},
+				{DeviceUserID: "1", Time: at(15, 8, 0, 30)},
+			},
+			wantDays:    1,
+			wantCheckIn: at(15, 8, 0, 0),
+		},
+		{
+			name: "multiple users and days",
+			punches: []Punch{
+				{DeviceUserID: "1", Time: at(15, 8, 0, 0)},
+				{DeviceUserID: "2", Time: at(15, 8, 0, 0)},
+				{DeviceUserID: "1", Time: at(16, 8, 0, 0)},
+			},
+			wantDays:    3,
+			wantCheckIn: at(15, 8, 0, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days := GroupDaily(tt.punches)
+			if len(days) != tt.wantDays {
+				t.Fatalf("GroupDaily() days = %v, want %v", len(days), tt.wantDays)
+			}
+			if !days[0].CheckIn.Equal(tt.wantCheckIn) {
+				t.Errorf("GroupDaily() check-in = %v, want %v", days[0].CheckIn, tt.wantCheckIn)
+			}
+			if (days[0].CheckOut == nil) != (tt.wantCheckOut == nil) {
+				t.Fatalf("GroupDaily() check-out = %v, want %v", days[0].CheckOut, tt.wantCheckOut)
+			}
+			if tt.wantCheckOut != nil && !days[0].CheckOut.Equal(*tt.wantCheckOut) {
+				t.Errorf("GroupDaily() check-out = %v, want %v", *days[0].CheckOut, *tt.wantCheckOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Package severance calculates termination benefits under UU Cipta Kerja and PP 35/2021.
+// Amounts are multiples of the monthly wage, the reason of the termination decides the multiplier.
+package severance
+
+import (
+	"fmt"
+	"time"
+)
+
+// Termination reasons and the article of PP 35/2021 that governs them
+const (
+	ReasonResignation      = "resignation"       // art. 50, resigning on own accord
+	ReasonContractEnd      = "contract_end"      // art. 15, fixed term contract (PKWT) ends
+	ReasonMerger           = "merger"            // art. 41, merger, consolidation or separation of the company
+	ReasonTakeover         = "takeover"          // art. 42, change of ownership
+	ReasonEfficiencyLoss   = "efficiency_loss"   // art. 43(1), efficiency because the company suffers losses
+	ReasonEfficiency       = "efficiency"        // art. 43(2), efficiency to prevent losses
+	ReasonClosureLoss      = "closure_loss"      // art. 44(1), closure because of losses
+	ReasonClosure          = "closure"           // art. 44(2), closure not caused by losses
+	ReasonBankruptcy       = "bankruptcy"        // art. 47, the company is declared bankrupt
+	ReasonViolation        = "violation"         // art. 52(1), violation after the third warning letter
+	ReasonSeriousViolation = "serious_violation" // art. 52(2), urgent violation set in the company regulation
+	ReasonAbsence          = "absence"           // art. 51, absent 5 working days without notice after two summons
+	ReasonDetention        = "detention"         // art. 54, detained by the authorities for 6 months
+	ReasonLongIllness      = "long_illness"      // art. 55, illness or disability beyond 12 months
+	ReasonRetirement       = "retirement"        // art. 56, reaching retirement age
+	ReasonDeath            = "death"             // art. 57, the employee passes away
+)
+
+// Factors are the multipliers a reason applies to the base entitlements of art. 40
+type Factors struct {
+	Severance     float64 // uang pesangon
+	ServiceAward  float64 // uang penghargaan masa kerja
+	SeparationPay bool    // uang pisah, the amount is set in the employment agreement
+	Compensation  bool    // uang kompensasi for a fixed term contract instead of severance
+}
+
+var factors = map[string]Factors{
+	ReasonResignation:      {SeparationPay: true},
+	ReasonContractEnd:      {Compensation: true},
+	ReasonMerger:           {Severance: 1, ServiceAward: 1},
+	ReasonTakeover:         {Severance: 1, ServiceAward: 1},
+	ReasonEfficiencyLoss:   {Severance: 0.5, ServiceAward: 1},
+	ReasonEfficiency:       {Severance: 1, ServiceAward: 1},
+	ReasonClosureLoss:      {Severance: 0.5, ServiceAward: 1},
+	ReasonClosure:          {Severance: 1, ServiceAward: 1},
+	ReasonBankruptcy:       {Severance: 0.5, ServiceAward: 1},
+	ReasonViolation:        {Severance: 0.5, ServiceAward: 1},
+	ReasonSeriousViolation: {SeparationPay: true},
+	ReasonAbsence:          {SeparationPay: true},
+	ReasonDetention:        {ServiceAward: 1},
+	ReasonLongIllness:      {Severance: 2, ServiceAward: 1},
+	ReasonRetirement:       {Severance: 1.75, ServiceAward: 1},
+	ReasonDeath:            {Severance: 2, ServiceAward: 1},
+}
+
+// Result is the severance part of a final settlement
+type Result struct {
+	ServiceMonths      int     `json:"service_months"`
+	SeveranceMonths    float64 `json:"severance_months"`
+	SeverancePay       float64 `json:"severance_pay"`
+	ServiceAwardMonths float64 `json:"service_award_months"`
+	ServiceAwardPay    float64 `json:"service_award_pay"`
+	CompensationMonths float64 `json:"compensation_months"`
+	CompensationPay    float64 `json:"compensation_pay"`
+}
+
+// FactorsFor returns the multipliers of a reason, ok is false for an unknown reason
+func FactorsFor(reason string) (Factors, bool) {
+	f, ok := factors[reason]
+	return f, ok
+}
+
+// ServiceMonths counts the full months worked from the join date up to and including the last working day
+func ServiceMonths(joinDate, lastWorkingDay time.Time) int {
+	end := lastWorkingDay.AddDate(0, 0, 1)
+	months := (end.Year()-joinDate.Year())*12 + int(end.Month()-joinDate.Month())
+	if end.Day() < joinDate.Day() {
+		months--
+	}
+	return max(months, 0)
+}
+
+// SeveranceMonths is the base severance of art. 40(2), one month of wage per started year of service up to nine
+func SeveranceMonths(serviceMonths int) int {
+	return min(serviceMonths/12+1, 9)
+}
+
+// ServiceAwardMonths is the base service award of art. 40(3), it starts after three years of service
+func ServiceAwardMonths(serviceMonths int) int {
+	years := serviceMonths / 12
+	switch {
+	case years < 3:
+		return 0
+	case years >= 24:
+		return 10
+	default:
+		return years/3 + 1
+	}
+}
+
+// CompensationMonths is the contract compensation of art. 16, one month of wage for every twelve months of the contract
+func CompensationMonths(serviceMonths int) float64 {
+	if serviceMonths < 1 {
+		return 0
+	}
+	return float64(serviceMonths) / 12
+}
+
+// Calculate applies the formulas of the reason to the length of service and the monthly wage
+func Calculate(reason string, serviceMonths int, monthlyWage float64) (Result, error) {
+	f, ok := FactorsFor(reason)
+	if !ok {
+		return Result{}, fmt.Errorf("unknown termination reason %q", reason)
+	}
+
+	result := Result{ServiceMonths: serviceMonths}
+	if f.Severance > 0 {
+		result.SeveranceMonths = f.Severance * float64(SeveranceMonths(serviceMonths))
+		result.SeverancePay = result.SeveranceMonths * monthlyWage
+	}
+	if f.ServiceAward > 0 {
+		result.ServiceAwardMonths = f.ServiceAward * float64(ServiceAwardMonths(serviceMonths))
+		result.ServiceAwardPay = result.ServiceAwardMonths * monthlyWage
+	}
+	if f.Compensation {
+		result.CompensationMonths = CompensationMonths(serviceMonths)
+		result.CompensationPay = result.CompensationMonths * monthlyWage
+	}
+	return result, nil
+}
@@ -0,0 +1,143 @@
+package severance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestServiceMonths(t *testing.T) {
+	date := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return parsed
+	}
+
+	tests := []struct {
+		name     string
+		joinDate string
+		lastDay  string
+		want     int
+	}{
+		{name: "exactly one year", joinDate: "2024-03-01", lastDay: "2025-02-28", want: 12},
+		{name: "one day short of a year", joinDate: "2024-03-01", lastDay: "2025-02-27", want: 11},
+		{name: "mid month join", joinDate: "2020-07-15", lastDay: "2025-07-14", want: 60},
+		{name: "less than a month", joinDate: "2025-07-01", lastDay: "2025-07-20", want: 0},
+		{name: "last day before join", joinDate: "2025-07-01", lastDay: "2025-06-01", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ServiceMonths(date(tt.joinDate), date(tt.lastDay)); got != tt.want {
+				t.Errorf("ServiceMonths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeveranceMonths(t *testing.T) {
+	tests := []struct {
+		serviceMonths int
+		want          int
+	}{
+		{serviceMonths: 0, want: 1},
+		{serviceMonths: 11, want: 1},
+		{serviceMonths: 12, want: 2},
+		{serviceMonths: 35, want: 3},
+		{serviceMonths: 95, want: 8},
+		{serviceMonths: 96, want: 9},
+		{serviceMonths: 300, want: 9},
+	}
+
+	for _, tt := range tests {
+		if got := SeveranceMonths(tt.serviceMonths); got != tt.want {
+			t.Errorf("SeveranceMonths(%d) = %v, want %v", tt.serviceMonths, got, tt.want)
+		}
+	}
+}
+
+func TestServiceAwardMonths(t *testing.T) {
+	tests := []struct {
+		serviceMonths int
+		want          int
+	}{
+		{serviceMonths: 35, want: 0},
+		{serviceMonths: 36, want: 2},
+		{serviceMonths: 71, want: 2},
+		{serviceMonths: 72, want: 3},
+		{serviceMonths: 143, want: 4},
+		{serviceMonths: 144, want: 5},
+		{serviceMonths: 287, want: 8},
+		{serviceMonths: 288, want: 10},
+	}
+
+	for _, tt := range tests {
+		if got := ServiceAwardMonths(tt.serviceMonths); got != tt.want {
+			t.Errorf("ServiceAwardMonths(%d) = %v, want %v", tt.serviceMonths, got, tt.want)
+		}
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	const wage = 10000000
+
+	tests := []struct {
+		name          string
+		reason        string
+		serviceMonths int
+		want          Result
+		wantErr       bool
+	}{
+		{
+			name:          "efficiency after five years",
+			reason:        ReasonEfficiency,
+			serviceMonths: 60,
+			want:          Result{ServiceMonths: 60, SeveranceMonths: 6, SeverancePay: 60000000, ServiceAwardMonths: 2, ServiceAwardPay: 20000000},
+		},
+		{
+			name:          "efficiency because of losses halves severance",
+			reason:        ReasonEfficiencyLoss,
+			serviceMonths: 60,
+			want:          Result{ServiceMonths: 60, SeveranceMonths: 3, SeverancePay: 30000000, ServiceAwardMonths: 2, ServiceAwardPay: 20000000},
+		},
+		{
+			name:          "retirement after ten years",
+			reason:        ReasonRetirement,
+			serviceMonths: 120,
+			want:          Result{ServiceMonths: 120, SeveranceMonths: 15.75, SeverancePay: 157500000, ServiceAwardMonths: 4, ServiceAwardPay: 40000000},
+		},
+		{
+			name:          "resignation has no severance",
+			reason:        ReasonResignation,
+			serviceMonths: 120,
+			want:          Result{ServiceMonths: 120},
+		},
+		{
+			name:          "detention only pays the service award",
+			reason:        ReasonDetention,
+			serviceMonths: 40,
+			want:          Result{ServiceMonths: 40, ServiceAwardMonths: 2, ServiceAwardPay: 20000000},
+		},
+		{
+			name:          "contract end pays compensation",
+			reason:        ReasonContractEnd,
+			serviceMonths: 18,
+			want:          Result{ServiceMonths: 18, CompensationMonths: 1.5, CompensationPay: 15000000},
+		},
+		{
+			name:    "unknown reason",
+			reason:  "fired",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Calculate(tt.reason, tt.serviceMonths, wage)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Calculate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Calculate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package similarity
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize lowercases the text and collapses punctuation and whitespace into single spaces
+func Normalize(s string) string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
+
+// Ratio returns how similar two texts are, from 0 (different) to 1 (identical after normalizing).
+// It is the Levenshtein distance relative to the length of the longer text.
+func Ratio(a, b string) float64 {
+	ra, rb := []rune(Normalize(a)), []rune(Normalize(b))
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,52 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "lowercase", in: "Taxi Fare", want: "taxi fare"},
+		{name: "punctuation and spaces", in: "  Taxi -  fare, airport!! ", want: "taxi fare airport"},
+		{name: "digits kept", in: "Meal 12/01", want: "meal 12 01"},
+		{name: "empty", in: " - ", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRatio(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{name: "identical", a: "Taxi to airport", b: "Taxi to airport", want: 1},
+		{name: "case and punctuation only", a: "Taxi to airport", b: "taxi, to AIRPORT.", want: 1},
+		{name: "one character changed", a: "Taxi fare", b: "Taxi fair", want: 1 - 2.0/9},
+		{name: "completely different", a: "abc", b: "xyz", want: 0},
+		{name: "one empty", a: "Meal", b: "", want: 0},
+		{name: "both empty", a: "", b: "", want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Ratio(tt.a, tt.b)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Ratio(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ReadTable reads the rows of an uploaded CSV or XLSX file, the format is picked by the file extension
+func ReadTable(fileName string, content []byte) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return ReadCSV(content)
+	case ".xlsx":
+		return ReadXLSX(content)
+	default:
+		return nil, fmt.Errorf("unsupported file type, use .csv or .xlsx")
+	}
+}
+
+// ReadCSV reads comma or semicolon separated rows, a UTF-8 byte order mark is ignored
+func ReadCSV(content []byte) ([][]string, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	// spreadsheets exported with an Indonesian locale separate columns with a semicolon
+	if firstLine, _, _ := bytes.Cut(content, []byte("\n")); bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv: %w", err)
+	}
+	return rows, nil
+}
+
+type (
+	xlsxWorkbook struct {
+		Sheets []struct {
+			RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+
+	xlsxRelationships struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+
+	xlsxSharedStrings struct {
+		Items []xlsxText `xml:"si"`
+	}
+
+	xlsxText struct {
+		T    string `xml:"t"`
+		Runs []struct {
+			T string `xml:"t"`
+		} `xml:"r"`
+	}
+
+	xlsxWorksheet struct {
+		Rows []struct {
+			Cells []struct {
+				Ref    string   `xml:"r,attr"`
+				Type   string   `xml:"t,attr"`
+				Value  string   `xml:"v"`
+				Inline xlsxText `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+)
+
+// ReadXLSX reads the first worksheet of a workbook as text.
+// Shared, inline and numeric cells are supported, formulas are read from their cached value.
+func ReadXLSX(content []byte) ([][]string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid xlsx: %w", err)
+	}
+
+	files := map[string]*zip.File{}
+	for _, f := range reader.File {
+		files[f.Name] = f
+	}
+
+	var sharedStrings xlsxSharedStrings
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeZipXML(f, &sharedStrings); err != nil {
+			return nil, fmt.Errorf("invalid xlsx shared strings: %w", err)
+		}
+	}
+
+	sheetFile, ok := files[firstSheetPath(files)]
+	if !ok {
+		return nil, fmt.Errorf("invalid xlsx: workbook has no worksheet")
+	}
+	var sheet xlsxWorksheet
+	if err := decodeZipXML(sheetFile, &sheet); err != nil {
+		return nil, fmt.Errorf("invalid xlsx worksheet: %w", err)
+	}
+
+	rows := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		values := []string{}
+		for i, cell := range row.Cells {
+			column := i
+			if index := columnIndex(cell.Ref); index >= 0 {
+				column = index
+			}
+			for len(values) <= column {
+				values = append(values, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err != nil || index < 0 || index >= len(sharedStrings.Items) {
+					return nil, fmt.Errorf("invalid xlsx: cell %s refers to an unknown shared string", cell.Ref)
+				}
+				values[column] = sharedStrings.Items[index].String()
+			case "inlineStr":
+				values[column] = cell.Inline.String()
+			default:
+				values[column] = cell.Value
+			}
+		}
+		rows = append(rows, values)
+	}
+	return rows, nil
+}
+
+// firstSheetPath resolves the first sheet of the workbook, falling back to the conventional part name
+func firstSheetPath(files map[string]*zip.File) string {
+	const fallback = "xl/worksheets/sheet1.xml"
+
+	var workbook xlsxWorkbook
+	var rels xlsxRelationships
+	workbookFile, ok := files["xl/workbook.xml"]
+	relsFile, relsOK := files["xl/_rels/workbook.xml.rels"]
+	if !ok || !relsOK || decodeZipXML(workbookFile, &workbook) != nil || decodeZipXML(relsFile, &rels) != nil || len(workbook.Sheets) == 0 {
+		return fallback
+	}
+
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/")
+		}
+		return path.Join("xl", rel.Target)
+	}
+	return fallback
+}
+
+// columnIndex converts the letters of a cell reference to a zero based column index, B7 is 1
+func columnIndex(ref string) int {
+	index := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		index = index*26 + int(r-'A'+1)
+	}
+	return index - 1
+}
+
+func (text xlsxText) String() string {
+	if len(text.Runs) == 0 {
+		return text.T
+	}
+	var b strings.Builder
+	for _, run := range text.Runs {
+		b.WriteString(run.T)
+	}
+	return b.String()
+}
+
+func decodeZipXML(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(io.LimitReader(rc, 64<<20)).Decode(v)
+}
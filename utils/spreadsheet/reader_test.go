@@ -0,0 +1,142 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    [][]string
+		wantErr bool
+	}{
+		{
+			name:    "comma separated",
+			content: "employee_number,full_name\nEMP-001,Budi Santoso\n",
+			want:    [][]string{{"employee_number", "full_name"}, {"EMP-001", "Budi Santoso"}},
+		},
+		{
+			name:    "semicolon separated with byte order mark",
+			content: "\xef\xbb\xbfemployee_number;salary\r\nEMP-001;8500000,50\r\n",
+			want:    [][]string{{"employee_number", "salary"}, {"EMP-001", "8500000,50"}},
+		},
+		{
+			name:    "quoted value",
+			content: "name,position\n\"Santoso, Budi\",Staff\n",
+			want:    [][]string{{"name", "position"}, {"Santoso, Budi", "Staff"}},
+		},
+		{
+			name:    "unterminated quote",
+			content: "name\n\"Budi\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV([]byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadCSV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadCSV() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	t.Run("round trip with WriteXLSX", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := WriteXLSX(&buf, []Sheet{
+			{Name: "Employees", Rows: [][]interface{}{
+				{"employee_number", "salary", "department"},
+				{"EMP-001", 8500000, "R&D"},
+				{"EMP-002", nil, "Finance"},
+			}},
+			{Name: "Ignored", Rows: [][]interface{}{{"other"}}},
+		})
+		if err != nil {
+			t.Fatalf("WriteXLSX() error = %v", err)
+		}
+
+		got, err := ReadXLSX(buf.Bytes())
+		if err != nil {
+			t.Fatalf("ReadXLSX() error = %v", err)
+		}
+		want := [][]string{
+			{"employee_number", "salary", "department"},
+			{"EMP-001", "8500000", "R&D"},
+			{"EMP-002", "", "Finance"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadXLSX() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("shared and rich strings", func(t *testing.T) {
+		content := zipParts(t, map[string]string{
+			"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><si><t>full_name</t></si><si><r><t>Budi </t></r><r><t>Santoso</t></r></si></sst>`,
+			"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+				`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1"><v>45870</v></c></row>` +
+				`<row r="2"><c r="A2" t="s"><v>1</v></c></row>` +
+				`</sheetData></worksheet>`,
+		})
+
+		got, err := ReadXLSX(content)
+		if err != nil {
+			t.Fatalf("ReadXLSX() error = %v", err)
+		}
+		want := [][]string{{"full_name", "", "45870"}, {"Budi Santoso"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadXLSX() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unknown shared string", func(t *testing.T) {
+		content := zipParts(t, map[string]string{
+			"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1"><c r="A1" t="s"><v>3</v></c></row></sheetData></worksheet>`,
+		})
+		if _, err := ReadXLSX(content); err == nil {
+			t.Error("ReadXLSX() expected an error")
+		}
+	})
+
+	t.Run("not a zip archive", func(t *testing.T) {
+		if _, err := ReadXLSX([]byte("employee_number,full_name")); err == nil {
+			t.Error("ReadXLSX() expected an error")
+		}
+	})
+}
+
+func TestReadTable(t *testing.T) {
+	if _, err := ReadTable("employees.CSV", []byte("a,b\n1,2\n")); err != nil {
+		t.Errorf("ReadTable() csv error = %v", err)
+	}
+	if _, err := ReadTable("employees.xls", []byte{}); err == nil {
+		t.Error("ReadTable() expected an error for an unsupported extension")
+	}
+}
+
+func zipParts(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		f, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,97 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestColumnName(t *testing.T) {
+	tests := []struct {
+		index int
+		want  string
+	}{
+		{index: 0, want: "A"},
+		{index: 25, want: "Z"},
+		{index: 26, want: "AA"},
+		{index: 51, want: "AZ"},
+		{index: 701, want: "ZZ"},
+		{index: 702, want: "AAA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := ColumnName(tt.index); got != tt.want {
+				t.Errorf("ColumnName(%d) = %v, want %v", tt.index, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteXLSX(&buf, []Sheet{
+		{
+			Name: "Timesheet 2025/07",
+			Rows: [][]interface{}{
+				{"Date", "Status", "Hours"},
+				{"2025-07-01", "present", 8.5},
+				{"2025-07-02", "R&D <absent>", 0},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("WriteXLSX() error = %v", err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("output is not a zip archive: %v", err)
+	}
+
+	files := map[string]string{}
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+
+	tests := []struct {
+		name string
+		part string
+		want string
+	}{
+		{name: "content types", part: "[Content_Types].xml", want: `/xl/worksheets/sheet1.xml`},
+		{name: "root relationship", part: "_rels/.rels", want: `Target="xl/workbook.xml"`},
+		{name: "sheet name sanitized", part: "xl/workbook.xml", want: `<sheet name="Timesheet 2025-07"`},
+		{name: "worksheet relationship", part: "xl/_rels/workbook.xml.rels", want: `Target="worksheets/sheet1.xml"`},
+		{name: "string cell", part: "xl/worksheets/sheet1.xml", want: `<c r="B2" t="inlineStr"><is><t>present</t></is></c>`},
+		{name: "numeric cell", part: "xl/worksheets/sheet1.xml", want: `<c r="C2"><v>8.5</v></c>`},
+		{name: "escaped cell", part: "xl/worksheets/sheet1.xml", want: `R&amp;D &lt;absent&gt;`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, ok := files[tt.part]
+			if !ok {
+				t.Fatalf("part %s is missing", tt.part)
+			}
+			if !strings.Contains(content, tt.want) {
+				t.Errorf("part %s does not contain %q", tt.part, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteXLSX_NoSheets(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteXLSX(&buf, nil); err == nil {
+		t.Error("WriteXLSX() expected error for workbook without sheets")
+	}
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// LocalStorage stores files below a directory of the local filesystem
+type LocalStorage struct {
+	basePath string
+}
+
+func NewLocalStorage(basePath string) (*LocalStorage, error) {
+	if err := os.MkdirAll(basePath, 0o750); err != nil {
+		return nil, err
+	}
+	return &LocalStorage{basePath: basePath}, nil
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return content, err
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.basePath, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		wantErr bool
+	}{
+		{key: "receipts/1/receipt.pdf", wantErr: false},
+		{key: "receipt.png", wantErr: false},
+		{key: "", wantErr: true},
+		{key: "/etc/passwd", wantErr: true},
+		{key: "../secret", wantErr: true},
+		{key: "receipts/../../secret", wantErr: true},
+		{key: "receipts//1.pdf", wantErr: true},
+		{key: "receipts\\1.pdf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			err := validateKey(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStorage() error = %v", err)
+	}
+
+	if err := store.Put(ctx, "receipts/1/receipt.pdf", []byte("%PDF-1.4"), "application/pdf"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	content, err := store.Get(ctx, "receipts/1/receipt.pdf")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(content) != "%PDF-1.4" {
+		t.Errorf("Get() = %q, want %q", content, "%PDF-1.4")
+	}
+
+	if err := store.Delete(ctx, "receipts/1/receipt.pdf"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(ctx, "receipts/1/receipt.pdf"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after delete error = %v, want ErrNotFound", err)
+	}
+
+	// Deleting a missing file is not an error
+	if err := store.Delete(ctx, "receipts/1/receipt.pdf"); err != nil {
+		t.Errorf("Delete() of missing file error = %v", err)
+	}
+
+	if err := store.Put(ctx, "../outside.txt", []byte("x"), "text/plain"); err == nil {
+		t.Error("Put() expected error for key outside the storage root")
+	}
+}
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ErrNotFound is returned when no object is stored under the key
+var ErrNotFound = errors.New("object not found")
+
+// Storage keeps uploaded files, keys are slash separated relative paths such as "receipts/1/file.pdf"
+type Storage interface {
+	Put(ctx context.Context, key string, content []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// validateKey rejects keys that could escape the storage root
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("invalid storage key %q", key)
+	}
+	if cleaned := path.Clean(key); cleaned != key || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return fmt.Errorf("invalid storage key %q", key)
+	}
+	return nil
+}
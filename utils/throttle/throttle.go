@@ -0,0 +1,32 @@
+package throttle
+
+import "time"
+
+// ProgressiveDelay returns how long to wait after the given number of consecutive failures,
+// starting at base and doubling on every failure up to max
+func ProgressiveDelay(failures int, base, max time.Duration) time.Duration {
+	if failures <= 0 || base <= 0 {
+		return 0
+	}
+	if max < base {
+		return max
+	}
+
+	delay := base
+	for i := 1; i < failures; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	return delay
+}
+
+// RetryAfter returns the time left until last+wait, or zero when it already passed
+func RetryAfter(last time.Time, wait time.Duration, now time.Time) time.Duration {
+	remaining := last.Add(wait).Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
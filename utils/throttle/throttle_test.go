@@ -0,0 +1,58 @@
+package throttle
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProgressiveDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int
+		base     time.Duration
+		max      time.Duration
+		want     time.Duration
+	}{
+		{name: "no failures", failures: 0, base: time.Second, max: 30 * time.Second, want: 0},
+		{name: "first failure waits base", failures: 1, base: time.Second, max: 30 * time.Second, want: time.Second},
+		{name: "doubles on second failure", failures: 2, base: time.Second, max: 30 * time.Second, want: 2 * time.Second},
+		{name: "doubles again", failures: 4, base: time.Second, max: 30 * time.Second, want: 8 * time.Second},
+		{name: "capped at max", failures: 6, base: time.Second, max: 30 * time.Second, want: 30 * time.Second},
+		{name: "many failures stay at max", failures: 1000, base: time.Second, max: 30 * time.Second, want: 30 * time.Second},
+		{name: "base above max", failures: 1, base: time.Minute, max: 30 * time.Second, want: 30 * time.Second},
+		{name: "disabled with zero base", failures: 3, base: 0, max: 30 * time.Second, want: 0},
+		{name: "disabled with zero max", failures: 3, base: time.Second, max: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProgressiveDelay(tt.failures, tt.base, tt.max); got != tt.want {
+				t.Errorf("ProgressiveDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 8, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		last time.Time
+		wait time.Duration
+		want time.Duration
+	}{
+		{name: "still waiting", last: now.Add(-3 * time.Second), wait: 8 * time.Second, want: 5 * time.Second},
+		{name: "wait passed", last: now.Add(-10 * time.Second), wait: 8 * time.Second, want: 0},
+		{name: "exactly over", last: now.Add(-8 * time.Second), wait: 8 * time.Second, want: 0},
+		{name: "no wait", last: now, wait: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RetryAfter(tt.last, tt.wait, now); got != tt.want {
+				t.Errorf("RetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
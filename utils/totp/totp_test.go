@@ -0,0 +1,212 @@
+package totp
+
+import (
+	"encoding/base32"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 seed of the RFC 6238 test vectors
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateCode(t *testing.T) {
+	// RFC 6238 appendix B lists 8 digit codes, the last 6 digits are the 6 digit code
+	tests := []struct {
+		name string
+		unix int64
+		want string
+	}{
+		{name: "59", unix: 59, want: "287082"},
+		{name: "1111111109", unix: 1111111109, want: "081804"},
+		{name: "1111111111", unix: 1111111111, want: "050471"},
+		{name: "1234567890", unix: 1234567890, want: "005924"},
+		{name: "2000000000", unix: 2000000000, want: "279037"},
+		{name: "20000000000", unix: 20000000000, want: "353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateCode(rfcSecret, time.Unix(tt.unix, 0))
+			if err != nil {
+				t.Fatalf("GenerateCode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("GenerateCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		skew     int
+		wantOK   bool
+		wantStep int64
+	}{
+		{name: "current step", secret: rfcSecret, code: "050471", skew: 1, wantOK: true, wantStep: Step(now)},
+		{name: "with spaces", secret: rfcSecret, code: "050 471", skew: 1, wantOK: true, wantStep: Step(now)},
+		{name: "lowercase secret", secret: strings.ToLower(rfcSecret), code: "050471", skew: 1, wantOK: true, wantStep: Step(now)},
+		{name: "previous step within skew", secret: rfcSecret, code: "081804", skew: 1, wantOK: true, wantStep: Step(now) - 1},
+		{name: "previous step without skew", secret: rfcSecret, code: "081804", skew: 0, wantOK: false},
+		{name: "wrong code", secret: rfcSecret, code: "123456", skew: 1, wantOK: false},
+		{name: "too short", secret: rfcSecret, code: "50471", skew: 1, wantOK: false},
+		{name: "invalid secret", secret: "not base32!", code: "050471", skew: 1, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(tt.secret, tt.code, now, tt.skew)
+			if ok != tt.wantOK {
+				t.Fatalf("Validate() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && step != tt.wantStep {
+				t.Errorf("Validate() step = %v, want %v", step, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	if len(secret) != 32 {
+		t.Errorf("GenerateSecret() length = %d, want 32", len(secret))
+	}
+
+	code, err := GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatalf("GenerateCode() error = %v", err)
+	}
+	if _, ok := Validate(secret, code, time.Now(), 1); !ok {
+		t.Errorf("Validate() rejected a freshly generated code")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		issuer  string
+		account string
+		want    string
+	}{
+		{
+			name:    "plain",
+			issuer:  "Payrolls",
+			account: "admin",
+			want:    "otpauth://totp/Payrolls:admin?algorithm=SHA1&digits=6&issuer=Payrolls&period=30&secret=JBSWY3DPEHPK3PXP",
+		},
+		{
+			name:    "issuer with space",
+			issuer:  "Blank Company",
+			account: "john_doe",
+			want:    "otpauth://totp/Blank%20Company:john_doe?algorithm=SHA1&digits=6&issuer=Blank+Company&period=30&secret=JBSWY3DPEHPK3PXP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProvisioningURI(tt.issuer, tt.account, "JBSWY3DPEHPK3PXP"); got != tt.want {
+				t.Errorf("ProvisioningURI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	if err != nil {
+		t.Fatalf("GenerateRecoveryCodes() error = %v", err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("GenerateRecoveryCodes() returned %d codes, want 10", len(codes))
+	}
+
+	format := regexp.MustCompile(`^[a-z2-9]{5}-[a-z2-9]{5}$`)
+	seen := map[string]bool{}
+	for _, code := range codes {
+		if !format.MatchString(code) {
+			t.Errorf("recovery code %q has an unexpected format", code)
+		}
+		if seen[code] {
+			t.Errorf("recovery code %q generated twice", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{name: "already normalized", code: "abcde-fghjk", want: "abcde-fghjk"},
+		{name: "uppercase", code: "ABCDE-FGHJK", want: "abcde-fghjk"},
+		{name: "surrounding spaces", code: "  abcde-fghjk ", want: "abcde-fghjk"},
+		{name: "inner spaces", code: "abcde - fghjk", want: "abcde-fghjk"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeRecoveryCode(tt.code); got != tt.want {
+				t.Errorf("NormalizeRecoveryCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	tests := []struct {
+		name       string
+		sealKey    string
+		openKey    string
+		wantErr    bool
+		tamperWith bool
+	}{
+		{name: "round trip", sealKey: "secret-key", openKey: "secret-key"},
+		{name: "wrong key", sealKey: "secret-key", openKey: "other-key", wantErr: true},
+		{name: "tampered", sealKey: "secret-key", openKey: "secret-key", tamperWith: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sealed, err := Seal("JBSWY3DPEHPK3PXP", tt.sealKey)
+			if err != nil {
+				t.Fatalf("Seal() error = %v", err)
+			}
+			if strings.Contains(sealed, "JBSWY3DPEHPK3PXP") {
+				t.Fatalf("Seal() output contains the plain secret")
+			}
+			if tt.tamperWith {
+				// Change a character in the middle, the last one may only carry padding bits
+				i := len(sealed) / 2
+				replacement := byte('A')
+				if sealed[i] == 'A' {
+					replacement = 'B'
+				}
+				sealed = sealed[:i] + string(replacement) + sealed[i+1:]
+			}
+
+			got, err := Open(sealed, tt.openKey)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Open() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != "JBSWY3DPEHPK3PXP" {
+				t.Errorf("Open() = %v, want JBSWY3DPEHPK3PXP", got)
+			}
+		})
+	}
+
+	if _, err := Seal("JBSWY3DPEHPK3PXP", ""); err == nil {
+		t.Errorf("Seal() with an empty key should fail")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	en_translations "github.com/go-playground/validator/v10/translations/en"
+	"github.com/riskykurniawan15/payrolls/utils/identity"
 )
 
 type (
@@ -44,12 +45,31 @@ func NewCustomValidator() *CustomValidator {
 	trans, _ := uni.GetTranslator("en")
 	en_translations.RegisterDefaultTranslations(v, trans)
 
+	// Register Indonesian identity number tags
+	registerCustomTag(v, trans, "nik", "{0} must be a valid 16 digit NIK", func(fl validator.FieldLevel) bool {
+		return identity.IsValidNIK(fl.Field().String())
+	})
+	registerCustomTag(v, trans, "npwp", "{0} must be a valid 15 or 16 digit NPWP", func(fl validator.FieldLevel) bool {
+		return identity.IsValidNPWP(fl.Field().String())
+	})
+
 	return &CustomValidator{
 		validator:  v,
 		translator: trans,
 	}
 }
 
+// registerCustomTag adds a validation tag together with its English message
+func registerCustomTag(v *validator.Validate, trans ut.Translator, tag, message string, fn validator.Func) {
+	v.RegisterValidation(tag, fn)
+	v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+		return ut.Add(tag, message, true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T(tag, fe.Field())
+		return t
+	})
+}
+
 // Validate validates the struct and returns validation errors
 // This implements Echo's Validator interface
 func (cv *CustomValidator) Validate(i interface{}) error {